@@ -19,6 +19,9 @@ type Has interface {
 type Account struct {
 	id string
 
+	// torIsolation is the SOCKS username used for automatic Tor proxies during this run
+	torIsolation string
+
 	//TODO: this should be JID
 	Account              string
 	Nickname             string   `json:",omitempty"`
@@ -39,6 +42,21 @@ type Account struct {
 	ConnectAutomatically bool
 	Certificates         []*CertificatePin `json:",omitempty"`
 	PinningPolicy        string            `json:",omitempty"`
+	SASLMechanism        string            `json:",omitempty"`
+	SASLChannelBinding   string            `json:",omitempty"`
+	SASLDowngradePolicy  string            `json:",omitempty"`
+	StoreSaltedKeys      bool              `json:",omitempty"`
+	SaltedKeys           []*SaltedKeys     `json:",omitempty"`
+	DANEPolicy           string            `json:",omitempty"`
+	DNSSECResolver       string            `json:",omitempty"`
+	DNSResolver          string            `json:",omitempty"`
+	DNSResolverAddress   string            `json:",omitempty"`
+	DNSFailClosed        bool              `json:",omitempty"`
+	TorControlPassword   string            `json:",omitempty"`
+	TorOnly              bool              `json:",omitempty"`
+	KeepHistory          bool              `json:",omitempty"`
+	HistoryMaxMessages   int               `json:",omitempty"`
+	HistoryMaxAgeDays    int               `json:",omitempty"`
 
 	LegacyKnownFingerprints       []KnownFingerprint `json:"KnownFingerprints,omitempty"`
 	DeprecatedPrivateKey          []byte             `json:"PrivateKey,omitempty"`
@@ -201,3 +219,14 @@ func (a *Account) SaveCert(subject, issuer string, sha3Digest []byte) {
 	sort.Sort(CertificatePinsByNaturalOrder(a.Certificates))
 
 }
+
+// SaveSASLMechanism records the given SASL mechanism and channel binding type as the strongest seen for this account
+func (a *Account) SaveSASLMechanism(mechanism, channelBinding string) {
+	a.SASLMechanism = mechanism
+	a.SASLChannelBinding = channelBinding
+}
+
+// UsesDANE returns true if the DANE policy of this account asks us to take TLSA records into account
+func (a *Account) UsesDANE() bool {
+	return a.DANEPolicy == "opportunistic" || a.DANEPolicy == "require"
+}
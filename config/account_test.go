@@ -165,3 +165,21 @@ func (s *AccountXMPPSuite) Test_Account_SaveCert(c *C) {
 	a.SaveCert("foo", "bar", []byte{1, 2, 3, 4})
 	c.Assert(a.Certificates[0], DeepEquals, &CertificatePin{Subject: "foo", Issuer: "bar", Fingerprint: []byte{0x1, 0x2, 0x3, 0x4}, FingerprintType: "SHA3-256"})
 }
+
+func (s *AccountXMPPSuite) Test_Account_SaveSASLMechanism(c *C) {
+	a := &Account{
+		SASLMechanism:      "SCRAM-SHA-1-PLUS",
+		SASLChannelBinding: "tls-unique",
+	}
+
+	a.SaveSASLMechanism("SCRAM-SHA-512", "")
+	c.Assert(a.SASLMechanism, Equals, "SCRAM-SHA-512")
+	c.Assert(a.SASLChannelBinding, Equals, "")
+}
+
+func (s *AccountXMPPSuite) Test_Account_UsesDANE(c *C) {
+	c.Assert((&Account{}).UsesDANE(), Equals, false)
+	c.Assert((&Account{DANEPolicy: "none"}).UsesDANE(), Equals, false)
+	c.Assert((&Account{DANEPolicy: "opportunistic"}).UsesDANE(), Equals, true)
+	c.Assert((&Account{DANEPolicy: "require"}).UsesDANE(), Equals, true)
+}
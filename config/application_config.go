@@ -7,6 +7,8 @@ import (
 	"os"
 	"strings"
 	"sync"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // HasApplication represents any object that has application config and can give access to it
@@ -22,8 +24,20 @@ type ApplicationConfig struct {
 	filename      string
 	shouldEncrypt bool
 	params        *EncryptionParameters
+	keySupplier   KeySupplier
 	ioLock        sync.Mutex
 	afterSave     []func()
+	container     *encryptedContainer
+	slot          int
+
+	// onDisk identifies the content of the configuration file as we last read or wrote it, and saved
+	// is the configuration as it was then, to merge in changes other instances make to the file
+	onDisk           *fileState
+	saved            []byte
+	changedElsewhere func(*ConfigurationChanges)
+
+	// Version is the version of the configuration format, which tells the migrations it has been through
+	Version int `json:",omitempty"`
 
 	Accounts                      []*Account
 	RawLogFile                    string   `json:",omitempty"`
@@ -33,7 +47,18 @@ type ApplicationConfig struct {
 	ConnectAutomatically          bool
 	Display                       DisplayConfig `json:",omitempty"`
 	AdvancedOptions               bool
+	TorOnly                       bool `json:",omitempty"`
 	UniqueConfigurationID         string
+
+	// KeyDerivationSecret is the random secret that keys for other purposes are derived from, so they don't
+	// depend on the master password. It is only kept in the encrypted file.
+	KeyDerivationSecret string `json:",omitempty"`
+
+	// DataDirectory is where the data files of this profile are kept, relative to the directory of the
+	// configuration file. Decoy profiles keep their data apart from the profile they share the file with.
+	DataDirectory string `json:",omitempty"`
+	// DestroyOtherProfilesWhenOpened makes opening this profile destroy the other profiles in the configuration file
+	DestroyOtherProfilesWhenOpened bool `json:",omitempty"`
 }
 
 var loadEntries []func(*ApplicationConfig)
@@ -71,9 +96,37 @@ func LoadOrCreate(configFile string, ks KeySupplier) (a *ApplicationConfig, ok b
 	defer a.ioLock.Unlock()
 
 	a.filename = findConfigFile(configFile)
+	a.keySupplier = ks
+	if err := a.recoverInterruptedRekey(); err != nil {
+		log.WithError(err).Warn("couldn't finish changing the keys of the configuration file")
+	}
 	e = a.tryLoad(ks)
 	ok = !(e == errNoPasswordSupplied || e == errDecryptionFailed)
 
+	if e == errInvalidConfigFile {
+		// A new configuration is made from scratch, so it starts out in the current version
+		a.Version = currentConfigurationVersion
+	}
+
+	if e == nil && a.DataDirectory != "" {
+		// Only the directory of the configuration file is known before the file is opened
+		discardRekeyLeftovers(a.dataDirectory())
+	}
+
+	if e == nil && a.DestroyOtherProfilesWhenOpened {
+		if err := a.destroyOtherProfiles(ks); err != nil {
+			log.WithError(err).Warn("couldn't destroy the other profiles in the configuration file")
+		}
+	}
+
+	if e == nil {
+		// The file has been unlocked, so this is the time to move it to stronger encryption, if needed.
+		// If that doesn't work out, the file can still be used as it is.
+		if _, err := a.upgradeEncryptionParameters(ks); err != nil {
+			log.WithError(err).Warn("couldn't upgrade the encryption parameters of the configuration file")
+		}
+	}
+
 	return
 }
 
@@ -121,6 +174,8 @@ func (a *ApplicationConfig) removeOldFileOnNextSave() {
 		if fileExists(oldFilename) && a.filename != oldFilename {
 			// TODO: Hmm, should we safe wipe this maybe? The old file can contain potentially sensitive things
 			_ = os.Remove(oldFilename)
+			// The backups of the old file are in the old format as well
+			removeBackupsOf(oldFilename)
 		}
 	})
 }
@@ -152,6 +207,10 @@ func (a *ApplicationConfig) turnOffEncryption() bool {
 	}
 
 	a.shouldEncrypt = false
+	// The secret is never written unencrypted. What was encrypted with keys derived from it can't be read
+	// anymore, so it has to be removed before turning off encryption - see session.DeleteHistory.
+	a.KeyDerivationSecret = ""
+	a.container = nil
 	a.removeOldFileOnNextSave()
 	a.filename = strings.TrimSuffix(a.filename, encryptedFileEnding)
 
@@ -173,13 +232,37 @@ func (a *ApplicationConfig) SetShouldSaveFileEncrypted(val bool) bool {
 }
 
 func (a *ApplicationConfig) tryLoad(ks KeySupplier) error {
-	var contents []byte
-	var err error
-
-	contents, err = readFileOrTemporaryBackup(a.filename)
+	contents, err := a.readConfigFile()
 	if err != nil {
 		return errInvalidConfigFile
 	}
+	// Only loading the file takes in its content. Other reads, like checking the password, must not remember it,
+	// or the next save would overwrite what another instance saved since we loaded it instead of merging it in.
+	a.rememberFileContent(contents)
+
+	if err := a.loadContent(contents, ks); err != nil {
+		return err
+	}
+
+	a.rememberSaved()
+	a.accountLoaded()
+
+	return nil
+}
+
+// loadContent reads the configuration from the content of a configuration file, decrypting it if needed
+func (a *ApplicationConfig) loadContent(contents []byte, ks KeySupplier) error {
+	container, err := parseEncryptedContainer(contents)
+	switch err {
+	case nil:
+		return a.loadFromContainer(container, ks)
+	case errUnsupportedEncryptionParameters:
+		return err
+	case errNotAContainer:
+	default:
+		return errInvalidConfigFile
+	}
+
 	_, err = parseEncryptedData(contents)
 	switch err {
 	case nil:
@@ -192,6 +275,8 @@ func (a *ApplicationConfig) tryLoad(ks KeySupplier) error {
 		}
 	case errDecryptionParamsEmpty:
 		a.shouldEncrypt = false
+	case errUnsupportedEncryptionParameters:
+		return err
 	default:
 		return errInvalidConfigFile
 	}
@@ -203,9 +288,7 @@ func (a *ApplicationConfig) tryLoad(ks KeySupplier) error {
 		return errInvalidConfigFile
 	}
 
-	a.accountLoaded()
-
-	return nil
+	return a.checkVersion()
 }
 
 // Add will add the account to the application configuration
@@ -252,8 +335,35 @@ func (a *ApplicationConfig) GetAccount(jid string) (*Account, bool) {
 func (a *ApplicationConfig) Save(ks KeySupplier) error {
 	a.ioLock.Lock()
 	defer a.ioLock.Unlock()
-	a.onBeforeSave()
+	return a.save(ks)
+}
+
+func (a *ApplicationConfig) save(ks KeySupplier) (err error) {
 	defer a.onAfterSave()
+	a.keySupplier = ks
+
+	lock, err := lockConfigFile(a.filename, true)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	// Changes are merged first, so the unique ID another instance generated is kept
+	changes, err := a.mergeChangesMadeElsewhere(ks)
+	if err != nil {
+		return err
+	}
+	a.onBeforeSave()
+
+	moved, err := a.ensureOwnDataDirectory()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil && moved {
+			_ = a.moveDataDirectory("")
+		}
+	}()
 
 	contents, err := a.serialize()
 	if err != nil {
@@ -268,30 +378,38 @@ func (a *ApplicationConfig) Save(ks KeySupplier) error {
 		if a.params == nil {
 			ps := newEncryptionParameters()
 			a.params = &ps
-		} else {
-			a.params.regenerateNonce()
 		}
 
-		contents, err = encryptConfiguration(string(contents), a.params, ks)
+		contents, err = a.encrypt(contents, ks)
 		if err != nil {
 			return err
 		}
 	}
 
-	return safeWrite(a.filename, contents, 0600)
+	if err := safeWriteKeepingBackups(a.filename, contents, 0600, configBackupsKept); err != nil {
+		return err
+	}
+
+	a.rememberFileContent(contents)
+	a.rememberSaved()
+	a.reportChangesMadeElsewhere(changes)
+	return nil
 }
 
-// UpdateToLatestVersion will run through all accounts and update their configuration to latest version
-// for cases where we have changed the configuration format.
-// It returns true if any changes were made
+// UpdateToLatestVersion will run the migrations the configuration hasn't been through yet, for cases where we have
+// changed the configuration format. The configuration file is backed up first, and left as it is if that fails.
+// It returns true if any changes were made, and the configuration should be saved
 func (a *ApplicationConfig) UpdateToLatestVersion() bool {
-	res := false
+	if a.Version >= currentConfigurationVersion {
+		return false
+	}
 
-	for _, acc := range a.Accounts {
-		res = acc.updateToLatestVersion() || res
+	if err := a.backUpBeforeMigration(); err != nil {
+		log.WithError(err).Warn("couldn't back up the configuration file before updating it")
+		return false
 	}
 
-	return res
+	return a.migrate()
 }
 
 var jsonMarshalIndentFunc = json.MarshalIndent
@@ -299,7 +417,32 @@ var jsonMarshalIndentFunc = json.MarshalIndent
 //TODO: This is where we generate a new JSON representation and serialize it.
 //We are currently serializing our internal representation (ApplicationConfig) directly.
 func (a *ApplicationConfig) serialize() ([]byte, error) {
-	return jsonMarshalIndentFunc(a, "", "\t")
+	if a.shouldEncrypt {
+		return jsonMarshalIndentFunc(a, "", "\t")
+	}
+	return jsonMarshalIndentFunc(a.withoutTorControlPasswords(), "", "\t")
+}
+
+// withoutTorControlPasswords returns the configuration as it should be written to a file that isn't encrypted.
+// The password for the control port of Tor is only kept in encrypted files. The accounts are copied, so the
+// passwords can still be used while running.
+func (a *ApplicationConfig) withoutTorControlPasswords() interface{} {
+	accounts := a.Accounts
+	if accounts != nil {
+		accounts = make([]*Account, len(a.Accounts))
+		for i, acc := range a.Accounts {
+			plain := *acc
+			plain.TorControlPassword = ""
+			accounts[i] = &plain
+		}
+	}
+
+	// The fields before the embedded configuration take precedence, and keep their place in the file
+	return struct {
+		Version  int `json:",omitempty"`
+		Accounts []*Account
+		*ApplicationConfig
+	}{a.Version, accounts, a}
 }
 
 // ByAccountNameAlphabetic sorts the accounts based on their account names
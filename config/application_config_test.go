@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/coyim/otr3"
@@ -296,6 +298,27 @@ func (s *AccountsSuite) Test_ApplicationConfig_Save_savesWithoutEncryption(c *C)
 		"}", a.UniqueConfigurationID))
 }
 
+func (s *AccountsSuite) Test_ApplicationConfig_Save_keepsTheTorControlPasswordOnlyInEncryptedFiles(c *C) {
+	defer cheapArgon2()()
+	configFile := filepath.Join(c.MkDir(), "accounts.json")
+	a := &ApplicationConfig{filename: configFile}
+	a.Add(&Account{Account: "test1@example.com", TorControlPassword: "control port password"})
+
+	c.Assert(a.Save(nil), IsNil)
+	content, err := ioutil.ReadFile(configFile)
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(content), "control port password"), Equals, false)
+	c.Assert(strings.Contains(string(content), "test1@example.com"), Equals, true)
+	c.Assert(a.Accounts[0].TorControlPassword, Equals, "control port password")
+
+	a.SetShouldSaveFileEncrypted(true)
+	c.Assert(a.Save(passwordSupplier(testPassword)), IsNil)
+
+	b, _, err := LoadOrCreate(a.filename, passwordSupplier(testPassword))
+	c.Assert(err, IsNil)
+	c.Assert(b.Accounts[0].TorControlPassword, Equals, "control port password")
+}
+
 type mockKeySupplier struct {
 	generateKey func(EncryptionParameters) ([]byte, []byte, bool)
 }
@@ -337,7 +360,7 @@ func (s *AccountsSuite) Test_ApplicationConfig_Save_savesWithEncryption_withNewP
 	content, ex := ioutil.ReadFile(a.filename)
 	c.Assert(ex, IsNil)
 
-	ed, e2 := parseEncryptedData(content)
+	ed, e2 := ownSlotIn(content, a)
 	c.Assert(e2, IsNil)
 	c.Assert(ed.Data, Not(Equals), "")
 	c.Assert(ed.Params.Nonce, Equals, a.params.Nonce)
@@ -389,7 +412,7 @@ func (s *AccountsSuite) Test_ApplicationConfig_Save_savesWithEncryption_withExis
 	content, ee := ioutil.ReadFile(a.filename)
 	c.Assert(ee, IsNil)
 
-	ed, e2 := parseEncryptedData(content)
+	ed, e2 := ownSlotIn(content, a)
 	c.Assert(e2, IsNil)
 	c.Assert(ed.Data, Not(Equals), "")
 	c.Assert(ed.Params.Nonce, Equals, a.params.Nonce)
@@ -426,7 +449,7 @@ func (s *AccountsSuite) Test_ApplicationConfig_Save_savesWithEncryption_doesntAd
 	content, ex2 := ioutil.ReadFile(a.filename)
 	c.Assert(ex2, IsNil)
 
-	ed, e2 := parseEncryptedData(content)
+	ed, e2 := ownSlotIn(content, a)
 	c.Assert(e2, IsNil)
 	c.Assert(ed.Data, Not(Equals), "")
 }
@@ -441,7 +464,7 @@ func (s *AccountsSuite) Test_ApplicationConfig_Save_failsOnSerialization(c *C) {
 		return nil, errors.New("ser went wrong")
 	}
 
-	a := &ApplicationConfig{}
+	a := &ApplicationConfig{filename: filepath.Join(c.MkDir(), "accounts.json")}
 
 	e := a.Save(nil)
 	c.Assert(e, ErrorMatches, "ser went wrong")
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// configBackupsKept is the number of previous versions of the configuration file that are kept next to it.
+// They are copies of the file as it was, so they are encrypted in the same way.
+const configBackupsKept = 5
+
+// ConfigurationBackup is a previous version of the configuration file
+type ConfigurationBackup struct {
+	Name    string
+	SavedAt time.Time
+	// Accounts are the accounts in the backup, if it could be opened
+	Accounts []string
+	// Err tells why the backup couldn't be opened
+	Err error
+}
+
+// HasBackups returns true if there are previous versions of the configuration file to restore
+func (a *ApplicationConfig) HasBackups() bool {
+	return len(backupsOf(a.filename)) > 0
+}
+
+// Backups returns the previous versions of the configuration file, newest first. They are opened
+// with the key supplier, to tell which accounts are in them.
+func (a *ApplicationConfig) Backups(ks KeySupplier) []*ConfigurationBackup {
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if fileExists(a.filename) {
+		if lock, err := lockConfigFile(a.filename, false); err == nil {
+			defer lock.unlock()
+		}
+	}
+
+	var res []*ConfigurationBackup
+	for _, name := range backupsOf(a.filename) {
+		b := &ConfigurationBackup{Name: name}
+		if info, err := os.Stat(name); err == nil {
+			b.SavedAt = info.ModTime()
+		}
+
+		opened, _, err := openBackup(name, ks)
+		if err != nil {
+			b.Err = err
+		} else {
+			for _, acc := range opened.Accounts {
+				b.Accounts = append(b.Accounts, acc.Account)
+			}
+		}
+
+		res = append(res, b)
+	}
+	return res
+}
+
+// openBackup reads a backup of the configuration file, in the same way the configuration file itself is read
+func openBackup(name string, ks KeySupplier) (*ApplicationConfig, []byte, error) {
+	contents, err := ioutil.ReadFile(filepath.Clean(name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := &ApplicationConfig{filename: name}
+	if err := b.loadContent(contents, ks); err != nil {
+		return nil, nil, err
+	}
+	return b, contents, nil
+}
+
+// RestoreBackup replaces the configuration file with the backup, after making sure it can be opened. The
+// configuration is replaced with what is in the backup as well. The configuration file as it was becomes
+// the newest backup, so the restore can be undone.
+func (a *ApplicationConfig) RestoreBackup(b *ConfigurationBackup, ks KeySupplier) error {
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	lock, err := lockConfigFile(a.filename, true)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	restored, contents, err := openBackup(b.Name, ks)
+	if err != nil {
+		return err
+	}
+
+	if err := safeWriteKeepingBackups(a.filename, contents, 0600, configBackupsKept); err != nil {
+		return err
+	}
+
+	copyExportedFields(a, restored, "")
+	a.shouldEncrypt = restored.shouldEncrypt
+	a.params = restored.params
+	a.container, a.slot = restored.container, restored.slot
+	a.keySupplier = ks
+
+	a.rememberFileContent(contents)
+	a.rememberSaved()
+	a.accountLoaded()
+	return nil
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type BackupsSuite struct{}
+
+var _ = Suite(&BackupsSuite{})
+
+func (s *BackupsSuite) Test_safeWriteKeepingBackups_keepsTheNewestVersions(c *C) {
+	name := filepath.Join(c.MkDir(), "accounts.json")
+
+	for i := 1; i <= 5; i++ {
+		c.Assert(safeWriteKeepingBackups(name, []byte(fmt.Sprintf("version number %d", i)), 0600, 3), IsNil)
+	}
+
+	backups := backupsOf(name)
+	c.Assert(backups, DeepEquals, []string{backupName(name, 0), backupName(name, 1), backupName(name, 2)})
+	for i, b := range backups {
+		content, _ := ioutil.ReadFile(b)
+		c.Assert(string(content), Equals, fmt.Sprintf("version number %d", 4-i))
+	}
+}
+
+func (s *BackupsSuite) Test_ApplicationConfig_Backups_opensEncryptedBackups(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+
+	for i := 0; i < configBackupsKept+2; i++ {
+		a.Accounts[0].Nickname = fmt.Sprintf("nick %d", i)
+		c.Assert(a.Save(a.keySupplier), IsNil)
+	}
+
+	backups := a.Backups(passwordSupplier(testPassword))
+	c.Assert(backups, HasLen, configBackupsKept)
+	for _, b := range backups {
+		c.Assert(b.Err, IsNil)
+		c.Assert(b.Accounts, DeepEquals, []string{"test1@example.com"})
+		c.Assert(b.SavedAt.IsZero(), Equals, false)
+
+		content, _ := ioutil.ReadFile(b.Name)
+		c.Assert(strings.Contains(string(content), "test1@example.com"), Equals, false)
+	}
+	c.Assert(backupsOf(configFile), HasLen, configBackupsKept)
+
+	backups = a.Backups(passwordSupplier(newTestPassword))
+	c.Assert(backups[0].Err, NotNil)
+	c.Assert(backups[0].Accounts, IsNil)
+}
+
+func (s *BackupsSuite) Test_ApplicationConfig_RestoreBackup_replacesTheConfiguration(c *C) {
+	configFile := setUpPlainConfig(c)
+	a, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+
+	a.Add(&Account{Account: "test2@example.com"})
+	c.Assert(a.Save(nil), IsNil)
+
+	backups := a.Backups(nil)
+	c.Assert(backups, HasLen, 1)
+	c.Assert(backups[0].Accounts, DeepEquals, []string{"test1@example.com"})
+
+	c.Assert(a.RestoreBackup(backups[0], nil), IsNil)
+	c.Assert(a.Accounts, HasLen, 1)
+	c.Assert(a.UniqueConfigurationID, Equals, "00112233")
+
+	restored, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(restored.Accounts, HasLen, 1)
+
+	// The configuration as it was before the restore is kept as well
+	backups = a.Backups(nil)
+	c.Assert(backups, HasLen, 2)
+	c.Assert(backups[0].Accounts, DeepEquals, []string{"test1@example.com", "test2@example.com"})
+
+	a.Accounts[0].Nickname = "me"
+	c.Assert(a.Save(nil), IsNil)
+	again, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(again.Accounts, HasLen, 1)
+	c.Assert(again.Accounts[0].Nickname, Equals, "me")
+}
+
+func (s *BackupsSuite) Test_ApplicationConfig_RestoreBackup_leavesTheFileAloneIfTheBackupCantBeOpened(c *C) {
+	configFile := setUpPlainConfig(c)
+	c.Assert(ioutil.WriteFile(backupName(configFile, 0), []byte("{ this is not what it should be"), 0600), IsNil)
+
+	a, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+
+	backups := a.Backups(nil)
+	c.Assert(backups, HasLen, 1)
+	c.Assert(backups[0].Err, NotNil)
+
+	c.Assert(a.RestoreBackup(backups[0], nil), NotNil)
+	content, _ := ioutil.ReadFile(configFile)
+	c.Assert(string(content), Equals, twoWritersTestConfig)
+	c.Assert(backupsOf(configFile), HasLen, 1)
+}
+
+func (s *BackupsSuite) Test_ApplicationConfig_RestoreBackup_recoversACorruptedConfigurationFile(c *C) {
+	configFile := setUpPlainConfig(c)
+	a, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(a.Save(nil), IsNil)
+	c.Assert(ioutil.WriteFile(configFile, []byte("{ this is not what it should be"), 0600), IsNil)
+
+	broken, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, Equals, errInvalidConfigFile)
+	c.Assert(broken.HasBackups(), Equals, true)
+
+	c.Assert(broken.RestoreBackup(broken.Backups(nil)[0], nil), IsNil)
+	c.Assert(broken.Accounts, HasLen, 1)
+	c.Assert(broken.Accounts[0].Account, Equals, "test1@example.com")
+
+	_, _, err = LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+}
+
+func (s *BackupsSuite) Test_ApplicationConfig_Save_removesThePlainBackupsWhenEncryptionIsTurnedOn(c *C) {
+	defer cheapArgon2()()
+	configFile := setUpPlainConfig(c)
+	a, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(a.Save(nil), IsNil)
+	c.Assert(backupsOf(configFile), HasLen, 1)
+
+	a.SetShouldSaveFileEncrypted(true)
+	c.Assert(a.Save(passwordSupplier(testPassword)), IsNil)
+
+	c.Assert(fileExists(configFile), Equals, false)
+	c.Assert(backupsOf(configFile), HasLen, 0)
+}
@@ -0,0 +1,397 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+)
+
+// An account bundle carries one account to another configuration, usually on another computer. It is encrypted
+// with a passphrase of its own, so it can be moved around without giving away the main password or the other accounts.
+const (
+	accountBundleFormat  = "decoyim-account-bundle"
+	accountBundleVersion = 1
+)
+
+var (
+	// ErrEmptyBundlePassphrase is returned when exporting a bundle without a passphrase
+	ErrEmptyBundlePassphrase = errors.New("the bundle needs a passphrase")
+	// ErrWrongBundlePassphrase is returned when the bundle can't be decrypted with the given passphrase
+	ErrWrongBundlePassphrase = errors.New("the bundle can't be opened with this passphrase")
+	// ErrNotAnAccountBundle is returned when the file is not an account bundle
+	ErrNotAnAccountBundle = errors.New("the file is not an account bundle")
+	// ErrUnsupportedBundleVersion is returned when the bundle was written by a newer version
+	ErrUnsupportedBundleVersion = errors.New("the bundle was written by a newer version, and can't be read")
+)
+
+// BundledContact is a contact from the roster of the account
+type BundledContact struct {
+	JID    string
+	Name   string   `json:",omitempty"`
+	Groups []string `json:",omitempty"`
+}
+
+// AccountBundle is what an account bundle has in it
+type AccountBundle struct {
+	Account *Account
+	// Roster is optional, since the roster is also kept on the server
+	Roster []BundledContact `json:",omitempty"`
+	// History is optional. It's written and read by the history store, since the history isn't part of the configuration.
+	History json.RawMessage `json:",omitempty"`
+}
+
+type encryptedBundle struct {
+	Format  string
+	Version int
+	Params  EncryptionParameters
+	Data    string
+}
+
+// NewAccountBundle returns a bundle with a copy of the account, so the account can keep changing while the bundle is exported
+func NewAccountBundle(acc *Account) (*AccountBundle, error) {
+	contents, err := json.Marshal(acc)
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(Account)
+	if err := json.Unmarshal(contents, c); err != nil {
+		return nil, err
+	}
+	c.updateToLatestVersion()
+
+	return &AccountBundle{Account: c}, nil
+}
+
+// Encrypt returns the bundle encrypted with the passphrase, ready to be written to a file
+func (b *AccountBundle) Encrypt(passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyBundlePassphrase
+	}
+
+	contents, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+
+	params := newEncryptionParameters()
+	key, macKey := GenerateKeys(passphrase, params)
+	sealed := sealDataWith(string(contents), &params, key, macKey)
+
+	return json.MarshalIndent(encryptedBundle{
+		Format:  accountBundleFormat,
+		Version: accountBundleVersion,
+		Params:  sealed.Params,
+		Data:    sealed.Data,
+	}, "", "\t")
+}
+
+// OpenAccountBundle decrypts a bundle written by Encrypt
+func OpenAccountBundle(content []byte, passphrase string) (*AccountBundle, error) {
+	eb := new(encryptedBundle)
+	if err := json.Unmarshal(content, eb); err != nil || eb.Format != accountBundleFormat {
+		return nil, ErrNotAnAccountBundle
+	}
+
+	if eb.Version > accountBundleVersion {
+		return nil, ErrUnsupportedBundleVersion
+	}
+
+	ed := &encryptedData{Params: eb.Params, Data: eb.Data}
+	if err := ed.Params.deserialize(); err != nil {
+		return nil, ErrNotAnAccountBundle
+	}
+
+	key, macKey := GenerateKeys(passphrase, ed.Params)
+	contents, err := ed.decryptWith(generatedKeys{key, macKey})
+	if err != nil {
+		return nil, ErrWrongBundlePassphrase
+	}
+
+	b := new(AccountBundle)
+	if err := json.Unmarshal(contents, b); err != nil || b.Account == nil || b.Account.Account == "" {
+		return nil, ErrNotAnAccountBundle
+	}
+	b.Account.updateToLatestVersion()
+
+	return b, nil
+}
+
+// BundleMerge decides what happens when the account in a bundle is already in the configuration.
+// Keys, fingerprints, certificate pins and peers that are only in the bundle are always added.
+// Credentials - the password, the proxies and the Tor control password - are only taken from the bundle
+// when the account doesn't have them, whatever the merge.
+type BundleMerge int
+
+const (
+	// KeepExisting keeps the settings of the account in the configuration. Its keys are used before the ones
+	// from the bundle, and fingerprints it already has keep their trust and tags.
+	KeepExisting BundleMerge = iota
+	// PreferBundle takes the settings of the account from the bundle. Its keys are used before the ones already
+	// in the configuration, and fingerprints take their trust and tags from the bundle.
+	PreferBundle
+)
+
+// BundleImport tells what importing a bundle changed
+type BundleImport struct {
+	Account *Account
+	// New is true if the account wasn't in the configuration before
+	New bool
+
+	AddedKeys         int
+	AddedFingerprints int
+	AddedPins         int
+	AddedPeers        int
+	// Conflicts is the number of keys and fingerprints where the bundle and the configuration disagreed
+	Conflicts int
+	// SettingConflicts is the number of settings of the account where the bundle and the configuration disagreed
+	SettingConflicts int
+	// KeptCredentials are the names of the credentials that were different in the bundle. The ones in the configuration were kept.
+	KeptCredentials []string
+}
+
+// ImportAccountBundle adds the account in the bundle to the configuration, or merges it into the account with
+// the same JID. The history in the bundle isn't imported, since it isn't part of the configuration.
+// The configuration has to be saved afterwards.
+func (a *ApplicationConfig) ImportAccountBundle(b *AccountBundle, merge BundleMerge) *BundleImport {
+	res := &BundleImport{}
+
+	ex, ok := a.GetAccount(b.Account.Account)
+	if !ok {
+		ex = b.Account
+		a.Add(ex)
+		res.New = true
+	} else {
+		res.mergeAccount(ex, b.Account, merge)
+	}
+
+	res.Account = ex
+	res.mergeRoster(ex, b.Roster)
+	return res
+}
+
+func (res *BundleImport) mergeAccount(ex, in *Account, merge BundleMerge) {
+	keys := res.mergeKeys(ex.AllPrivateKeys(), in.AllPrivateKeys(), merge)
+	pins := res.mergePins(ex.Certificates, in.Certificates)
+	res.mergePeers(ex, in.Peers, merge)
+	res.mergeCredentials(ex, in)
+	res.mergeSettings(ex, in, merge)
+
+	ex.DeprecatedPrivateKey = nil
+	ex.PrivateKeys = keys
+	ex.Certificates = pins
+}
+
+// bundleCredentials are the fields of the account that hold credentials, in groups that are only taken together.
+// The salted keys come from the password, so they go with it.
+var bundleCredentials = [][]string{
+	{"Password", "StoreSaltedKeys", "SaltedKeys"},
+	{"Proxies"},
+	{"TorControlPassword"},
+}
+
+// bundleMergedApart are the fields of the account that aren't settings - they identify the account, or are merged on their own.
+// The legacy fields have already been moved to their new places.
+var bundleMergedApart = map[string]bool{
+	"Account":                       true,
+	"PrivateKeys":                   true,
+	"DeprecatedPrivateKey":          true,
+	"Peers":                         true,
+	"Certificates":                  true,
+	"LegacyKnownFingerprints":       true,
+	"LegacyServerCertificateSHA256": true,
+}
+
+func isBundleCredential(name string) bool {
+	for _, g := range bundleCredentials {
+		for _, f := range g {
+			if f == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeCredentials takes the credentials from the bundle only when the account has none of them. Credentials that
+// differ are never replaced, since they decide how and where the account connects - they are reported instead.
+func (res *BundleImport) mergeCredentials(ex, in *Account) {
+	to, from := reflect.ValueOf(ex).Elem(), reflect.ValueOf(in).Elem()
+	for _, g := range bundleCredentials {
+		empty, same := true, true
+		for _, f := range g {
+			if !to.FieldByName(f).IsZero() {
+				empty = false
+			}
+			if !sameJSON(to.FieldByName(f).Interface(), from.FieldByName(f).Interface()) {
+				same = false
+			}
+		}
+
+		switch {
+		case same:
+		case empty:
+			for _, f := range g {
+				to.FieldByName(f).Set(from.FieldByName(f))
+			}
+		default:
+			res.KeptCredentials = append(res.KeptCredentials, g[0])
+		}
+	}
+}
+
+// mergeSettings goes through the settings of the account one by one. The ones that differ are conflicts,
+// and are taken from the bundle only if it should be preferred.
+func (res *BundleImport) mergeSettings(ex, in *Account, merge BundleMerge) {
+	to, from := reflect.ValueOf(ex).Elem(), reflect.ValueOf(in).Elem()
+	for i := 0; i < to.NumField(); i++ {
+		f := to.Type().Field(i)
+		if f.PkgPath != "" || bundleMergedApart[f.Name] || isBundleCredential(f.Name) {
+			continue
+		}
+
+		if sameJSON(to.Field(i).Interface(), from.Field(i).Interface()) {
+			continue
+		}
+
+		res.SettingConflicts++
+		if merge == PreferBundle {
+			to.Field(i).Set(from.Field(i))
+		}
+	}
+}
+
+func containsKey(keys [][]byte, k []byte) bool {
+	for _, kk := range keys {
+		if bytes.Equal(kk, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeKeys returns all the keys, with the ones that should be used first at the front.
+// Every key that ends up behind a different key from the other side is a conflict.
+func (res *BundleImport) mergeKeys(existing, imported [][]byte, merge BundleMerge) [][]byte {
+	first, second := existing, imported
+	if merge == PreferBundle {
+		first, second = imported, existing
+	}
+
+	var result [][]byte
+	for _, k := range append(append([][]byte{}, first...), second...) {
+		if !containsKey(result, k) {
+			result = append(result, k)
+		}
+	}
+
+	for _, k := range imported {
+		if !containsKey(existing, k) {
+			res.AddedKeys++
+		}
+	}
+
+	// The keys of the other side are only used if the first side has none
+	if len(first) > 0 {
+		for _, k := range second {
+			if !containsKey(first, k) {
+				res.Conflicts++
+			}
+		}
+	}
+
+	return result
+}
+
+func (res *BundleImport) mergePins(existing, imported []*CertificatePin) []*CertificatePin {
+	result := append([]*CertificatePin{}, existing...)
+
+	for _, p := range imported {
+		found := false
+		for _, e := range existing {
+			if e.FingerprintType == p.FingerprintType && bytes.Equal(e.Fingerprint, p.Fingerprint) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, p)
+			res.AddedPins++
+		}
+	}
+
+	sort.Sort(CertificatePinsByNaturalOrder(result))
+	return result
+}
+
+func (res *BundleImport) mergePeers(ex *Account, imported []*Peer, merge BundleMerge) {
+	for _, ip := range imported {
+		ep, ok := ex.GetPeer(ip.UserID)
+		if !ok {
+			ex.Peers = append(ex.Peers, ip)
+			res.AddedPeers++
+			res.AddedFingerprints += len(ip.Fingerprints)
+			continue
+		}
+
+		res.mergeFingerprints(ep, ip.Fingerprints, merge)
+		mergePeerSettings(ep, ip, merge)
+	}
+}
+
+func (res *BundleImport) mergeFingerprints(p *Peer, imported []*Fingerprint, merge BundleMerge) {
+	for _, f := range imported {
+		ef, ok := p.GetFingerprint(f.Fingerprint)
+		if !ok {
+			p.Fingerprints = append(p.Fingerprints, f)
+			res.AddedFingerprints++
+			continue
+		}
+
+		if ef.Trusted != f.Trusted || ef.Tag != f.Tag {
+			res.Conflicts++
+			if merge == PreferBundle {
+				ef.Trusted, ef.Tag = f.Trusted, f.Tag
+			}
+		}
+	}
+}
+
+// mergePeerSettings takes the settings for the peer from the bundle, or only the ones that aren't set
+// in the configuration, if the existing settings should be kept
+func mergePeerSettings(ep, ip *Peer, merge BundleMerge) {
+	prefer := merge == PreferBundle
+
+	if ip.Nickname != "" && (prefer || ep.Nickname == "") {
+		ep.Nickname = ip.Nickname
+	}
+	if ip.EncryptionSettings != "" && (prefer || ep.EncryptionSettings == "") {
+		ep.EncryptionSettings = ip.EncryptionSettings
+	}
+	if len(ip.Groups) > 0 && (prefer || len(ep.Groups) == 0) {
+		ep.Groups = ip.Groups
+	}
+	if prefer || (ep.HistoryMaxMessages == 0 && ep.HistoryMaxAgeDays == 0) {
+		ep.HistoryMaxMessages, ep.HistoryMaxAgeDays = ip.HistoryMaxMessages, ip.HistoryMaxAgeDays
+	}
+}
+
+// mergeRoster keeps the names and groups of the contacts in the roster, for contacts that don't have them yet
+func (res *BundleImport) mergeRoster(ex *Account, roster []BundledContact) {
+	for _, c := range roster {
+		p, ok := ex.GetPeer(c.JID)
+		if !ok {
+			p = ex.EnsurePeer(c.JID)
+			res.AddedPeers++
+		}
+
+		if p.Nickname == "" {
+			p.Nickname = c.Name
+		}
+		if len(p.Groups) == 0 {
+			p.Groups = c.Groups
+		}
+	}
+}
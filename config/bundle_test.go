@@ -0,0 +1,258 @@
+package config
+
+import (
+	"encoding/json"
+
+	. "gopkg.in/check.v1"
+)
+
+type BundleSuite struct{}
+
+var _ = Suite(&BundleSuite{})
+
+const bundleTestPassphrase = "carried over by hand"
+
+func bundleTestAccount() *Account {
+	a := &Account{
+		Account:       "someone@example.org",
+		Password:      "secret",
+		Proxies:       []string{"tor-auto://"},
+		PrivateKeys:   [][]byte{[]byte("key one")},
+		PinningPolicy: "deny",
+		Certificates:  []*CertificatePin{{Subject: "example.org", FingerprintType: "SHA3-256", Fingerprint: []byte{1, 2, 3}}},
+	}
+	a.AddTrustedFingerprint([]byte{0xAA, 0xBB}, "alice@example.org", "alice's laptop")
+	a.EnsurePeer("bob@example.org").EncryptionSettings = AlwaysEncrypt
+	return a
+}
+
+func (s *BundleSuite) Test_AccountBundle_Encrypt_and_OpenAccountBundle(c *C) {
+	defer cheapArgon2()()
+
+	b, err := NewAccountBundle(bundleTestAccount())
+	c.Assert(err, IsNil)
+	b.Roster = []BundledContact{{JID: "carol@example.org", Name: "Carol", Groups: []string{"Friends"}}}
+	b.History = json.RawMessage(`[{"Peer":"alice@example.org"}]`)
+
+	content, err := b.Encrypt(bundleTestPassphrase)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Not(Matches), "(?s).*someone@example.org.*")
+
+	opened, err := OpenAccountBundle(content, bundleTestPassphrase)
+	c.Assert(err, IsNil)
+	c.Assert(opened.Account.Account, Equals, "someone@example.org")
+	c.Assert(opened.Account.Password, Equals, "secret")
+	c.Assert(opened.Account.PrivateKeys, DeepEquals, [][]byte{[]byte("key one")})
+	c.Assert(opened.Account.Certificates, DeepEquals, b.Account.Certificates)
+	trusted, tag := opened.Account.Peers[0].HasTrustedFingerprint([]byte{0xAA, 0xBB})
+	c.Assert(trusted, Equals, true)
+	c.Assert(tag, Equals, "alice's laptop")
+	c.Assert(opened.Roster, DeepEquals, b.Roster)
+	c.Assert(string(opened.History), Equals, string(b.History))
+}
+
+func (s *BundleSuite) Test_NewAccountBundle_copiesTheAccount(c *C) {
+	acc := bundleTestAccount()
+	b, _ := NewAccountBundle(acc)
+
+	acc.Password = "changed"
+	acc.Peers[0].Fingerprints[0].Trusted = false
+
+	c.Assert(b.Account.Password, Equals, "secret")
+	c.Assert(b.Account.Peers[0].Fingerprints[0].Trusted, Equals, true)
+}
+
+func (s *BundleSuite) Test_OpenAccountBundle_failsWithTheWrongPassphraseOrFile(c *C) {
+	defer cheapArgon2()()
+
+	b, _ := NewAccountBundle(bundleTestAccount())
+	content, _ := b.Encrypt(bundleTestPassphrase)
+
+	_, err := OpenAccountBundle(content, "something else")
+	c.Assert(err, Equals, ErrWrongBundlePassphrase)
+
+	_, err = OpenAccountBundle([]byte(`{"Accounts": []}`), bundleTestPassphrase)
+	c.Assert(err, Equals, ErrNotAnAccountBundle)
+
+	_, err = OpenAccountBundle([]byte(`{"Format": "decoyim-account-bundle", "Version": 2}`), bundleTestPassphrase)
+	c.Assert(err, Equals, ErrUnsupportedBundleVersion)
+
+	_, err = b.Encrypt("")
+	c.Assert(err, Equals, ErrEmptyBundlePassphrase)
+}
+
+func (s *BundleSuite) Test_ApplicationConfig_ImportAccountBundle_addsANewAccount(c *C) {
+	a := &ApplicationConfig{}
+	a.Add(&Account{Account: "other@example.org"})
+	b, _ := NewAccountBundle(bundleTestAccount())
+	b.Roster = []BundledContact{{JID: "carol@example.org", Name: "Carol", Groups: []string{"Friends"}}}
+
+	res := a.ImportAccountBundle(b, KeepExisting)
+
+	c.Assert(res.New, Equals, true)
+	c.Assert(a.Accounts, HasLen, 2)
+	acc, ok := a.GetAccount("someone@example.org")
+	c.Assert(ok, Equals, true)
+	c.Assert(acc, Equals, res.Account)
+	carol, ok := acc.GetPeer("carol@example.org")
+	c.Assert(ok, Equals, true)
+	c.Assert(carol.Nickname, Equals, "Carol")
+	c.Assert(carol.Groups, DeepEquals, []string{"Friends"})
+}
+
+func existingAccountForMerge() *Account {
+	acc := &Account{
+		Account:       "someone@example.org",
+		Password:      "old secret",
+		PrivateKeys:   [][]byte{[]byte("key two")},
+		PinningPolicy: "add",
+		Certificates:  []*CertificatePin{{Subject: "example.org", FingerprintType: "SHA3-256", Fingerprint: []byte{1, 2, 3}}},
+	}
+	fpr, _ := acc.EnsurePeer("alice@example.org").EnsureHasFingerprint([]byte{0xAA, 0xBB})
+	fpr.Tag = "unverified"
+	acc.EnsurePeer("dave@example.org").Nickname = "Dave"
+	return acc
+}
+
+func (s *BundleSuite) Test_ApplicationConfig_ImportAccountBundle_keepsTheExistingAccount(c *C) {
+	a := &ApplicationConfig{}
+	existing := existingAccountForMerge()
+	id := existing.ID()
+	a.Add(existing)
+	b, _ := NewAccountBundle(bundleTestAccount())
+
+	res := a.ImportAccountBundle(b, KeepExisting)
+
+	c.Assert(res.New, Equals, false)
+	c.Assert(res.Account, Equals, existing)
+	c.Assert(a.Accounts, HasLen, 1)
+	c.Assert(existing.ID(), Equals, id)
+	c.Assert(existing.Password, Equals, "old secret")
+	c.Assert(existing.PinningPolicy, Equals, "add")
+	c.Assert(existing.PrivateKeys, DeepEquals, [][]byte{[]byte("key two"), []byte("key one")})
+	c.Assert(existing.Certificates, HasLen, 1)
+
+	trusted, _ := existing.Peers[0].HasTrustedFingerprint([]byte{0xAA, 0xBB})
+	c.Assert(trusted, Equals, false)
+	c.Assert(existing.Peers[0].Fingerprints, HasLen, 1)
+	bob, ok := existing.GetPeer("bob@example.org")
+	c.Assert(ok, Equals, true)
+	c.Assert(bob.EncryptionSettings, Equals, EncryptionSettings(AlwaysEncrypt))
+	_, ok = existing.GetPeer("dave@example.org")
+	c.Assert(ok, Equals, true)
+
+	c.Assert(res.AddedKeys, Equals, 1)
+	c.Assert(res.AddedFingerprints, Equals, 0)
+	c.Assert(res.AddedPins, Equals, 0)
+	c.Assert(res.AddedPeers, Equals, 1)
+	c.Assert(res.Conflicts, Equals, 2)
+	c.Assert(res.SettingConflicts, Equals, 1)
+	c.Assert(res.KeptCredentials, DeepEquals, []string{"Password"})
+}
+
+func (s *BundleSuite) Test_ApplicationConfig_ImportAccountBundle_canPreferTheBundle(c *C) {
+	a := &ApplicationConfig{}
+	existing := existingAccountForMerge()
+	id := existing.ID()
+	a.Add(existing)
+	b, _ := NewAccountBundle(bundleTestAccount())
+
+	res := a.ImportAccountBundle(b, PreferBundle)
+
+	c.Assert(res.Account, Equals, existing)
+	c.Assert(existing.ID(), Equals, id)
+	c.Assert(existing.Password, Equals, "old secret")
+	c.Assert(existing.PinningPolicy, Equals, "deny")
+	c.Assert(existing.Proxies, DeepEquals, []string{"tor-auto://"})
+	c.Assert(existing.PrivateKeys, DeepEquals, [][]byte{[]byte("key one"), []byte("key two")})
+
+	trusted, tag := existing.Peers[0].HasTrustedFingerprint([]byte{0xAA, 0xBB})
+	c.Assert(trusted, Equals, true)
+	c.Assert(tag, Equals, "alice's laptop")
+	dave, ok := existing.GetPeer("dave@example.org")
+	c.Assert(ok, Equals, true)
+	c.Assert(dave.Nickname, Equals, "Dave")
+	c.Assert(res.Conflicts, Equals, 2)
+	c.Assert(res.SettingConflicts, Equals, 1)
+	c.Assert(res.KeptCredentials, DeepEquals, []string{"Password"})
+}
+
+func (s *BundleSuite) Test_ApplicationConfig_ImportAccountBundle_neverReplacesCredentials(c *C) {
+	a := &ApplicationConfig{}
+	existing := existingAccountForMerge()
+	existing.Proxies = []string{"socks5://127.0.0.1:9050"}
+	existing.StoreSaltedKeys = true
+	existing.SaltedKeys = []*SaltedKeys{{Mechanism: "SCRAM-SHA-256"}}
+	a.Add(existing)
+	in := bundleTestAccount()
+	in.TorControlPassword = "control"
+	b, _ := NewAccountBundle(in)
+
+	res := a.ImportAccountBundle(b, PreferBundle)
+
+	c.Assert(existing.Password, Equals, "old secret")
+	c.Assert(existing.SaltedKeys, HasLen, 1)
+	c.Assert(existing.Proxies, DeepEquals, []string{"socks5://127.0.0.1:9050"})
+	c.Assert(existing.TorControlPassword, Equals, "control")
+	c.Assert(res.KeptCredentials, DeepEquals, []string{"Password", "Proxies"})
+}
+
+func (s *BundleSuite) Test_ApplicationConfig_ImportAccountBundle_countsEverySettingThatDiffers(c *C) {
+	a := &ApplicationConfig{}
+	existing := bundleTestAccount()
+	a.Add(existing)
+	in := bundleTestAccount()
+	in.Nickname = "Someone"
+	in.DANEPolicy = "require"
+	in.KeepHistory = true
+	b, _ := NewAccountBundle(in)
+
+	res := a.ImportAccountBundle(b, KeepExisting)
+
+	c.Assert(res.SettingConflicts, Equals, 3)
+	c.Assert(existing.Nickname, Equals, "")
+	c.Assert(existing.KeepHistory, Equals, false)
+
+	res = a.ImportAccountBundle(b, PreferBundle)
+
+	c.Assert(res.SettingConflicts, Equals, 3)
+	c.Assert(existing.Nickname, Equals, "Someone")
+	c.Assert(existing.DANEPolicy, Equals, "require")
+	c.Assert(existing.KeepHistory, Equals, true)
+	c.Assert(res.Conflicts, Equals, 0)
+	c.Assert(res.KeptCredentials, HasLen, 0)
+}
+
+func (s *BundleSuite) Test_BundleImport_mergeKeys_countsEveryKeyThatIsNotUsedFirst(c *C) {
+	one, two, three := []byte("key one"), []byte("key two"), []byte("key three")
+
+	res := &BundleImport{}
+	keys := res.mergeKeys([][]byte{one}, [][]byte{two, three}, KeepExisting)
+	c.Assert(keys, DeepEquals, [][]byte{one, two, three})
+	c.Assert(res.AddedKeys, Equals, 2)
+	c.Assert(res.Conflicts, Equals, 2)
+
+	res = &BundleImport{}
+	keys = res.mergeKeys([][]byte{one, two}, [][]byte{two}, PreferBundle)
+	c.Assert(keys, DeepEquals, [][]byte{two, one})
+	c.Assert(res.AddedKeys, Equals, 0)
+	c.Assert(res.Conflicts, Equals, 1)
+
+	res = &BundleImport{}
+	res.mergeKeys(nil, [][]byte{one}, KeepExisting)
+	c.Assert(res.AddedKeys, Equals, 1)
+	c.Assert(res.Conflicts, Equals, 0)
+}
+
+func (s *BundleSuite) Test_ApplicationConfig_ImportAccountBundle_hasNoConflictsWithTheSameKeys(c *C) {
+	a := &ApplicationConfig{}
+	a.Add(bundleTestAccount())
+	b, _ := NewAccountBundle(bundleTestAccount())
+
+	res := a.ImportAccountBundle(b, KeepExisting)
+
+	c.Assert(res.Conflicts, Equals, 0)
+	c.Assert(res.AddedKeys, Equals, 0)
+	c.Assert(res.AddedPeers, Equals, 0)
+	c.Assert(a.Accounts[0].PrivateKeys, HasLen, 1)
+}
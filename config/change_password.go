@@ -0,0 +1,106 @@
+package config
+
+import "errors"
+
+// ErrWrongPassword is returned when changing the password, if the current password doesn't open the configuration file
+var ErrWrongPassword = errors.New("the current password is not correct")
+
+var errNotEncrypted = errors.New("the configuration file is not encrypted")
+
+// keyRememberer is implemented by key suppliers that can be told the keys for new encryption parameters
+type keyRememberer interface {
+	rememberKeys(params EncryptionParameters, key, macKey []byte)
+}
+
+func (pk *passwordKeySupplier) rememberKeys(params EncryptionParameters, key, macKey []byte) {
+	pk.Lock()
+	defer pk.Unlock()
+	pk.keys[params.kdfID()] = generatedKeys{key, macKey}
+}
+
+// ChangePassword encrypts the configuration file and the data files next to it with keys generated from the new password,
+// using new encryption parameters. The current password has to open the configuration file as it is on disk.
+// If the application stops in the middle of it, the files can be opened with either the old or the new password, never with a mix.
+// The keys derived for other purposes, such as for the conversation history, come from a random secret in the
+// configuration file instead of the password, so they stay the same, and nothing encrypted with them depends on the old password.
+// If possible, the key supplier is told the new keys, so it doesn't have to ask for the new password.
+func (a *ApplicationConfig) ChangePassword(ks KeySupplier, currentPassword, newPassword string) error {
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if !a.shouldEncrypt || a.params == nil {
+		return errNotEncrypted
+	}
+
+	old := *a.params
+	key, macKey, err := a.checkPassword(currentPassword)
+	if err != nil {
+		return err
+	}
+	keys := knownKeysSupplier()
+	keys.rememberKeys(old, key, macKey)
+
+	np := newEncryptionParameters()
+	key, macKey = GenerateKeys(newPassword, np)
+	keys.rememberKeys(np, key, macKey)
+
+	if err := a.rekey(&np, keys); err != nil {
+		return err
+	}
+
+	if r, ok := ks.(keyRememberer); ok {
+		r.rememberKeys(np, key, macKey)
+	}
+	a.keySupplier = ks
+
+	return nil
+}
+
+// CheckPassword returns ErrWrongPassword if the password doesn't open this profile in the configuration file as it is on disk,
+// for things that should only be done by someone who knows the password, even when the application is already unlocked
+func (a *ApplicationConfig) CheckPassword(password string) error {
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if !a.shouldEncrypt || a.params == nil {
+		return errNotEncrypted
+	}
+
+	_, _, err := a.checkPassword(password)
+	return err
+}
+
+// checkPassword returns the keys the password generates, if they open this profile in the configuration file
+func (a *ApplicationConfig) checkPassword(password string) ([]byte, []byte, error) {
+	params := *a.params
+	key, macKey := GenerateKeys(password, params)
+	keys := knownKeysSupplier()
+	keys.rememberKeys(params, key, macKey)
+
+	contents, err := a.readConfigFile()
+	if err != nil {
+		return nil, nil, err
+	}
+	if c, err := parseEncryptedContainer(contents); err == nil {
+		if a.slot >= len(c.Slots) || c.Slots[a.slot].Params.kdfID() != params.kdfID() {
+			return nil, nil, ErrWrongPassword
+		}
+		if _, err := c.Slots[a.slot].decryptWith(generatedKeys{key, macKey}); err != nil {
+			return nil, nil, ErrWrongPassword
+		}
+	} else if _, _, err := decryptConfiguration(contents, keys); err != nil {
+		return nil, nil, ErrWrongPassword
+	}
+
+	return key, macKey, nil
+}
+
+// knownKeysSupplier returns a key supplier that only has the keys it is told about, and never asks for a password
+func knownKeysSupplier() *passwordKeySupplier {
+	return &passwordKeySupplier{
+		keys: make(map[string]generatedKeys),
+		getPassword: func(bool) (string, bool) {
+			return "", false
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type ChangePasswordSuite struct{}
+
+var _ = Suite(&ChangePasswordSuite{})
+
+const newTestPassword = "staple horse battery correct"
+
+func passwordSupplier(password string) KeySupplier {
+	return PasswordKeySupplier(func(bool) (string, bool) {
+		return password, true
+	})
+}
+
+// setUpEncryptedConfig writes an encrypted configuration file and a data file with the test password, and loads it
+func setUpEncryptedConfig(c *C) (*ApplicationConfig, string) {
+	dir := c.MkDir()
+	configFile := filepath.Join(dir, "accounts.json.enc")
+
+	params := newEncryptionParameters()
+	writeEncryptedTestFile(c, configFile, rekeyTestConfig, params)
+	writeEncryptedTestFile(c, filepath.Join(dir, "outbox.json.enc"), "some important data", params)
+
+	a, ok, err := LoadOrCreate(configFile, passwordSupplier(testPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+
+	return a, configFile
+}
+
+func assertOpensWith(c *C, configFile, password string) *ApplicationConfig {
+	a, ok, err := LoadOrCreate(configFile, passwordSupplier(password))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(a.Accounts, HasLen, 1)
+
+	data, err := a.DataFile("outbox.json").Load()
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "some important data")
+
+	return a
+}
+
+func assertDoesNotOpenWith(c *C, configFile, password string) {
+	_, ok, err := LoadOrCreate(configFile, passwordSupplier(password))
+	c.Assert(ok, Equals, false)
+	c.Assert(err, Equals, errDecryptionFailed)
+}
+
+func assertNoRekeyLeftovers(c *C, dir string) {
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "*~"))
+	c.Assert(leftovers, HasLen, 0)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_ChangePassword_reencryptsEverythingWithTheNewPassword(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	historyKey, _ := a.DeriveKey("history")
+
+	asked := 0
+	ks := PasswordKeySupplier(func(bool) (string, bool) {
+		asked++
+		return "", false
+	})
+
+	c.Assert(a.ChangePassword(ks, testPassword, newTestPassword), IsNil)
+	assertNoRekeyLeftovers(c, filepath.Dir(configFile))
+
+	c.Assert(a.Save(ks), IsNil)
+	c.Assert(asked, Equals, 0)
+
+	assertDoesNotOpenWith(c, configFile, testPassword)
+	a2 := assertOpensWith(c, configFile, newTestPassword)
+
+	k, err := a2.DeriveKey("history")
+	c.Assert(err, IsNil)
+	c.Assert(k, DeepEquals, historyKey)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_ChangePassword_failsWithTheWrongCurrentPassword(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	before, _ := ioutil.ReadFile(configFile)
+
+	c.Assert(a.ChangePassword(passwordSupplier(testPassword), "not the password", newTestPassword), Equals, ErrWrongPassword)
+
+	after, _ := ioutil.ReadFile(configFile)
+	c.Assert(after, DeepEquals, before)
+	assertOpensWith(c, configFile, testPassword)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_ChangePassword_failsForUnencryptedConfiguration(c *C) {
+	a := &ApplicationConfig{filename: filepath.Join(c.MkDir(), "accounts.json")}
+	c.Assert(a.ChangePassword(passwordSupplier(testPassword), testPassword, newTestPassword), Equals, errNotEncrypted)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_ChangePassword_keepsTheOldPasswordIfInterruptedBeforeTheJournal(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+
+	orig := writeRekeyJournal
+	defer func() { writeRekeyJournal = orig }()
+	writeRekeyJournal = func(string, []string) error {
+		panic("the application stops")
+	}
+
+	c.Assert(func() { _ = a.ChangePassword(passwordSupplier(testPassword), testPassword, newTestPassword) }, PanicMatches, "the application stops")
+	writeRekeyJournal = orig
+
+	written, _ := filepath.Glob(filepath.Join(filepath.Dir(configFile), "*"+rekeyExtension))
+	c.Assert(written, HasLen, 2)
+
+	assertOpensWith(c, configFile, testPassword)
+	assertNoRekeyLeftovers(c, filepath.Dir(configFile))
+	assertDoesNotOpenWith(c, configFile, newTestPassword)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_ChangePassword_usesTheNewPasswordIfInterruptedAfterTheJournal(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+
+	orig := writeRekeyJournal
+	defer func() { writeRekeyJournal = orig }()
+	writeRekeyJournal = func(dir string, files []string) error {
+		c.Assert(orig(dir, files), IsNil)
+		panic("the application stops")
+	}
+
+	c.Assert(func() { _ = a.ChangePassword(passwordSupplier(testPassword), testPassword, newTestPassword) }, PanicMatches, "the application stops")
+	writeRekeyJournal = orig
+
+	assertOpensWith(c, configFile, newTestPassword)
+	assertNoRekeyLeftovers(c, filepath.Dir(configFile))
+	assertDoesNotOpenWith(c, configFile, testPassword)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_ChangePassword_isFinishedOnLoadIfReplacingTheFilesFails(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+
+	orig := osRename
+	defer func() { osRename = orig }()
+	renamed := 0
+	osRename = func(from, to string) error {
+		if filepath.Ext(from) == rekeyExtension && renamed > 0 {
+			return errors.New("the disk goes away")
+		}
+		renamed++
+		return orig(from, to)
+	}
+
+	c.Assert(a.ChangePassword(passwordSupplier(testPassword), testPassword, newTestPassword), ErrorMatches, "the disk goes away")
+	osRename = orig
+
+	assertOpensWith(c, configFile, newTestPassword)
+	assertNoRekeyLeftovers(c, filepath.Dir(configFile))
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_CheckPassword_onlyAcceptsThePasswordOfTheProfile(c *C) {
+	defer cheapArgon2()()
+	a, _ := setUpEncryptedConfig(c)
+
+	c.Assert(a.CheckPassword(testPassword), IsNil)
+	c.Assert(a.CheckPassword(newTestPassword), Equals, ErrWrongPassword)
+
+	c.Assert(a.Save(a.keySupplier), IsNil)
+	c.Assert(a.CheckPassword(testPassword), IsNil)
+}
+
+func (s *ChangePasswordSuite) Test_ApplicationConfig_CheckPassword_failsWithoutEncryption(c *C) {
+	a := &ApplicationConfig{}
+	c.Assert(a.CheckPassword(testPassword), Equals, errNotEncrypted)
+}
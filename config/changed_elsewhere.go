@@ -0,0 +1,364 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+)
+
+// Several instances can have the same configuration file open, for example with --multi. Before writing the file,
+// we check if another instance has written it since we last read or wrote it. If so, the changes are merged:
+// settings, accounts, peers and fingerprints are compared with how they were when we last saw the file, and
+// changes only the other instance made are taken. When both instances changed the same thing, our change is kept.
+
+// ErrConfigurationChangedElsewhere is returned when saving, if another instance has changed the configuration
+// file in a way that can't be merged, for example by changing the password
+var ErrConfigurationChangedElsewhere = errors.New("the configuration file was changed by another instance in a way that can't be merged")
+
+// ConfigurationChanges tells what was merged in from changes another instance made to the configuration file
+type ConfigurationChanges struct {
+	// Merged is the number of changes that were taken from the other instance
+	Merged int
+	// Conflicts is the number of changes the other instance made that were left out, since this instance
+	// changed the same thing, or still has an account the other instance removed
+	Conflicts int
+}
+
+// fileState identifies the content of the configuration file as we last read or wrote it
+type fileState struct {
+	name string
+	sum  [sha256.Size]byte
+}
+
+func (a *ApplicationConfig) rememberFileContent(contents []byte) {
+	a.onDisk = &fileState{name: a.filename, sum: sha256.Sum256(contents)}
+}
+
+// rememberSaved keeps the configuration as it is now, to tell what another instance changed from it later
+func (a *ApplicationConfig) rememberSaved() {
+	a.saved, _ = json.Marshal(a)
+}
+
+// WhenChangedElsewhere sets the function that is called after saving, when changes another instance made to the
+// configuration file were merged in
+func (a *ApplicationConfig) WhenChangedElsewhere(f func(*ConfigurationChanges)) {
+	a.changedElsewhere = f
+}
+
+// readConfigFile reads the configuration file, making sure no other instance is writing it at the same time
+func (a *ApplicationConfig) readConfigFile() ([]byte, error) {
+	if !fileExists(a.filename) && !fileExists(a.filename+tmpExtension) {
+		return nil, os.ErrNotExist
+	}
+
+	lock, err := lockConfigFile(a.filename, false)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.unlock()
+
+	return readFileOrTemporaryBackup(a.filename)
+}
+
+// mergeChangesMadeElsewhere merges in the changes made to the configuration file since we last read or wrote it.
+// The configuration file has to be locked.
+func (a *ApplicationConfig) mergeChangesMadeElsewhere(ks KeySupplier) (*ConfigurationChanges, error) {
+	if a.onDisk == nil || a.saved == nil || a.onDisk.name != a.filename {
+		return nil, nil
+	}
+
+	contents, err := readFileOrTemporaryBackup(a.filename)
+	if err != nil || sha256.Sum256(contents) == a.onDisk.sum {
+		// If the file is gone, there's nothing to merge - it will be written again
+		return nil, nil
+	}
+
+	theirs, err := a.openChangedFile(contents, ks)
+	if err != nil {
+		return nil, err
+	}
+
+	if theirs.checkVersion() != nil {
+		return nil, ErrConfigurationChangedElsewhere
+	}
+
+	base := new(ApplicationConfig)
+	if err := json.Unmarshal(a.saved, base); err != nil {
+		return nil, err
+	}
+
+	m := &configMerge{}
+	m.mergeSettings(base, a, theirs)
+	m.mergeAccounts(base, a, theirs)
+	return &m.changes, nil
+}
+
+// openChangedFile reads the configuration another instance wrote. It has to be encrypted in the same way as
+// we have it, since we would otherwise have to ask for the password again, which might even be a different one.
+// The other slots of an encrypted container are kept as the other instance left them.
+func (a *ApplicationConfig) openChangedFile(contents []byte, ks KeySupplier) (*ApplicationConfig, error) {
+	if !a.shouldEncrypt {
+		theirs := new(ApplicationConfig)
+		if err := json.Unmarshal(contents, theirs); err != nil {
+			return nil, ErrConfigurationChangedElsewhere
+		}
+		return theirs, nil
+	}
+
+	plain, err := a.decryptChangedFile(contents, ks)
+	if err != nil {
+		return nil, ErrConfigurationChangedElsewhere
+	}
+
+	theirs := new(ApplicationConfig)
+	if err := json.Unmarshal(plain, theirs); err != nil {
+		return nil, ErrConfigurationChangedElsewhere
+	}
+	return theirs, nil
+}
+
+func (a *ApplicationConfig) decryptChangedFile(contents []byte, ks KeySupplier) ([]byte, error) {
+	if a.params == nil {
+		return nil, errDecryptionFailed
+	}
+
+	if c, err := parseEncryptedContainer(contents); err == nil {
+		for i := range c.Slots {
+			if c.Slots[i].Params.kdfID() != a.params.kdfID() {
+				continue
+			}
+
+			key, macKey, ok := ks.GenerateKey(c.Slots[i].Params)
+			if !ok {
+				return nil, errNoPasswordSupplied
+			}
+			plain, err := c.Slots[i].decryptWith(generatedKeys{key, macKey})
+			if err != nil {
+				return nil, err
+			}
+
+			a.container, a.slot = c, i
+			return plain, nil
+		}
+		return nil, errDecryptionFailed
+	}
+
+	ed, err := parseEncryptedData(contents)
+	if err != nil || ed.Params.kdfID() != a.params.kdfID() {
+		return nil, errDecryptionFailed
+	}
+
+	plain, _, err := decryptConfiguration(contents, ks)
+	return plain, err
+}
+
+func (a *ApplicationConfig) reportChangesMadeElsewhere(changes *ConfigurationChanges) {
+	if changes == nil || changes.Merged+changes.Conflicts == 0 || a.changedElsewhere == nil {
+		return
+	}
+	a.changedElsewhere(changes)
+}
+
+type configMerge struct {
+	changes ConfigurationChanges
+}
+
+func sameJSON(x, y interface{}) bool {
+	xs, _ := json.Marshal(x)
+	ys, _ := json.Marshal(y)
+	return bytes.Equal(xs, ys)
+}
+
+// jsonFields returns the fields of the value as they are serialized, leaving out the given field
+func jsonFields(v interface{}, leaveOut string) map[string]json.RawMessage {
+	res := make(map[string]json.RawMessage)
+	s, _ := json.Marshal(v)
+	_ = json.Unmarshal(s, &res)
+	delete(res, leaveOut)
+	return res
+}
+
+// takeTheirs returns true if the other instance changed something we didn't change. Changes both made are conflicts.
+func (m *configMerge) takeTheirs(base, ours, theirs []byte) bool {
+	if bytes.Equal(ours, theirs) || bytes.Equal(base, theirs) {
+		return false
+	}
+
+	if bytes.Equal(base, ours) {
+		m.changes.Merged++
+		return true
+	}
+
+	m.changes.Conflicts++
+	return false
+}
+
+// mergeFields merges the serialized fields of the values one by one, leaving out the given field,
+// and returns the serialized result, and whether anything was taken from the other instance
+func (m *configMerge) mergeFields(base, ours, theirs interface{}, leaveOut string) ([]byte, bool) {
+	b, o, t := jsonFields(base, leaveOut), jsonFields(ours, leaveOut), jsonFields(theirs, leaveOut)
+
+	keys := make(map[string]bool)
+	for k := range o {
+		keys[k] = true
+	}
+	for k := range t {
+		keys[k] = true
+	}
+
+	changed := false
+	for k := range keys {
+		if !m.takeTheirs(b[k], o[k], t[k]) {
+			continue
+		}
+
+		changed = true
+		if v, ok := t[k]; ok {
+			o[k] = v
+		} else {
+			delete(o, k)
+		}
+	}
+
+	res, _ := json.Marshal(o)
+	return res, changed
+}
+
+func (m *configMerge) mergeSettings(base, ours, theirs *ApplicationConfig) {
+	merged, changed := m.mergeFields(base, ours, theirs, "Accounts")
+	if !changed {
+		return
+	}
+
+	n := new(ApplicationConfig)
+	_ = json.Unmarshal(merged, n)
+
+	// The settings are all the exported fields, other than the accounts
+	copyExportedFields(ours, n, "Accounts")
+}
+
+// copyExportedFields copies the exported fields of the configuration, leaving out the given field
+func copyExportedFields(ours, theirs *ApplicationConfig, leaveOut string) {
+	to, from := reflect.ValueOf(ours).Elem(), reflect.ValueOf(theirs).Elem()
+	for i := 0; i < to.NumField(); i++ {
+		if f := to.Type().Field(i); f.PkgPath == "" && f.Name != leaveOut {
+			to.Field(i).Set(from.Field(i))
+		}
+	}
+}
+
+// mergeAccounts merges the accounts. Accounts the other instance removed are kept, since they might still be in use here.
+func (m *configMerge) mergeAccounts(base, ours, theirs *ApplicationConfig) {
+	for _, ta := range theirs.Accounts {
+		oa, inOurs := ours.GetAccount(ta.Account)
+		ba, inBase := base.GetAccount(ta.Account)
+
+		switch {
+		case inOurs && inBase:
+			m.mergeAccount(ba, oa, ta)
+		case inOurs:
+			m.mergeAccount(&Account{Account: ta.Account}, oa, ta)
+		case !inBase:
+			ours.Add(ta)
+			m.changes.Merged++
+		}
+	}
+
+	for _, oa := range ours.Accounts {
+		_, inTheirs := theirs.GetAccount(oa.Account)
+		if _, inBase := base.GetAccount(oa.Account); inBase && !inTheirs {
+			m.changes.Conflicts++
+		}
+	}
+}
+
+func (m *configMerge) mergeAccount(base, ours, theirs *Account) {
+	if merged, changed := m.mergeFields(base, ours, theirs, "Peers"); changed {
+		n := new(Account)
+		_ = json.Unmarshal(merged, n)
+		torIsolationLock.Lock()
+		n.id, n.torIsolation, n.Peers = ours.id, ours.torIsolation, ours.Peers
+		*ours = *n
+		torIsolationLock.Unlock()
+	}
+
+	var peers []*Peer
+	for _, op := range ours.Peers {
+		bp, inBase := base.GetPeer(op.UserID)
+		tp, inTheirs := theirs.GetPeer(op.UserID)
+
+		switch {
+		case inTheirs && inBase:
+			m.mergePeer(bp, op, tp)
+		case inTheirs:
+			m.mergePeer(&Peer{UserID: op.UserID}, op, tp)
+		case inBase && sameJSON(bp, op):
+			// Removed by the other instance
+			m.changes.Merged++
+			continue
+		case inBase:
+			m.changes.Conflicts++
+		}
+		peers = append(peers, op)
+	}
+
+	for _, tp := range theirs.Peers {
+		_, inOurs := ours.GetPeer(tp.UserID)
+		if _, inBase := base.GetPeer(tp.UserID); !inOurs && !inBase {
+			peers = append(peers, tp)
+			m.changes.Merged++
+		}
+	}
+
+	ours.Peers = peers
+}
+
+func (m *configMerge) mergePeer(base, ours, theirs *Peer) {
+	if merged, changed := m.mergeFields(base, ours, theirs, "Fingerprints"); changed {
+		n := new(Peer)
+		_ = json.Unmarshal(merged, n)
+		n.Fingerprints = ours.Fingerprints
+		*ours = *n
+	}
+
+	var fprs []*Fingerprint
+	for _, of := range ours.Fingerprints {
+		bf, inBase := base.GetFingerprint(of.Fingerprint)
+		tf, inTheirs := theirs.GetFingerprint(of.Fingerprint)
+
+		switch {
+		case inTheirs:
+			if !inBase {
+				bf = &Fingerprint{Fingerprint: of.Fingerprint}
+			}
+			if m.takeTheirs(fingerprintJSON(bf), fingerprintJSON(of), fingerprintJSON(tf)) {
+				of.Trusted, of.Tag = tf.Trusted, tf.Tag
+			}
+		case inBase && sameJSON(bf, of):
+			// Removed by the other instance
+			m.changes.Merged++
+			continue
+		case inBase:
+			m.changes.Conflicts++
+		}
+		fprs = append(fprs, of)
+	}
+
+	for _, tf := range theirs.Fingerprints {
+		_, inOurs := ours.GetFingerprint(tf.Fingerprint)
+		if _, inBase := base.GetFingerprint(tf.Fingerprint); !inOurs && !inBase {
+			fprs = append(fprs, tf)
+			m.changes.Merged++
+		}
+	}
+
+	ours.Fingerprints = fprs
+}
+
+func fingerprintJSON(f *Fingerprint) []byte {
+	res, _ := json.Marshal(f)
+	return res
+}
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type ChangedElsewhereSuite struct{}
+
+var _ = Suite(&ChangedElsewhereSuite{})
+
+const twoWritersTestConfig = `{
+	"UniqueConfigurationID": "00112233",
+	"Accounts": [{
+		"Account": "test1@example.com",
+		"Peers": [
+			{"UserID": "bob@example.com", "Nickname": "Bob", "Fingerprints": [{"FingerprintHex": "0102", "Trusted": false}]},
+			{"UserID": "carol@example.com", "Nickname": "Carol"}
+		]
+	}]
+}`
+
+// openTwice opens the same configuration file as two instances would
+func openTwice(c *C, configFile string, ks func() KeySupplier) (*ApplicationConfig, *ApplicationConfig) {
+	one, ok, err := LoadOrCreate(configFile, ks())
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+
+	two, ok, err := LoadOrCreate(configFile, ks())
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+
+	return one, two
+}
+
+func setUpPlainConfig(c *C) string {
+	configFile := filepath.Join(c.MkDir(), "accounts.json")
+	c.Assert(ioutil.WriteFile(configFile, []byte(twoWritersTestConfig), 0600), IsNil)
+	return configFile
+}
+
+func noKeys() KeySupplier {
+	return nil
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_mergesWhatAnotherInstanceSaved(c *C) {
+	configFile := setUpPlainConfig(c)
+	one, two := openTwice(c, configFile, noKeys)
+
+	bob, _ := one.Accounts[0].GetPeer("bob@example.com")
+	bob.Fingerprints[0].Trusted = true
+	one.Bell = true
+	c.Assert(one.Save(nil), IsNil)
+
+	var reported *ConfigurationChanges
+	two.WhenChangedElsewhere(func(ch *ConfigurationChanges) { reported = ch })
+	two.Accounts[0].EnsurePeer("dave@example.com")
+	two.Add(&Account{Account: "test2@example.com"})
+	c.Assert(two.Save(nil), IsNil)
+
+	c.Assert(reported, DeepEquals, &ConfigurationChanges{Merged: 2})
+	c.Assert(two.Bell, Equals, true)
+
+	three, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(three.Bell, Equals, true)
+	c.Assert(three.Accounts, HasLen, 2)
+	bob, _ = three.Accounts[0].GetPeer("bob@example.com")
+	trusted, _ := bob.HasTrustedFingerprint([]byte{0x01, 0x02})
+	c.Assert(trusted, Equals, true)
+	_, ok := three.Accounts[0].GetPeer("dave@example.com")
+	c.Assert(ok, Equals, true)
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_keepsOurChangeWhenBothChangedTheSameThing(c *C) {
+	configFile := setUpPlainConfig(c)
+	one, two := openTwice(c, configFile, noKeys)
+
+	bob, _ := one.Accounts[0].GetPeer("bob@example.com")
+	bob.Fingerprints[0].Tag = "from one"
+	c.Assert(one.Save(nil), IsNil)
+
+	var reported *ConfigurationChanges
+	two.WhenChangedElsewhere(func(ch *ConfigurationChanges) { reported = ch })
+	bob, _ = two.Accounts[0].GetPeer("bob@example.com")
+	bob.Fingerprints[0].Tag = "from two"
+	c.Assert(two.Save(nil), IsNil)
+
+	c.Assert(reported, DeepEquals, &ConfigurationChanges{Conflicts: 1})
+	c.Assert(bob.Fingerprints[0].Tag, Equals, "from two")
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_removesWhatAnotherInstanceRemoved(c *C) {
+	configFile := setUpPlainConfig(c)
+	one, two := openTwice(c, configFile, noKeys)
+
+	one.Accounts[0].Peers = one.Accounts[0].Peers[:1]
+	one.Accounts[0].Peers[0].Fingerprints = nil
+	c.Assert(one.Save(nil), IsNil)
+
+	two.Accounts[0].Nickname = "me"
+	c.Assert(two.Save(nil), IsNil)
+
+	c.Assert(two.Accounts[0].Nickname, Equals, "me")
+	c.Assert(two.Accounts[0].Peers, HasLen, 1)
+	c.Assert(two.Accounts[0].Peers[0].Fingerprints, HasLen, 0)
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_keepsAccountsTheOtherInstanceRemoved(c *C) {
+	configFile := setUpPlainConfig(c)
+	one, two := openTwice(c, configFile, noKeys)
+
+	one.Add(&Account{Account: "test2@example.com"})
+	c.Assert(one.Save(nil), IsNil)
+	two.Add(&Account{Account: "test2@example.com"})
+	c.Assert(two.Save(nil), IsNil)
+
+	one.Remove(one.Accounts[0])
+	c.Assert(one.Save(nil), IsNil)
+
+	var reported *ConfigurationChanges
+	two.WhenChangedElsewhere(func(ch *ConfigurationChanges) { reported = ch })
+	c.Assert(two.Save(nil), IsNil)
+
+	c.Assert(reported, DeepEquals, &ConfigurationChanges{Conflicts: 1})
+	c.Assert(two.Accounts, HasLen, 2)
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_mergesEncryptedFiles(c *C) {
+	defer cheapArgon2()()
+	_, configFile := setUpEncryptedConfig(c)
+	ks := func() KeySupplier { return passwordSupplier(testPassword) }
+	one, two := openTwice(c, configFile, ks)
+
+	one.Accounts[0].EnsurePeer("bob@example.com")
+	c.Assert(one.Save(one.keySupplier), IsNil)
+	two.Accounts[0].EnsurePeer("carol@example.com")
+	c.Assert(two.Save(two.keySupplier), IsNil)
+	one.Accounts[0].Nickname = "me"
+	c.Assert(one.Save(one.keySupplier), IsNil)
+
+	three := assertOpensWith(c, configFile, testPassword)
+	c.Assert(three.Accounts[0].Nickname, Equals, "me")
+	c.Assert(three.Accounts[0].Peers, HasLen, 2)
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_mergesWhatAnotherInstanceSavedAfterCheckingThePassword(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	c.Assert(a.Save(a.keySupplier), IsNil)
+	ks := func() KeySupplier { return passwordSupplier(testPassword) }
+	one, two := openTwice(c, configFile, ks)
+
+	one.Accounts[0].EnsurePeer("bob@example.com")
+	c.Assert(one.Save(one.keySupplier), IsNil)
+
+	// Checking the password reads the file, but doesn't take in what the other instance saved
+	c.Assert(two.CheckPassword(testPassword), IsNil)
+	two.Accounts[0].EnsurePeer("carol@example.com")
+	c.Assert(two.Save(two.keySupplier), IsNil)
+
+	three := assertOpensWith(c, configFile, testPassword)
+	c.Assert(three.Accounts[0].Peers, HasLen, 2)
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_failsIfTheOtherInstanceChangedThePassword(c *C) {
+	defer cheapArgon2()()
+	_, configFile := setUpEncryptedConfig(c)
+	ks := func() KeySupplier { return passwordSupplier(testPassword) }
+	one, two := openTwice(c, configFile, ks)
+
+	c.Assert(one.ChangePassword(one.keySupplier, testPassword, newTestPassword), IsNil)
+
+	two.Accounts[0].EnsurePeer("carol@example.com")
+	c.Assert(two.Save(two.keySupplier), Equals, ErrConfigurationChangedElsewhere)
+
+	assertOpensWith(c, configFile, newTestPassword)
+}
+
+func (s *ChangedElsewhereSuite) Test_ApplicationConfig_Save_waitsForTheLockOfTheConfigurationFile(c *C) {
+	configFile := setUpPlainConfig(c)
+	a, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+
+	lock, err := lockConfigFile(configFile, true)
+	c.Assert(err, IsNil)
+
+	saved := make(chan error)
+	go func() {
+		saved <- a.Save(nil)
+	}()
+
+	select {
+	case <-saved:
+		c.Fatal("the configuration file was saved while another instance held the lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	lock.unlock()
+
+	select {
+	case err := <-saved:
+		c.Assert(err, IsNil)
+	case <-time.After(5 * time.Second):
+		c.Fatal("the configuration file wasn't saved after the lock was released")
+	}
+}
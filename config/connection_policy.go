@@ -8,12 +8,12 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	"golang.org/x/net/proxy"
 
 	"github.com/chadsec1/decoyim/decoylog"
 	ournet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
 	ourtls "github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
@@ -114,7 +114,12 @@ func (p *ConnectionPolicy) buildDialerFor(conf *Account, verifier ourtls.Verifie
 		xmppConfig.TLSConfig.RootCAs = domainRoot
 	}
 
-	proxy, err := buildProxyChain(conf.Proxies)
+	proxy, err := conf.proxyChain()
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, err := conf.CreateResolver(proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +128,7 @@ func (p *ConnectionPolicy) buildDialerFor(conf *Account, verifier ourtls.Verifie
 	dialer.SetLogger(p.Log)
 	dialer.SetJID(conf.Account)
 	dialer.SetProxy(proxy)
+	dialer.SetResolver(resolver)
 	dialer.SetConfig(xmppConfig)
 
 	defaultServerAddress := dialer.GetServer()
@@ -176,10 +182,12 @@ func (a *Account) CreateTorProxy() (proxy.Dialer, error) {
 		}
 	}
 
-	return buildProxyChain(a.Proxies)
+	return a.proxyChain()
 }
 
-func buildProxyChain(proxies []string) (dialer proxy.Dialer, err error) {
+// buildProxyChain builds a dialer going through all the given proxies, starting with the last one.
+// If torOnly is true, the first proxy has to be on the local machine.
+func buildProxyChain(proxies []string, torOnly bool) (dialer proxy.Dialer, err error) {
 	for i := len(proxies) - 1; i >= 0; i-- {
 		u, e := url.Parse(proxies[i])
 		if e != nil {
@@ -188,9 +196,7 @@ func buildProxyChain(proxies []string) (dialer proxy.Dialer, err error) {
 		}
 
 		if dialer == nil {
-			dialer = &net.Dialer{
-				Timeout: 60 * time.Second,
-			}
+			dialer = ournet.Dialers.Direct(torOnly)
 		}
 
 		if dialer, err = proxy.FromURL(u, dialer); err != nil {
@@ -227,7 +233,7 @@ func buildInOutLogs(rawLog io.Writer) (io.Writer, io.Writer) {
 }
 
 // Connect to the server and authenticates with the password
-func (p *ConnectionPolicy) Connect(password, resource string, conf *Account, verifier ourtls.Verifier) (interfaces.Conn, error) {
+func (p *ConnectionPolicy) Connect(password, resource string, conf *Account, verifier ourtls.Verifier, mechanismVerifier sasl.Verifier) (interfaces.Conn, error) {
 	dialer, err := buildDialerFor(p, conf, verifier)
 	if err != nil {
 		return nil, err
@@ -239,6 +245,14 @@ func (p *ConnectionPolicy) Connect(password, resource string, conf *Account, ver
 	dialer.SetResource(resource)
 	dialer.SetShouldConnectTLS(conf.ConnectTLS)
 	dialer.SetShouldSendALPN(conf.SetALPN)
+	dialer.SetMechanismVerifier(mechanismVerifier)
+	if conf.StoreSaltedKeys {
+		dialer.SetSaltedKeyStore(conf)
+	}
+	if conf.UsesDANE() {
+		dialer.SetShouldLookupTLSA(true)
+		dialer.SetDNSSECResolver(conf.DNSSECResolver)
+	}
 
 	return dialer.Dial()
 }
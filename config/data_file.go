@@ -0,0 +1,210 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// ErrDataFileNeedsPassword is returned when an encrypted data file can't be read or written since we don't have the password
+var ErrDataFileNeedsPassword = errors.New("can't access the encrypted data file without the password")
+
+// DataFile is a file kept in the data directory of the profile, for data that doesn't belong
+// in the configuration itself. When the configuration file is encrypted, data files are encrypted with
+// the same password and key derivation parameters, so the user is never asked for another password.
+type DataFile struct {
+	app  *ApplicationConfig
+	name string
+
+	withoutBackups bool
+}
+
+// DataFile returns the data file with the given name
+func (a *ApplicationConfig) DataFile(name string) *DataFile {
+	return &DataFile{app: a, name: name}
+}
+
+// WithoutBackups makes the data file be written without keeping the previous version as a backup, for data
+// that shouldn't stay on disk once it has been taken out of the file. Backups left by earlier writes are removed.
+func (f *DataFile) WithoutBackups() *DataFile {
+	f.withoutBackups = true
+	return f
+}
+
+func (f *DataFile) plainPath() string {
+	return filepath.Join(f.app.dataDirectory(), f.name)
+}
+
+func (f *DataFile) encryptedPath() string {
+	return f.plainPath() + encryptedFileEnding
+}
+
+// Exists returns true if the data file has been written, encrypted or not
+func (f *DataFile) Exists() bool {
+	return fileExists(f.plainPath()) || fileExists(f.plainPath()+tmpExtension) ||
+		fileExists(f.encryptedPath()) || fileExists(f.encryptedPath()+tmpExtension)
+}
+
+// Load returns the contents of the data file, or nil if it doesn't exist yet
+func (f *DataFile) Load() ([]byte, error) {
+	a := f.app
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if fileExists(f.encryptedPath()) || fileExists(f.encryptedPath()+tmpExtension) {
+		contents, err := readFileOrTemporaryBackup(f.encryptedPath())
+		if err != nil {
+			return nil, err
+		}
+
+		if a.keySupplier == nil {
+			return nil, ErrDataFileNeedsPassword
+		}
+
+		res, _, err := decryptConfiguration(contents, a.keySupplier)
+		if err == errNoPasswordSupplied {
+			return nil, ErrDataFileNeedsPassword
+		}
+		return res, err
+	}
+
+	if fileExists(f.plainPath()) || fileExists(f.plainPath()+tmpExtension) {
+		return readFileOrTemporaryBackup(f.plainPath())
+	}
+
+	return nil, nil
+}
+
+// Store writes the data file, encrypting it if the configuration file is encrypted.
+// If encryption has been turned on or off since the file was last written, the old version is removed.
+func (f *DataFile) Store(contents []byte) error {
+	a := f.app
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	name, old := f.plainPath(), f.encryptedPath()
+	if a.shouldEncrypt {
+		if a.keySupplier == nil {
+			return ErrDataFileNeedsPassword
+		}
+
+		if a.params == nil {
+			ps := newEncryptionParameters()
+			a.params = &ps
+		}
+
+		params := *a.params
+		params.regenerateNonce()
+
+		var err error
+		contents, err = encryptConfiguration(string(contents), &params, a.keySupplier)
+		if err != nil {
+			return ErrDataFileNeedsPassword
+		}
+
+		name, old = old, name
+	}
+
+	write := safeWrite
+	if f.withoutBackups {
+		write = atomicWrite
+	}
+
+	ensureDir(filepath.Dir(name), 0700)
+	if err := write(name, contents, 0600); err != nil {
+		return err
+	}
+
+	if f.withoutBackups {
+		for _, b := range backupsOf(name) {
+			_ = os.Remove(b)
+		}
+	}
+	removeDataFile(old)
+	return nil
+}
+
+// Remove removes the data file, and the backups of it
+func (f *DataFile) Remove() {
+	f.app.ioLock.Lock()
+	defer f.app.ioLock.Unlock()
+
+	removeDataFile(f.plainPath())
+	removeDataFile(f.encryptedPath())
+}
+
+func removeDataFile(name string) {
+	for _, n := range append([]string{name, name + tmpExtension}, backupsOf(name)...) {
+		if fileExists(n) {
+			_ = os.Remove(n)
+		}
+	}
+}
+
+// dataDirMovers are the directories in the data directory of profiles that belong to the profile, with the functions that move them
+var dataDirMovers = map[string]func(from, to string) error{}
+
+// RegisterDataDir tells that the named directory in the data directory of a profile belongs to the profile, so it's
+// moved along with the data files when the profile gets a new data directory. The function moves it, so whatever keeps
+// it open can follow it there. It's only called when the directory exists.
+func RegisterDataDir(name string, move func(from, to string) error) {
+	dataDirMovers[name] = move
+}
+
+func renameIfExists(from, to string) error {
+	if err := os.Rename(from, to); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// dataEntries returns the names of the data files of the profile, with their backups, and of the directories that belong to it
+func (a *ApplicationConfig) dataEntries() []string {
+	var res []string
+	for _, f := range a.encryptedDataFiles() {
+		for _, n := range append([]string{f, f + tmpExtension}, backupsOf(f)...) {
+			if fileExists(n) {
+				res = append(res, filepath.Base(n))
+			}
+		}
+	}
+
+	for name := range dataDirMovers {
+		if fileExists(filepath.Join(a.dataDirectory(), name)) {
+			res = append(res, name)
+		}
+	}
+	return res
+}
+
+func moveDataEntry(name, from, to string) error {
+	if move, ok := dataDirMovers[name]; ok && move != nil {
+		return move(filepath.Join(from, name), filepath.Join(to, name))
+	}
+	return renameIfExists(filepath.Join(from, name), filepath.Join(to, name))
+}
+
+// moveDataDirectory moves the data files of the profile to the given data directory. If any of them can't be moved,
+// the ones that were are moved back, so the profile keeps all its data in one place.
+func (a *ApplicationConfig) moveDataDirectory(name string) error {
+	entries := a.dataEntries()
+	from, old := a.dataDirectory(), a.DataDirectory
+
+	a.DataDirectory = name
+	to := a.dataDirectory()
+	if err := os.MkdirAll(to, 0700); err != nil {
+		a.DataDirectory = old
+		return err
+	}
+
+	for i, e := range entries {
+		if err := moveDataEntry(e, from, to); err != nil {
+			for _, m := range entries[:i] {
+				_ = moveDataEntry(m, to, from)
+			}
+			a.DataDirectory = old
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type DataFileSuite struct{}
+
+var _ = Suite(&DataFileSuite{})
+
+func testKeySupplier() KeySupplier {
+	return &mockKeySupplier{
+		generateKey: func(EncryptionParameters) ([]byte, []byte, bool) {
+			return testKey, testMacKey, true
+		},
+	}
+}
+
+func dataFileTestConfig(c *C) (*ApplicationConfig, func()) {
+	dir, err := ioutil.TempDir("", "decoyim-data-file-")
+	c.Assert(err, IsNil)
+
+	return &ApplicationConfig{filename: filepath.Join(dir, "accounts.json")}, func() {
+		logPotentialError(c, os.RemoveAll(dir))
+	}
+}
+
+func (s *DataFileSuite) Test_DataFile_Load_returnsNothingIfTheFileDoesntExist(c *C) {
+	a, done := dataFileTestConfig(c)
+	defer done()
+
+	f := a.DataFile("outbox.json")
+	c.Assert(f.Exists(), Equals, false)
+
+	res, err := f.Load()
+	c.Assert(err, IsNil)
+	c.Assert(res, IsNil)
+}
+
+func (s *DataFileSuite) Test_DataFile_storesNextToTheConfigurationFileInPlainText(c *C) {
+	a, done := dataFileTestConfig(c)
+	defer done()
+
+	f := a.DataFile("outbox.json")
+	c.Assert(f.Store([]byte("some important data")), IsNil)
+	c.Assert(f.Exists(), Equals, true)
+
+	content, err := ioutil.ReadFile(filepath.Join(filepath.Dir(a.filename), "outbox.json"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "some important data")
+
+	res, err := a.DataFile("outbox.json").Load()
+	c.Assert(err, IsNil)
+	c.Assert(string(res), Equals, "some important data")
+}
+
+func (s *DataFileSuite) Test_DataFile_WithoutBackups_leavesNoPreviousVersionBehind(c *C) {
+	a, done := dataFileTestConfig(c)
+	defer done()
+	name := filepath.Join(filepath.Dir(a.filename), "outbox.json")
+
+	c.Assert(a.DataFile("outbox.json").Store([]byte("some important data")), IsNil)
+	c.Assert(a.DataFile("outbox.json").Store([]byte("some other data")), IsNil)
+	c.Assert(backupsOf(name), HasLen, 1)
+
+	c.Assert(a.DataFile("outbox.json").WithoutBackups().Store([]byte("what is left")), IsNil)
+	c.Assert(a.DataFile("outbox.json").WithoutBackups().Store([]byte("what is left now")), IsNil)
+
+	c.Assert(backupsOf(name), HasLen, 0)
+	c.Assert(fileExists(name+tmpExtension), Equals, false)
+	res, err := a.DataFile("outbox.json").Load()
+	c.Assert(err, IsNil)
+	c.Assert(string(res), Equals, "what is left now")
+}
+
+func (s *DataFileSuite) Test_DataFile_isEncryptedWithTheConfigurationParameters(c *C) {
+	a, done := dataFileTestConfig(c)
+	defer done()
+
+	a.shouldEncrypt = true
+	a.keySupplier = testKeySupplier()
+
+	f := a.DataFile("outbox.json")
+	c.Assert(f.Store([]byte("some important data")), IsNil)
+
+	_, err := os.Stat(f.plainPath())
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	content, err := ioutil.ReadFile(f.plainPath() + ".enc")
+	c.Assert(err, IsNil)
+	ed, err := parseEncryptedData(content)
+	c.Assert(err, IsNil)
+	c.Assert(ed.Params.Salt, Equals, hex.EncodeToString(a.params.saltInternal))
+	c.Assert(ed.Params.N, Equals, a.params.N)
+	c.Assert(ed.Params.Nonce, Not(Equals), hex.EncodeToString(a.params.nonceInternal))
+
+	res, err := f.Load()
+	c.Assert(err, IsNil)
+	c.Assert(string(res), Equals, "some important data")
+}
+
+func (s *DataFileSuite) Test_DataFile_failsWithoutAPassword(c *C) {
+	a, done := dataFileTestConfig(c)
+	defer done()
+
+	a.shouldEncrypt = true
+	f := a.DataFile("outbox.json")
+	c.Assert(f.Store([]byte("some important data")), Equals, ErrDataFileNeedsPassword)
+
+	a.keySupplier = testKeySupplier()
+	c.Assert(f.Store([]byte("some important data")), IsNil)
+
+	a.keySupplier = &mockKeySupplier{
+		generateKey: func(EncryptionParameters) ([]byte, []byte, bool) {
+			return nil, nil, false
+		},
+	}
+	_, err := f.Load()
+	c.Assert(err, Equals, ErrDataFileNeedsPassword)
+}
+
+func (s *DataFileSuite) Test_DataFile_Store_removesTheOldVersionWhenEncryptionChanges(c *C) {
+	a, done := dataFileTestConfig(c)
+	defer done()
+
+	a.keySupplier = testKeySupplier()
+	f := a.DataFile("outbox.json")
+	c.Assert(f.Store([]byte("some important data")), IsNil)
+
+	a.shouldEncrypt = true
+	c.Assert(f.Store([]byte("some other data")), IsNil)
+	c.Assert(fileExists(f.plainPath()), Equals, false)
+	c.Assert(fileExists(f.plainPath()+".backup.000~"), Equals, false)
+
+	f.Remove()
+	c.Assert(f.Exists(), Equals, false)
+}
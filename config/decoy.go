@@ -0,0 +1,320 @@
+package config
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+)
+
+// The encrypted configuration file is a container with a fixed number of slots. Every slot is encrypted on its own,
+// with its own salt, and padded to the same fixed size. One slot has the configuration. The other one has
+// either random data, or a decoy profile that is opened with a duress password instead of the main password.
+// Without the duress password, there is no way to tell which one it is.
+const (
+	containerSlots    = 2
+	containerSlotSize = 512 * 1024
+)
+
+type encryptedContainer struct {
+	Slots []encryptedData
+}
+
+// ErrDuressPasswordIsMainPassword is returned when setting up a decoy profile with the main password as the duress password
+var ErrDuressPasswordIsMainPassword = errors.New("the duress password can't be the same as the main password")
+
+var errNotAContainer = errors.New("the file is not an encrypted container")
+
+// ErrConfigurationTooLarge is returned when the configuration doesn't fit in a slot of the encrypted configuration file
+var ErrConfigurationTooLarge = errors.New("the configuration is too large to be encrypted")
+
+func parseEncryptedContainer(content []byte) (*encryptedContainer, error) {
+	c := new(encryptedContainer)
+	if err := json.Unmarshal(content, c); err != nil {
+		return nil, err
+	}
+
+	if len(c.Slots) == 0 {
+		return nil, errNotAContainer
+	}
+
+	for i := range c.Slots {
+		if err := c.Slots[i].Params.deserialize(); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// newContainer puts the slot in a new container, in a random position. The other slots get random data.
+func newContainer(own *encryptedData) (*encryptedContainer, int) {
+	c := &encryptedContainer{Slots: make([]encryptedData, containerSlots)}
+	slot := int(genRand(1)[0]) % containerSlots
+	for i := range c.Slots {
+		c.Slots[i] = fillerSlotLike(own)
+	}
+	c.Slots[slot] = *own
+	return c, slot
+}
+
+// paramsLike returns parameters that generate keys in the same way as the given ones, but with a new salt
+func paramsLike(p EncryptionParameters) EncryptionParameters {
+	p.saltInternal = genRand(saltLen)
+	p.regenerateNonce()
+	p.serialize()
+	return p
+}
+
+// fillerSlotLike returns a slot of random data, which looks the same as the given slot to anyone without its keys
+func fillerSlotLike(s *encryptedData) encryptedData {
+	return encryptedData{
+		Params: paramsLike(s.Params),
+		Data:   hex.EncodeToString(genRand(len(s.Data) / 2)),
+	}
+}
+
+// padSlot pads the serialized configuration with whitespace to the size of a slot, so every slot
+// has the same size, whatever is in it
+func padSlot(contents []byte) ([]byte, error) {
+	if len(contents) > containerSlotSize {
+		return nil, ErrConfigurationTooLarge
+	}
+	return append(contents, bytes.Repeat([]byte(" "), containerSlotSize-len(contents))...), nil
+}
+
+// multiKeySupplier is implemented by key suppliers that can generate keys for several parameters from one password
+type multiKeySupplier interface {
+	generateKeysForAll(params []EncryptionParameters) ([]generatedKeys, bool)
+}
+
+func keysForSlots(c *encryptedContainer, ks KeySupplier) ([]generatedKeys, bool) {
+	params := make([]EncryptionParameters, len(c.Slots))
+	for i, s := range c.Slots {
+		params[i] = s.Params
+	}
+
+	if m, ok := ks.(multiKeySupplier); ok {
+		return m.generateKeysForAll(params)
+	}
+
+	res := make([]generatedKeys, len(params))
+	for i, p := range params {
+		key, macKey, ok := ks.GenerateKey(p)
+		if !ok {
+			return nil, false
+		}
+		res[i] = generatedKeys{key, macKey}
+	}
+	return res, true
+}
+
+// openContainer returns the slot the password opens, and what's in it. Keys are generated for
+// all the slots, so the time it takes doesn't tell which slot was opened.
+func openContainer(c *encryptedContainer, ks KeySupplier) (int, []byte, error) {
+	keys, ok := keysForSlots(c, ks)
+	if !ok {
+		return 0, nil, errNoPasswordSupplied
+	}
+
+	for i := range c.Slots {
+		if res, err := c.Slots[i].decryptWith(keys[i]); err == nil {
+			return i, res, nil
+		}
+	}
+
+	return 0, nil, errDecryptionFailed
+}
+
+func (a *ApplicationConfig) loadFromContainer(c *encryptedContainer, ks KeySupplier) error {
+	a.shouldEncrypt = true
+
+	slot, contents, err := openContainer(c, ks)
+	if err != nil {
+		return err
+	}
+
+	a.container, a.slot = c, slot
+	params := c.Slots[slot].Params
+	a.params = &params
+
+	// This can't actually fail - the slot was written by us, and the MAC has been checked.
+	// Profiles in a container can have no accounts, since decoy profiles start out that way.
+	_ = json.Unmarshal(contents, a)
+
+	return a.checkVersion()
+}
+
+// encrypt encrypts the serialized configuration with the current parameters and a new nonce,
+// and returns the container with it in the slot of this profile
+func (a *ApplicationConfig) encrypt(contents []byte, ks KeySupplier) ([]byte, error) {
+	padded, err := padSlot(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	a.params.regenerateNonce()
+	own, err := sealData(string(padded), a.params, ks)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.container == nil {
+		a.container, a.slot = newContainer(own)
+	} else {
+		a.container.Slots[a.slot] = *own
+	}
+
+	return json.MarshalIndent(a.container, "", "\t")
+}
+
+// writeContainer writes the configuration file with the slots as they are now. The backups are removed,
+// since they would show which slots have changed.
+func (a *ApplicationConfig) writeContainer() error {
+	contents, err := json.MarshalIndent(a.container, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	lock, err := lockConfigFile(a.filename, true)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	if err := atomicWrite(a.filename, contents, 0600); err != nil {
+		return err
+	}
+
+	a.rememberFileContent(contents)
+	a.rememberSaved()
+	removeBackupsOf(a.filename)
+	return nil
+}
+
+func removeBackupsOf(name string) {
+	for _, b := range backupsOf(name) {
+		_ = os.Remove(b)
+	}
+	_ = os.Remove(name + tmpExtension)
+	for _, b := range versionBackupsOf(name) {
+		_ = os.Remove(b)
+	}
+}
+
+// SetUpDecoy puts a new, empty decoy profile in the configuration file, which is opened instead of this profile
+// when the duress password is given. It has its own accounts, and keeps its data files apart from the ones of this profile.
+// If destroyThisProfile is true, opening the decoy profile destroys this profile for good, since nothing is left to open
+// it with. An earlier decoy profile can't be opened anymore after this.
+func (a *ApplicationConfig) SetUpDecoy(ks KeySupplier, duressPassword string, destroyThisProfile bool) error {
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if !a.shouldEncrypt || a.params == nil {
+		return errNotEncrypted
+	}
+
+	key, _, ok := ks.GenerateKey(*a.params)
+	if !ok {
+		return errNoPasswordSupplied
+	}
+	if dkey, _ := GenerateKeys(duressPassword, *a.params); subtle.ConstantTimeCompare(key, dkey) == 1 {
+		return ErrDuressPasswordIsMainPassword
+	}
+
+	decoy := &ApplicationConfig{
+		Version:                        currentConfigurationVersion,
+		DataDirectory:                  newDataDirectoryName(),
+		DestroyOtherProfilesWhenOpened: destroyThisProfile,
+	}
+	decoy.genUniqueID()
+	decoyContents, err := decoy.serialize()
+	if err != nil {
+		return err
+	}
+
+	moved, err := a.ensureOwnDataDirectory()
+	if err != nil {
+		return err
+	}
+	if err := a.putDecoyInContainer(ks, duressPassword, decoyContents); err != nil {
+		if moved {
+			_ = a.moveDataDirectory("")
+		}
+		return err
+	}
+	return nil
+}
+
+func newDataDirectoryName() string {
+	return hex.EncodeToString(genRand(16))
+}
+
+// ensureOwnDataDirectory gives an encrypted profile that keeps its data files next to the configuration file
+// a data directory with a random name, and moves the files there. Every encrypted profile has one, whether
+// there is a decoy profile in the file or not, so the data directories don't tell that there is one.
+// It returns true if the data files were moved.
+func (a *ApplicationConfig) ensureOwnDataDirectory() (bool, error) {
+	if !a.shouldEncrypt || a.DataDirectory != "" {
+		return false, nil
+	}
+	if err := a.moveDataDirectory(newDataDirectoryName()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// putDecoyInContainer writes the configuration file with this profile in its slot, and the decoy profile in the other one
+func (a *ApplicationConfig) putDecoyInContainer(ks KeySupplier, duressPassword string, decoyContents []byte) error {
+	a.onBeforeSave()
+	contents, err := a.serialize()
+	if err != nil {
+		return err
+	}
+	if _, err := a.encrypt(contents, ks); err != nil {
+		return err
+	}
+	a.keySupplier = ks
+
+	// The decoy generates its keys in the same way as this profile, so the slots look alike
+	params := paramsLike(*a.params)
+	key, macKey := GenerateKeys(duressPassword, params)
+	padded, err := padSlot(decoyContents)
+	if err != nil {
+		return err
+	}
+	sealed := sealDataWith(string(padded), &params, key, macKey)
+	for i := range a.container.Slots {
+		if i != a.slot {
+			a.container.Slots[i] = *sealed
+			break
+		}
+	}
+
+	return a.writeContainer()
+}
+
+// destroyOtherProfiles fills the other slots of the configuration file with random data,
+// so the profiles in them can't be opened anymore
+func (a *ApplicationConfig) destroyOtherProfiles(ks KeySupplier) error {
+	a.DestroyOtherProfilesWhenOpened = false
+
+	contents, err := a.serialize()
+	if err != nil {
+		return err
+	}
+	if _, err := a.encrypt(contents, ks); err != nil {
+		return err
+	}
+
+	own := &a.container.Slots[a.slot]
+	for i := range a.container.Slots {
+		if i != a.slot {
+			a.container.Slots[i] = fillerSlotLike(own)
+		}
+	}
+
+	return a.writeContainer()
+}
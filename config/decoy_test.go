@@ -0,0 +1,235 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type DecoySuite struct{}
+
+var _ = Suite(&DecoySuite{})
+
+const duressTestPassword = "not under any circumstances"
+
+func ownSlotIn(content []byte, a *ApplicationConfig) (*encryptedData, error) {
+	c, err := parseEncryptedContainer(content)
+	if err != nil {
+		return nil, err
+	}
+	return &c.Slots[a.slot], nil
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_Save_writesAContainerWithSlotsOfTheSameSize(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	c.Assert(a.Save(a.keySupplier), IsNil)
+
+	contents, _ := ioutil.ReadFile(configFile)
+	container, err := parseEncryptedContainer(contents)
+	c.Assert(err, IsNil)
+	c.Assert(container.Slots, HasLen, containerSlots)
+	c.Assert(len(container.Slots[0].Data), Equals, len(container.Slots[1].Data))
+	c.Assert(len(container.Slots[0].Data), Equals, 2*(containerSlotSize+16))
+	c.Assert(container.Slots[0].Params.Salt, Not(Equals), container.Slots[1].Params.Salt)
+	c.Assert(container.Slots[0].Params.Time, Equals, container.Slots[1].Params.Time)
+
+	assertOpensWith(c, configFile, testPassword)
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_SetUpDecoy_opensTheDecoyWithTheDuressPassword(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	c.Assert(a.Save(a.keySupplier), IsNil)
+	before, _ := ioutil.ReadFile(configFile)
+
+	c.Assert(a.SetUpDecoy(a.keySupplier, duressTestPassword, false), IsNil)
+
+	after, _ := ioutil.ReadFile(configFile)
+	c.Assert(len(after), Equals, len(before))
+
+	decoy, ok, err := LoadOrCreate(configFile, passwordSupplier(duressTestPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(decoy.Accounts, HasLen, 0)
+	c.Assert(decoy.UniqueConfigurationID, Not(Equals), a.UniqueConfigurationID)
+	c.Assert(decoy.dataDirectory(), Not(Equals), a.dataDirectory())
+
+	data, err := decoy.DataFile("outbox.json").Load()
+	c.Assert(err, IsNil)
+	c.Assert(data, IsNil)
+
+	_, err = decoy.AddNewAccount()
+	c.Assert(err, IsNil)
+	c.Assert(decoy.Save(decoy.keySupplier), IsNil)
+	c.Assert(decoy.DataFile("outbox.json").Store([]byte("nothing to see here")), IsNil)
+
+	assertOpensWith(c, configFile, testPassword)
+
+	decoy, _, _ = LoadOrCreate(configFile, passwordSupplier(duressTestPassword))
+	c.Assert(decoy.Accounts, HasLen, 1)
+	data, _ = decoy.DataFile("outbox.json").Load()
+	c.Assert(string(data), Equals, "nothing to see here")
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_SetUpDecoy_movesTheDataOfThisProfileToADirectoryOfItsOwn(c *C) {
+	defer cheapArgon2()()
+	RegisterDataDir("test-data", nil)
+	defer delete(dataDirMovers, "test-data")
+
+	a, configFile := setUpEncryptedConfig(c)
+	dir := filepath.Dir(configFile)
+	c.Assert(a.DataDirectory, Equals, "")
+	c.Assert(a.DataFile("outbox-test.json").Store([]byte("queued message")), IsNil)
+	c.Assert(a.DataFile("outbox-test.json").Store([]byte("another queued message")), IsNil)
+	c.Assert(os.MkdirAll(filepath.Join(dir, "test-data"), 0700), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "test-data", "content"), []byte("something"), 0600), IsNil)
+
+	c.Assert(a.SetUpDecoy(a.keySupplier, duressTestPassword, false), IsNil)
+
+	c.Assert(a.DataDirectory, Not(Equals), "")
+	c.Assert(fileExists(filepath.Join(dir, "outbox-test.json.enc")), Equals, false)
+	c.Assert(backupsOf(filepath.Join(dir, "outbox-test.json.enc")), HasLen, 0)
+	c.Assert(fileExists(filepath.Join(dir, "test-data")), Equals, false)
+	content, _ := ioutil.ReadFile(filepath.Join(a.DataDir("test-data"), "content"))
+	c.Assert(string(content), Equals, "something")
+	c.Assert(fileExists(configFile), Equals, true)
+
+	main, _, err := LoadOrCreate(configFile, passwordSupplier(testPassword))
+	c.Assert(err, IsNil)
+	c.Assert(main.DataDirectory, Equals, a.DataDirectory)
+	data, err := main.DataFile("outbox-test.json").Load()
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "another queued message")
+
+	decoy, _, err := LoadOrCreate(configFile, passwordSupplier(duressTestPassword))
+	c.Assert(err, IsNil)
+	c.Assert(decoy.DataDirectory, Not(Equals), "")
+	c.Assert(decoy.DataDirectory, Not(Equals), a.DataDirectory)
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_SetUpDecoy_canDestroyTheRealProfileWhenTheDecoyIsOpened(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+
+	c.Assert(a.SetUpDecoy(a.keySupplier, duressTestPassword, true), IsNil)
+	assertOpensWith(c, configFile, testPassword)
+
+	decoy, ok, err := LoadOrCreate(configFile, passwordSupplier(duressTestPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(decoy.DestroyOtherProfilesWhenOpened, Equals, false)
+
+	assertDoesNotOpenWith(c, configFile, testPassword)
+	c.Assert(fileExists(configFile+".backup.000~"), Equals, false)
+
+	decoy, ok, err = LoadOrCreate(configFile, passwordSupplier(duressTestPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(decoy.DestroyOtherProfilesWhenOpened, Equals, false)
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_SetUpDecoy_failsWithTheMainPassword(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	before, _ := ioutil.ReadFile(configFile)
+
+	c.Assert(a.SetUpDecoy(a.keySupplier, testPassword, false), Equals, ErrDuressPasswordIsMainPassword)
+
+	after, _ := ioutil.ReadFile(configFile)
+	c.Assert(after, DeepEquals, before)
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_ChangePassword_keepsTheDecoy(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	c.Assert(a.SetUpDecoy(a.keySupplier, duressTestPassword, false), IsNil)
+
+	c.Assert(a.ChangePassword(a.keySupplier, testPassword, newTestPassword), IsNil)
+
+	assertOpensWith(c, configFile, newTestPassword)
+	decoy, ok, err := LoadOrCreate(configFile, passwordSupplier(duressTestPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(decoy.Accounts, HasLen, 0)
+}
+
+func (s *DecoySuite) Test_LoadOrCreate_readsConfigurationFilesWrittenBeforeContainers(c *C) {
+	defer cheapArgon2()()
+	configFile := filepath.Join(c.MkDir(), "accounts.json.enc")
+	writeEncryptedTestFile(c, configFile, rekeyTestConfig, newEncryptionParameters())
+
+	a, ok, err := LoadOrCreate(configFile, passwordSupplier(testPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(a.Accounts, HasLen, 1)
+	c.Assert(a.container, IsNil)
+
+	c.Assert(a.Save(a.keySupplier), IsNil)
+	contents, _ := ioutil.ReadFile(configFile)
+	_, err = parseEncryptedContainer(contents)
+	c.Assert(err, IsNil)
+
+	a, ok, err = LoadOrCreate(configFile, passwordSupplier(testPassword))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(a.Accounts, HasLen, 1)
+}
+
+func (s *DecoySuite) Test_padSlot_padsToTheSlotSize(c *C) {
+	padded, err := padSlot([]byte("{}"))
+	c.Assert(err, IsNil)
+	c.Assert(padded, HasLen, containerSlotSize)
+
+	padded, err = padSlot(make([]byte, containerSlotSize))
+	c.Assert(err, IsNil)
+	c.Assert(padded, HasLen, containerSlotSize)
+
+	_, err = padSlot(make([]byte, containerSlotSize+1))
+	c.Assert(err, Equals, ErrConfigurationTooLarge)
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_Save_keepsTheSlotsOfTheSameSizeWhenTheConfigurationGrows(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	c.Assert(a.SetUpDecoy(a.keySupplier, duressTestPassword, false), IsNil)
+	before, _ := ioutil.ReadFile(configFile)
+
+	for i := 0; i < 50; i++ {
+		_, err := a.AddNewAccount()
+		c.Assert(err, IsNil)
+	}
+	c.Assert(a.Save(a.keySupplier), IsNil)
+
+	after, _ := ioutil.ReadFile(configFile)
+	container, err := parseEncryptedContainer(after)
+	c.Assert(err, IsNil)
+	c.Assert(len(container.Slots[0].Data), Equals, 2*(containerSlotSize+16))
+	c.Assert(len(container.Slots[1].Data), Equals, 2*(containerSlotSize+16))
+	c.Assert(len(after), Equals, len(before))
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_Save_givesEveryEncryptedProfileADataDirectoryOfItsOwn(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	dir := filepath.Dir(configFile)
+	c.Assert(a.DataDirectory, Equals, "")
+
+	c.Assert(a.Save(a.keySupplier), IsNil)
+
+	c.Assert(a.DataDirectory, Not(Equals), "")
+	c.Assert(fileExists(filepath.Join(dir, "outbox.json.enc")), Equals, false)
+	c.Assert(assertOpensWith(c, configFile, testPassword).DataDirectory, Equals, a.DataDirectory)
+}
+
+func (s *DecoySuite) Test_ApplicationConfig_Save_keepsTheDataOfPlainProfilesNextToTheConfigurationFile(c *C) {
+	configFile := setUpPlainConfig(c)
+	a, _, err := LoadOrCreate(configFile, noKeys())
+	c.Assert(err, IsNil)
+
+	c.Assert(a.Save(nil), IsNil)
+
+	c.Assert(a.DataDirectory, Equals, "")
+}
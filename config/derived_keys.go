@@ -0,0 +1,69 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"path/filepath"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// ErrConfigurationNotEncrypted is returned when trying to derive a key, but the configuration file
+// isn't encrypted, so there is no safe place to keep the secret keys are derived from
+var ErrConfigurationNotEncrypted = errors.New("the configuration file is not encrypted, so there is no secret to derive keys from")
+
+const (
+	derivedKeyLen          = 32
+	keyDerivationSecretLen = 32
+)
+
+// DeriveKey returns a key for the given purpose, derived from a random secret kept in the encrypted configuration
+// file. Different purposes will always get unrelated keys. The secret is generated and saved the first time a key is
+// derived, so the keys don't depend on the master password, and stay the same when it changes.
+func (a *ApplicationConfig) DeriveKey(purpose string) ([]byte, error) {
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if !a.shouldEncrypt {
+		return nil, ErrConfigurationNotEncrypted
+	}
+
+	if a.keySupplier == nil {
+		return nil, ErrDataFileNeedsPassword
+	}
+
+	if a.KeyDerivationSecret != "" {
+		if secret, err := hex.DecodeString(a.KeyDerivationSecret); err == nil {
+			return deriveKey(secret, purpose), nil
+		}
+	}
+
+	// Nothing can have been encrypted with a key derived from the secret before it's saved
+	secret := genRand(keyDerivationSecretLen)
+	a.KeyDerivationSecret = hex.EncodeToString(secret)
+	if err := a.save(a.keySupplier); err != nil {
+		a.KeyDerivationSecret = ""
+		return nil, err
+	}
+
+	return deriveKey(secret, purpose), nil
+}
+
+func deriveKey(secret []byte, purpose string) []byte {
+	res := make([]byte, derivedKeyLen)
+	_, _ = io.ReadFull(hkdf.New(sha256.New, secret, nil, []byte("decoyim "+purpose)), res)
+	return res
+}
+
+// DataDir returns the path to a directory with the given name, in the data directory of the profile
+func (a *ApplicationConfig) DataDir(name string) string {
+	return filepath.Join(a.dataDirectory(), name)
+}
+
+// dataDirectory returns the directory the data files of the profile are kept in.
+// Unless the profile says otherwise, it's the directory of the configuration file.
+func (a *ApplicationConfig) dataDirectory() string {
+	return filepath.Join(filepath.Dir(a.filename), a.DataDirectory)
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"bytes"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type DerivedKeysSuite struct{}
+
+var _ = Suite(&DerivedKeysSuite{})
+
+func (s *DerivedKeysSuite) Test_ApplicationConfig_DeriveKey_needsAnEncryptedConfiguration(c *C) {
+	a := &ApplicationConfig{keySupplier: testKeySupplier()}
+
+	_, err := a.DeriveKey("history")
+	c.Assert(err, Equals, ErrConfigurationNotEncrypted)
+
+	a.shouldEncrypt = true
+	a.keySupplier = nil
+	_, err = a.DeriveKey("history")
+	c.Assert(err, Equals, ErrDataFileNeedsPassword)
+}
+
+func (s *DerivedKeysSuite) Test_ApplicationConfig_DeriveKey_givesDifferentKeysForDifferentPurposes(c *C) {
+	defer cheapArgon2()()
+	a := &ApplicationConfig{filename: filepath.Join(c.MkDir(), "accounts.json.enc"), shouldEncrypt: true, keySupplier: testKeySupplier()}
+
+	k1, err := a.DeriveKey("history")
+	c.Assert(err, IsNil)
+	c.Assert(k1, HasLen, 32)
+
+	k2, _ := a.DeriveKey("history")
+	c.Assert(k2, DeepEquals, k1)
+
+	k3, _ := a.DeriveKey("something else")
+	c.Assert(bytes.Equal(k1, k3), Equals, false)
+	c.Assert(bytes.Contains(k1, testKey), Equals, false)
+	c.Assert(bytes.Equal(k1, deriveKey(append(append([]byte{}, testKey...), testMacKey...), "history")), Equals, false)
+}
+
+func (s *DerivedKeysSuite) Test_ApplicationConfig_DeriveKey_savesTheSecretBeforeGivingAKey(c *C) {
+	defer cheapArgon2()()
+	a, configFile := setUpEncryptedConfig(c)
+	c.Assert(a.KeyDerivationSecret, Equals, "")
+
+	k, err := a.DeriveKey("history")
+	c.Assert(err, IsNil)
+	c.Assert(a.KeyDerivationSecret, HasLen, 2*keyDerivationSecretLen)
+
+	a2 := assertOpensWith(c, configFile, testPassword)
+	c.Assert(a2.KeyDerivationSecret, Equals, a.KeyDerivationSecret)
+	k2, _ := a2.DeriveKey("history")
+	c.Assert(k2, DeepEquals, k)
+}
+
+func (s *DerivedKeysSuite) Test_ApplicationConfig_DataDir(c *C) {
+	a := &ApplicationConfig{filename: filepath.Join("some", "dir", "accounts.json.enc")}
+	c.Assert(a.DataDir("history"), Equals, filepath.Join("some", "dir", "history"))
+}
@@ -8,8 +8,6 @@ import (
 	"encoding/json"
 	"errors"
 	"sync"
-
-	"golang.org/x/crypto/scrypt"
 )
 
 const encryptedFileEnding = ".enc"
@@ -21,13 +19,18 @@ type encryptedData struct {
 
 // We will generate a new nonce every time we encrypt, but we will keep the salt the same. This way we can cache the scrypted password
 
-// EncryptionParameters contains the parameters used for scrypting the password and encrypting the configuration file
+// EncryptionParameters contains the parameters used for generating keys from the password and encrypting the configuration file.
+// Depending on the version, the keys are generated with scrypt, using N, R and P, or with Argon2id, using Time, Memory and Threads.
 type EncryptionParameters struct {
-	Nonce string
-	Salt  string
-	N     int
-	R     int
-	P     int
+	Version int `json:",omitempty"`
+	Nonce   string
+	Salt    string
+	N       int    `json:",omitempty"`
+	R       int    `json:",omitempty"`
+	P       int    `json:",omitempty"`
+	Time    uint32 `json:",omitempty"`
+	Memory  uint32 `json:",omitempty"`
+	Threads uint8  `json:",omitempty"`
 
 	//Similarly to ApplicationConfig, EncryptionParameters should be just a JSON representation of whatever we use internally to represent application configuration.
 	nonceInternal []byte
@@ -49,10 +52,12 @@ func (p *EncryptionParameters) regenerateNonce() {
 }
 
 func newEncryptionParameters() EncryptionParameters {
+	cost := calibrateArgon2()
 	res := EncryptionParameters{
-		N: 262144, // 2 ** 18
-		R: 8,
-		P: 1,
+		Version: currentEncryptionParametersVersion,
+		Time:    cost.time,
+		Memory:  cost.memory,
+		Threads: cost.threads,
 	}
 	res.regenerateNonce()
 	res.saltInternal = genRand(saltLen)
@@ -64,9 +69,9 @@ const macKeyLen = 16
 const nonceLen = 12
 const saltLen = 16
 
-// GenerateKeys takes a password and encryption parameters and generates an AES key and a MAC key using SCrypt
+// GenerateKeys takes a password and encryption parameters and generates an AES key and a MAC key using the KDF of the parameters
 func GenerateKeys(password string, params EncryptionParameters) ([]byte, []byte) {
-	res, _ := scrypt.Key([]byte(password), params.saltInternal, params.N, params.R, params.P, aesKeyLen+macKeyLen)
+	res := generateKeyMaterial(password, params)
 	return res[0:aesKeyLen], res[aesKeyLen:]
 }
 
@@ -101,6 +106,16 @@ func (p *EncryptionParameters) deserialize() (e error) {
 		return errDecryptionParamsEmpty
 	}
 
+	switch p.version() {
+	case encryptionParametersScrypt:
+	case encryptionParametersArgon2id:
+		if p.Time == 0 || p.Memory == 0 || p.Threads == 0 {
+			return errUnsupportedEncryptionParameters
+		}
+	default:
+		return errUnsupportedEncryptionParameters
+	}
+
 	return nil
 }
 
@@ -148,21 +163,41 @@ func decryptConfiguration(content []byte, ks KeySupplier) ([]byte, *EncryptionPa
 }
 
 func encryptConfiguration(content string, params *EncryptionParameters, ks KeySupplier) ([]byte, error) {
+	dd, err := sealData(content, params, ks)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(dd, "", "\t")
+}
+
+func sealData(content string, params *EncryptionParameters, ks KeySupplier) (*encryptedData, error) {
 	key, macKey, ok := ks.GenerateKey(*params)
 	if !ok {
 		return nil, errors.New("no password supplied, aborting")
 	}
 
+	return sealDataWith(content, params, key, macKey), nil
+}
+
+func sealDataWith(content string, params *EncryptionParameters, key, macKey []byte) *encryptedData {
 	ctext := encryptData(key, macKey, params.nonceInternal, content)
 
 	params.serialize()
 
-	dd := encryptedData{
+	return &encryptedData{
 		Params: *params,
 		Data:   hex.EncodeToString(ctext),
 	}
+}
 
-	return json.MarshalIndent(dd, "", "\t")
+func (ed *encryptedData) decryptWith(keys generatedKeys) ([]byte, error) {
+	ctext, err := hex.DecodeString(ed.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptData(keys.key, keys.macKey, ed.Params.nonceInternal, ctext)
 }
 
 // KeySupplier is a function that can be used to get key data from a user
@@ -237,3 +272,91 @@ func CachingKeySupplier(getKeys func(params EncryptionParameters, lastAttemptFai
 		getKeys: getKeys,
 	}
 }
+
+type generatedKeys struct {
+	key, macKey []byte
+}
+
+type passwordKeySupplier struct {
+	sync.Mutex
+	keys              map[string]generatedKeys
+	upgraded          *EncryptionParameters
+	getPassword       func(lastAttemptFailed bool) (string, bool)
+	lastAttemptFailed bool
+}
+
+// PasswordKeySupplier is a key supplier that only asks the user for the password if it doesn't already have
+// keys for the given parameters. When the password is given for outdated parameters, keys for new parameters
+// are generated at the same time, so that files can be upgraded to them without asking for the password again.
+func PasswordKeySupplier(getPassword func(lastAttemptFailed bool) (string, bool)) KeySupplier {
+	return &passwordKeySupplier{
+		keys:        make(map[string]generatedKeys),
+		getPassword: getPassword,
+	}
+}
+
+func (pk *passwordKeySupplier) LastAttemptFailed() {
+	pk.lastAttemptFailed = true
+}
+
+func (pk *passwordKeySupplier) Invalidate() {
+	pk.Lock()
+	defer pk.Unlock()
+	pk.keys = make(map[string]generatedKeys)
+	pk.upgraded = nil
+}
+
+func (pk *passwordKeySupplier) GenerateKey(params EncryptionParameters) ([]byte, []byte, bool) {
+	keys, ok := pk.generateKeysForAll([]EncryptionParameters{params})
+	if !ok {
+		return nil, nil, false
+	}
+	return keys[0].key, keys[0].macKey, true
+}
+
+// generateKeysForAll returns keys for all the parameters, generated from the same password.
+// The password is only asked for if keys for some of the parameters are missing.
+func (pk *passwordKeySupplier) generateKeysForAll(params []EncryptionParameters) ([]generatedKeys, bool) {
+	pk.Lock()
+	defer pk.Unlock()
+
+	res := make([]generatedKeys, len(params))
+	haveAll := true
+	for i, p := range params {
+		k, ok := pk.keys[p.kdfID()]
+		res[i] = k
+		haveAll = haveAll && ok
+	}
+
+	if haveAll {
+		return res, true
+	}
+
+	laf := pk.lastAttemptFailed
+	pk.lastAttemptFailed = false
+	password, ok := pk.getPassword(laf)
+	if !ok {
+		return nil, false
+	}
+
+	for i, p := range params {
+		key, macKey := GenerateKeys(password, p)
+		res[i] = generatedKeys{key, macKey}
+		pk.keys[p.kdfID()] = res[i]
+
+		if p.isOutdated() && pk.upgraded == nil {
+			np := newEncryptionParameters()
+			nkey, nmacKey := GenerateKeys(password, np)
+			pk.keys[np.kdfID()] = generatedKeys{nkey, nmacKey}
+			pk.upgraded = &np
+		}
+	}
+
+	return res, true
+}
+
+func (pk *passwordKeySupplier) upgradedParameters() (*EncryptionParameters, bool) {
+	pk.Lock()
+	defer pk.Unlock()
+	return pk.upgraded, pk.upgraded != nil
+}
@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 )
 
 func fileExists(filename string) bool {
@@ -21,52 +23,160 @@ func ensureDir(dirname string, perm os.FileMode) {
 
 func findConfigFile(filename string) string {
 	if len(filename) == 0 {
-		dir := configDir()
-		ensureDir(dir, 0700)
-		basePath := filepath.Join(dir, "accounts.json")
-		switch {
-		case fileExists(basePath + encryptedFileEnding):
-			return basePath + encryptedFileEnding
-		case fileExists(basePath + encryptedFileEnding + tmpExtension):
-			return basePath + encryptedFileEnding
-		}
-		return basePath
+		return configFileIn(configDir())
 	}
 	ensureDir(filepath.Dir(filename), 0700)
 	return filename
 }
 
+// configFileIn returns the configuration file in the directory, encrypted or not
+func configFileIn(dir string) string {
+	ensureDir(dir, 0700)
+	basePath := filepath.Join(dir, "accounts.json")
+	switch {
+	case fileExists(basePath + encryptedFileEnding):
+		return basePath + encryptedFileEnding
+	case fileExists(basePath + encryptedFileEnding + tmpExtension):
+		return basePath + encryptedFileEnding
+	}
+	return basePath
+}
+
 const tmpExtension = ".000~"
 
 var osRename = os.Rename
 
+// safeWrite replaces the file with the data in the same way as atomicWrite, but keeps the previous content
+// of the file as a backup
 func safeWrite(name string, data []byte, perm os.FileMode) error {
-	// This function will leave a backup of the config file every time it writes
+	return safeWriteKeepingBackups(name, data, perm, 1)
+}
 
+// safeWriteKeepingBackups is safeWrite, but keeps the given number of previous versions of the file.
+// The newest backup is always the first one, and the oldest is removed once there are enough of them.
+func safeWriteKeepingBackups(name string, data []byte, perm os.FileMode, kept int) error {
 	if len(data) < 10 {
 		return errors.New("data amount too small - unlikely to be real data")
 	}
 
-	backupName := fmt.Sprintf("%s.backup.000~", name)
+	tempName := fmt.Sprintf("%s%s", name, tmpExtension)
+	if err := writeAndSync(tempName, data, perm); err != nil {
+		_ = os.Remove(tempName)
+		return err
+	}
 
-	if fileExists(backupName) {
-		_ = os.Remove(backupName)
+	if err := rotateBackups(name, kept); err != nil {
+		_ = os.Remove(tempName)
+		return err
 	}
 
 	if fileExists(name) {
-		err := osRename(name, backupName)
-		if err != nil {
+		if err := linkOrCopy(name, backupName(name, 0), perm); err != nil {
+			_ = os.Remove(tempName)
 			return err
 		}
 	}
 
-	tempName := fmt.Sprintf("%s%s", name, tmpExtension)
-	err := ioutil.WriteFile(tempName, data, perm)
+	if err := osRename(tempName, name); err != nil {
+		return err
+	}
+
+	syncDir(filepath.Dir(name))
+	return nil
+}
+
+// backupName returns the name of the nth newest backup of the file
+func backupName(name string, n int) string {
+	return fmt.Sprintf("%s.backup.%03d~", name, n)
+}
+
+// backupsOf returns the backups of the file, newest first
+func backupsOf(name string) []string {
+	res, _ := filepath.Glob(name + ".backup.[0-9][0-9][0-9]~")
+	sort.Strings(res)
+	return res
+}
+
+// rotateBackups makes room for a new backup of the file, removing the ones that are too old to keep
+func rotateBackups(name string, kept int) error {
+	backups := backupsOf(name)
+	for i := len(backups) - 1; i >= 0; i-- {
+		var n int
+		if _, err := fmt.Sscanf(strings.TrimPrefix(backups[i], name), ".backup.%03d~", &n); err != nil {
+			continue
+		}
+
+		if n+1 >= kept {
+			if err := os.Remove(backups[i]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.Rename(backups[i], backupName(name, n+1)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkOrCopy makes a backup of the file under another name, without ever leaving the file itself missing
+func linkOrCopy(name, backupName string, perm os.FileMode) error {
+	if err := os.Link(name, backupName); err == nil {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(filepath.Clean(name))
+	if err != nil {
+		return err
+	}
+	return writeAndSync(backupName, data, perm)
+}
+
+// writeAndSync writes the file and makes sure it has reached the disk before returning
+func writeAndSync(name string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(filepath.Clean(name), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// syncDir makes sure renames and removals in the directory have reached the disk.
+// Not all systems can do this, so failures are ignored.
+func syncDir(dir string) {
+	d, err := os.Open(filepath.Clean(dir))
 	if err != nil {
+		return
+	}
+	_ = d.Sync()
+	_ = d.Close()
+}
+
+// atomicWrite replaces the file with the data, so that the file has either the old or the new content,
+// even if the application or the machine stops in the middle of it
+func atomicWrite(name string, data []byte, perm os.FileMode) error {
+	tempName := fmt.Sprintf("%s%s", name, tmpExtension)
+	if err := writeAndSync(tempName, data, perm); err != nil {
+		_ = os.Remove(tempName)
+		return err
+	}
+
+	if err := osRename(tempName, name); err != nil {
 		return err
 	}
 
-	return osRename(tempName, name)
+	syncDir(filepath.Dir(name))
+	return nil
 }
 
 func readFileOrTemporaryBackup(name string) (data []byte, e error) {
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileLock is an advisory lock that keeps other instances from reading or writing the configuration file
+// while it's being written. The lock is taken on a file of its own, since the configuration file is
+// replaced on every write, and it's the same for the encrypted and the unencrypted file.
+type fileLock struct {
+	f *os.File
+}
+
+// errConfigFileLocked is returned when another instance holds the lock for the configuration file, and we can't wait for it
+var errConfigFileLocked = errors.New("the configuration file is in use by another instance")
+
+func lockFileFor(name string) string {
+	return strings.TrimSuffix(name, encryptedFileEnding) + ".lock"
+}
+
+// lockConfigFile waits until the lock for the configuration file can be taken. Several readers can hold
+// the lock at the same time, but a writer holds it alone.
+func lockConfigFile(name string, exclusive bool) (*fileLock, error) {
+	ensureDir(filepath.Dir(name), 0700)
+	f, err := os.OpenFile(filepath.Clean(lockFileFor(name)), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lockFile(f, exclusive); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &fileLock{f}, nil
+}
+
+// tryLockConfigFile takes the lock for the configuration file to write it, if no other instance holds it right now
+func tryLockConfigFile(name string) (*fileLock, error) {
+	ensureDir(filepath.Dir(name), 0700)
+	f, err := os.OpenFile(filepath.Clean(lockFileFor(name)), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tryLockFile(f); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &fileLock{f}, nil
+}
+
+func (l *fileLock) unlock() {
+	_ = unlockFile(l.f)
+	_ = l.f.Close()
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// The whole file is locked, which is what a length of all ones means to LockFileEx
+const allOfTheFile = ^uint32(0)
+
+func lockFile(f *os.File, exclusive bool) error {
+	var flags uint32
+	if exclusive {
+		flags = windows.LOCKFILE_EXCLUSIVE_LOCK
+	}
+
+	return windows.LockFileEx(windows.Handle(f.Fd()), flags, 0, allOfTheFile, allOfTheFile, new(windows.Overlapped))
+}
+
+func tryLockFile(f *os.File) error {
+	err := windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, allOfTheFile, allOfTheFile, new(windows.Overlapped))
+	if err == windows.ERROR_LOCK_VIOLATION {
+		return errConfigFileLocked
+	}
+	return err
+}
+
+func unlockFile(f *os.File) error {
+	return windows.UnlockFileEx(windows.Handle(f.Fd()), 0, allOfTheFile, allOfTheFile, new(windows.Overlapped))
+}
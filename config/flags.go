@@ -5,6 +5,7 @@ import "flag"
 // These flags represent all the available command line flags
 var (
 	ConfigFile          = flag.String("config-file", "", "Location of the config file")
+	ProfileFlag         = flag.String("profile", "", "The name of the profile to use. Every profile has its own configuration, history and settings")
 	ConfigFileEncrypted = flag.Bool("config-file-encrypted", false, "Force config file to be encrypted even if the file name doesn't match the expected pattern")
 	CreateAccount       = flag.Bool("create", false, "If true, attempt to create account")
 	DebugFlag           = flag.Bool("debug", false, "Enable debug logging")
@@ -0,0 +1,28 @@
+package config
+
+import "github.com/chadsec1/decoyim/xmpp/jid"
+
+// HistoryRetentionFor returns how many messages, and for how many days, the history of the conversation
+// with the given peer or room should be kept. Zero means no limit.
+func (a *Account) HistoryRetentionFor(uid string) (maxMessages, maxAgeDays int) {
+	maxMessages, maxAgeDays = a.HistoryMaxMessages, a.HistoryMaxAgeDays
+
+	if p, ok := a.GetPeer(jid.Parse(uid).NoResource().String()); ok {
+		if p.HistoryMaxMessages != 0 {
+			maxMessages = p.HistoryMaxMessages
+		}
+		if p.HistoryMaxAgeDays != 0 {
+			maxAgeDays = p.HistoryMaxAgeDays
+		}
+	}
+
+	return
+}
+
+// SetHistoryRetentionFor sets the history retention for the conversation with the given peer or room.
+// Zero values mean the account defaults are used.
+func (a *Account) SetHistoryRetentionFor(uid string, maxMessages, maxAgeDays int) {
+	p := a.EnsurePeer(jid.Parse(uid).NoResource().String())
+	p.HistoryMaxMessages = maxMessages
+	p.HistoryMaxAgeDays = maxAgeDays
+}
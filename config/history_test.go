@@ -0,0 +1,31 @@
+package config
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type HistoryConfigSuite struct{}
+
+var _ = Suite(&HistoryConfigSuite{})
+
+func (s *HistoryConfigSuite) Test_Account_HistoryRetentionFor_usesTheAccountDefaults(c *C) {
+	a := &Account{HistoryMaxMessages: 1000, HistoryMaxAgeDays: 30}
+
+	m, d := a.HistoryRetentionFor("someone@example.org")
+	c.Assert(m, Equals, 1000)
+	c.Assert(d, Equals, 30)
+}
+
+func (s *HistoryConfigSuite) Test_Account_HistoryRetentionFor_canBeOverriddenPerPeer(c *C) {
+	a := &Account{HistoryMaxMessages: 1000, HistoryMaxAgeDays: 30}
+	a.SetHistoryRetentionFor("someone@example.org", 0, 2)
+	a.SetHistoryRetentionFor("room@conference.example.org", 50, 0)
+
+	m, d := a.HistoryRetentionFor("someone@example.org/phone")
+	c.Assert(m, Equals, 1000)
+	c.Assert(d, Equals, 2)
+
+	m, d = a.HistoryRetentionFor("room@conference.example.org")
+	c.Assert(m, Equals, 50)
+	c.Assert(d, Equals, 30)
+}
@@ -0,0 +1,143 @@
+package importer
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chadsec1/decoyim/session/history"
+)
+
+// In the Adium config directory. Under it, there is one directory for every account, named
+// after the service and the account, with one directory for every peer. Every conversation
+// is kept in a .chatlog bundle, or in a .chatlog file in older versions of Adium.
+const adiumLogsDir = "Logs"
+
+const adiumJabberLogPrefix = "Jabber."
+
+const adiumChatlogExtension = ".chatlog"
+
+// Older versions of Adium don't use a colon in the time zone
+var adiumTimeFormats = []string{time.RFC3339Nano, "2006-01-02T15:04:05-0700"}
+
+type adiumChatlogXML struct {
+	Account   string            `xml:"account,attr"`
+	GroupChat string            `xml:"groupchat,attr"`
+	Messages  []adiumMessageXML `xml:"message"`
+}
+
+type adiumMessageXML struct {
+	Sender string `xml:"sender,attr"`
+	Alias  string `xml:"alias,attr"`
+	Time   string `xml:"time,attr"`
+	Body   string `xml:",innerxml"`
+}
+
+func parseAdiumTime(s string) (time.Time, bool) {
+	for _, f := range adiumTimeFormats {
+		if t, err := time.Parse(f, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// importChatlogAdiumStyle reads the messages in one Adium chat log, returning the conversation they belong to
+func importChatlogAdiumStyle(f, account, peer string) (history.Conversation, []history.Message, bool) {
+	content, err := ioutil.ReadFile(filepath.Clean(f))
+	if err != nil {
+		return history.Conversation{}, nil, false
+	}
+
+	var chat adiumChatlogXML
+	if err := xml.Unmarshal(content, &chat); err != nil {
+		return history.Conversation{}, nil, false
+	}
+
+	if a := bareJIDOf(chat.Account); a != "" {
+		account = a
+	}
+
+	c := history.Conversation{Account: account, Peer: peer, Room: chat.GroupChat == "true"}
+
+	var result []history.Message
+	for _, m := range chat.Messages {
+		t, ok := parseAdiumTime(m.Time)
+		body := plainTextOf(m.Body)
+		if !ok || body == "" {
+			continue
+		}
+
+		outgoing := bareJIDOf(m.Sender) == account
+		from := peer
+		switch {
+		case c.Room:
+			from = m.Sender
+			if m.Alias != "" {
+				from = m.Alias
+			}
+		case outgoing:
+			from = account
+		}
+
+		result = append(result, history.Message{Time: t, From: from, Outgoing: outgoing, Body: body})
+	}
+
+	return c, result, true
+}
+
+// chatlogsIn returns the chat logs for one peer. Chat logs are either XML files, or bundles with the XML file inside.
+func chatlogsIn(dir string) []string {
+	var result []string
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+
+		ext := filepath.Ext(path)
+		inBundle := strings.HasSuffix(filepath.Dir(path), adiumChatlogExtension)
+		if ext == adiumChatlogExtension || (ext == ".xml" && inBundle) {
+			result = append(result, path)
+		}
+		return nil
+	})
+	return result
+}
+
+// importLogsAdiumStyle reads all logs in an Adium logs directory
+func importLogsAdiumStyle(dir string) Logs {
+	res := make(Logs)
+	for _, accountDir := range subdirectoriesOf(dir) {
+		if !strings.HasPrefix(accountDir, adiumJabberLogPrefix) {
+			continue
+		}
+		account := bareJIDOf(strings.TrimPrefix(accountDir, adiumJabberLogPrefix))
+
+		for _, peerDir := range subdirectoriesOf(filepath.Join(dir, accountDir)) {
+			peer := bareJIDOf(peerDir)
+			if peer == "" {
+				continue
+			}
+
+			for _, f := range chatlogsIn(filepath.Join(dir, accountDir, peerDir)) {
+				c, ms, ok := importChatlogAdiumStyle(f, account, peer)
+				if ok && c.Account != "" {
+					res.add(c, ms...)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// TryImportLogs reads the message logs of Adium
+func (p *adiumImporter) TryImportLogs() Logs {
+	dd, ok := p.findDir()
+	if !ok {
+		return nil
+	}
+	return importLogsAdiumStyle(filepath.Join(dd, adiumLogsDir))
+}
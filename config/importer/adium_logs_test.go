@@ -0,0 +1,38 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/chadsec1/decoyim/session/history"
+	. "gopkg.in/check.v1"
+)
+
+type AdiumLogsSuite struct{}
+
+var _ = Suite(&AdiumLogsSuite{})
+
+func (s *AdiumLogsSuite) Test_importLogsAdiumStyle_readsChatlogBundlesAndFiles(c *C) {
+	res := importLogsAdiumStyle(testResourceFilename("adium_test_data/Logs"))
+
+	c.Assert(res.Accounts(), DeepEquals, []string{"alice@example.com"})
+
+	ms := res[history.Conversation{Account: "alice@example.com", Peer: "bob@example.com"}]
+	c.Assert(ms, HasLen, 3)
+
+	c.Assert(ms[0].Body, Equals, "an old message")
+	c.Assert(ms[0].Time.Equal(time.Date(2008, 9, 10, 23, 1, 24, 0, time.UTC)), Equals, true)
+
+	c.Assert(ms[1].From, Equals, "bob@example.com")
+	c.Assert(ms[1].Outgoing, Equals, false)
+	c.Assert(ms[1].Body, Equals, "hi & welcome")
+	c.Assert(ms[1].Time.Equal(time.Date(2016, 3, 4, 11, 1, 5, 0, time.UTC)), Equals, true)
+
+	c.Assert(ms[2].From, Equals, "alice@example.com")
+	c.Assert(ms[2].Outgoing, Equals, true)
+	c.Assert(ms[2].Body, Equals, "line one\nline two")
+}
+
+func (s *AdiumLogsSuite) Test_importChatlogAdiumStyle_failsForFilesThatArentChatlogs(c *C) {
+	_, _, ok := importChatlogAdiumStyle(testResourceFilename("adium_test_data/otr.private_key"), "alice@example.com", "bob@example.com")
+	c.Assert(ok, Equals, false)
+}
@@ -7,8 +7,13 @@ func TryImportAll() map[string][]*config.ApplicationConfig {
 	res := make(map[string][]*config.ApplicationConfig)
 
 	res["Adium"] = (&adiumImporter{}).TryImport()
+	res["CoyIM"] = (&coyimImporter{}).TryImport()
+	res["Dino"] = (&dinoImporter{}).TryImport()
 	res["Gajim"] = (&gajimImporter{}).TryImport()
+	res["Gajim 1.x"] = (&gajim1Importer{}).TryImport()
 	res["Pidgin"] = (&pidginImporter{}).TryImport()
+	res["Profanity"] = (&profanityImporter{}).TryImport()
+	res["Psi+"] = (&psiImporter{}).TryImport()
 	res["xmpp-client"] = (&xmppClientImporter{}).TryImport()
 
 	return res
@@ -21,7 +21,12 @@ func (s *AllSuite) Test_TryImportAll(c *C) {
 
 	res := TryImportAll()
 	c.Assert(res["Adium"], HasLen, 0)
+	c.Assert(res["CoyIM"], HasLen, 0)
+	c.Assert(res["Dino"], HasLen, 0)
 	c.Assert(res["Gajim"], HasLen, 0)
+	c.Assert(res["Gajim 1.x"], HasLen, 0)
 	c.Assert(res["Pidgin"], HasLen, 0)
+	c.Assert(res["Profanity"], HasLen, 0)
+	c.Assert(res["Psi+"], HasLen, 0)
 	c.Assert(res["xmpp-client"], HasLen, 0)
 }
@@ -0,0 +1,49 @@
+package importer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/chadsec1/decoyim/config"
+)
+
+// In the system config directory
+const coyimConfigDir = "coyim"
+
+// In the CoyIM config directory
+const coyimAccountsFile = "accounts.json"
+
+type coyimImporter struct{}
+
+// importFrom reads an upstream CoyIM configuration file. DecoyIM uses the same format for
+// its accounts, so everything in it is kept - proxies, keys, fingerprints and certificate pins.
+// Encrypted configuration files are not read, since that would need the password of CoyIM.
+func (c *coyimImporter) importFrom(f string) (*config.ApplicationConfig, bool) {
+	contents, err := ioutil.ReadFile(filepath.Clean(f))
+	if err != nil {
+		return nil, false
+	}
+
+	a := new(config.ApplicationConfig)
+	if err := json.Unmarshal(contents, a); err != nil || len(a.Accounts) == 0 {
+		return nil, false
+	}
+
+	a.UpdateToLatestVersion()
+	sort.Sort(config.ByAccountNameAlphabetic(a.Accounts))
+
+	return a, true
+}
+
+func (c *coyimImporter) TryImport() []*config.ApplicationConfig {
+	var res []*config.ApplicationConfig
+
+	ac, ok := c.importFrom(filepath.Join(config.SystemConfigDir(), coyimConfigDir, coyimAccountsFile))
+	if ok {
+		res = append(res, ac)
+	}
+
+	return res
+}
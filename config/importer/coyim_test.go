@@ -0,0 +1,59 @@
+package importer
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type CoyIMSuite struct{}
+
+var _ = Suite(&CoyIMSuite{})
+
+func (s *CoyIMSuite) Test_CoyIMImporter_canImportConfiguration(c *C) {
+	importer := coyimImporter{}
+
+	res, ok := importer.importFrom(testResourceFilename("coyim_test_data/accounts.json"))
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res.Accounts, HasLen, 2)
+
+	alice := res.Accounts[0]
+	c.Assert(alice.Account, Equals, "alice@example.com")
+	c.Assert(alice.Server, Equals, "xmpp.example.com")
+	c.Assert(alice.Port, Equals, 5223)
+	c.Assert(alice.Proxies, DeepEquals, []string{"socks5://127.0.0.1:9050"})
+	c.Assert(alice.ConnectAutomatically, Equals, true)
+
+	p, ok := alice.GetPeer("carol@example.com")
+	c.Assert(ok, Equals, true)
+	c.Assert(p.Nickname, Equals, "Carol")
+	c.Assert(p.Fingerprints[0].Fingerprint, DeepEquals, decode("c8123327e389e3d036ba91cf92d722f515057b61"))
+	c.Assert(p.Fingerprints[0].Trusted, Equals, true)
+
+	c.Assert(alice.Certificates, HasLen, 1)
+	c.Assert(alice.Certificates[0].FingerprintType, Equals, "SHA3-256")
+	c.Assert(alice.Certificates[0].Issuer, Equals, "Example CA")
+
+	bob := res.Accounts[1]
+	c.Assert(bob.Account, Equals, "bob@other.org")
+	c.Assert(bob.Proxies, DeepEquals, []string{"tor-auto://"})
+	c.Assert(bob.LegacyKnownFingerprints, HasLen, 0)
+
+	p, ok = bob.GetPeer("alice@example.com")
+	c.Assert(ok, Equals, true)
+	c.Assert(p.Fingerprints[0].Fingerprint, DeepEquals, decode("57d8ea36c76d5d800fe790c56dc33feb254e899b"))
+	c.Assert(p.Fingerprints[0].Trusted, Equals, true)
+}
+
+func (s *CoyIMSuite) Test_CoyIMImporter_failsOnMissingFile(c *C) {
+	importer := coyimImporter{}
+
+	_, ok := importer.importFrom(testResourceFilename("coyim_test_data/accounts.json.enc"))
+	c.Assert(ok, Equals, false)
+}
+
+func (s *CoyIMSuite) Test_CoyIMImporter_failsOnConfigurationWithoutAccounts(c *C) {
+	importer := coyimImporter{}
+
+	_, ok := importer.importFrom(testResourceFilename("xmpp_client_test_conf.json"))
+	c.Assert(ok, Equals, false)
+}
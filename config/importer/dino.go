@@ -0,0 +1,66 @@
+package importer
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/config/importer/sqlite"
+)
+
+// In the XDG data directory
+const dinoDataDir = "dino"
+
+// In the Dino data directory
+const dinoDatabaseFile = "dino.db"
+
+type dinoImporter struct{}
+
+// importFrom reads the accounts in the Dino database. Dino doesn't support OTR or proxies,
+// and doesn't remember certificates, so only the addresses and passwords are brought over.
+func (d *dinoImporter) importFrom(f string) (*config.ApplicationConfig, bool) {
+	db, err := sqlite.Open(f)
+	if err != nil {
+		return nil, false
+	}
+
+	rows, err := db.Rows("account")
+	if err != nil {
+		return nil, false
+	}
+
+	res := &config.ApplicationConfig{}
+	for _, r := range rows {
+		account := bareJIDOf(sqliteString(r["bare_jid"]))
+		if account == "" {
+			continue
+		}
+
+		res.Add(&config.Account{
+			Account:  account,
+			Nickname: sqliteString(r["alias"]),
+			Password: sqliteString(r["password"]),
+			Port:     5222,
+			Proxies:  make([]string, 0),
+		})
+	}
+
+	if len(res.Accounts) == 0 {
+		return nil, false
+	}
+
+	sort.Sort(config.ByAccountNameAlphabetic(res.Accounts))
+
+	return res, true
+}
+
+func (d *dinoImporter) TryImport() []*config.ApplicationConfig {
+	var res []*config.ApplicationConfig
+
+	ac, ok := d.importFrom(filepath.Join(config.XdgDataHome(), dinoDataDir, dinoDatabaseFile))
+	if ok {
+		res = append(res, ac)
+	}
+
+	return res
+}
@@ -0,0 +1,42 @@
+package importer
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type DinoSuite struct{}
+
+var _ = Suite(&DinoSuite{})
+
+func (s *DinoSuite) Test_DinoImporter_canImportAccountsFromDatabase(c *C) {
+	importer := dinoImporter{}
+
+	res, ok := importer.importFrom(testResourceFilename("dino_test_data/dino.db"))
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res.Accounts, HasLen, 2)
+
+	c.Assert(res.Accounts[0].Account, Equals, "alice@example.com")
+	c.Assert(res.Accounts[0].Nickname, Equals, "Alice")
+	c.Assert(res.Accounts[0].Password, Equals, "alices secret")
+	c.Assert(res.Accounts[0].Port, Equals, 5222)
+	c.Assert(res.Accounts[0].Proxies, HasLen, 0)
+
+	c.Assert(res.Accounts[1].Account, Equals, "bob@other.org")
+	c.Assert(res.Accounts[1].Nickname, Equals, "")
+	c.Assert(res.Accounts[1].Password, Equals, "bobs secret")
+}
+
+func (s *DinoSuite) Test_DinoImporter_failsOnMissingDatabase(c *C) {
+	importer := dinoImporter{}
+
+	_, ok := importer.importFrom(testResourceFilename("dino_test_data/nonexistent.db"))
+	c.Assert(ok, Equals, false)
+}
+
+func (s *DinoSuite) Test_DinoImporter_failsOnFileThatIsNotADatabase(c *C) {
+	importer := dinoImporter{}
+
+	_, ok := importer.importFrom(testResourceFilename("coyim_test_data/accounts.json"))
+	c.Assert(ok, Equals, false)
+}
@@ -0,0 +1,188 @@
+package importer
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/config/importer/sqlite"
+)
+
+// In the Gajim config directory. Gajim 1.0 and later keep their settings here instead of in the old config file.
+const gajim1SettingsFile = "settings.sqlite"
+
+// In the Gajim data directory. The certificates the user has decided to trust.
+const gajim1CertificatesDir = "certs"
+
+// The name of the row in the settings table that has the proxies
+const gajim1ProxiesSettings = "proxies"
+
+// gajim1Importer reads the settings of Gajim 1.x, using the same places for the OTR
+// plugin as older versions of Gajim
+type gajim1Importer struct {
+	gajimImporter
+}
+
+type gajim1AccountSettings struct {
+	Account struct {
+		Name          string `json:"name"`
+		Hostname      string `json:"hostname"`
+		Password      string `json:"password"`
+		AccountLabel  string `json:"account_label"`
+		UseCustomHost bool   `json:"use_custom_host"`
+		CustomHost    string `json:"custom_host"`
+		CustomPort    int    `json:"custom_port"`
+		Proxy         string `json:"proxy"`
+	} `json:"account"`
+}
+
+type gajim1ProxySettings struct {
+	Type    string `json:"type"`
+	Host    string `json:"host"`
+	Port    int    `json:"port"`
+	UseAuth bool   `json:"useauth"`
+	User    string `json:"user"`
+	Pass    string `json:"pass"`
+}
+
+func (p gajim1ProxySettings) String() string {
+	user := p.User
+	if !p.UseAuth {
+		user = ""
+	}
+	port := ""
+	if p.Port != 0 {
+		port = strconv.Itoa(p.Port)
+	}
+	return composeProxyString(p.Type, user, p.Pass, p.Host, port)
+}
+
+func gajim1ProxiesIn(db *sqlite.Database) map[string]gajim1ProxySettings {
+	res := make(map[string]gajim1ProxySettings)
+	rows, err := db.Rows("settings")
+	if err != nil {
+		return res
+	}
+
+	for _, r := range rows {
+		if sqliteString(r["name"]) == gajim1ProxiesSettings {
+			_ = json.Unmarshal([]byte(sqliteString(r["settings"])), &res)
+		}
+	}
+	return res
+}
+
+// importAccountsFrom reads the accounts in the Gajim settings database, by the name Gajim gives them
+func (g *gajim1Importer) importAccountsFrom(f string) (map[string]*config.Account, bool) {
+	db, err := sqlite.Open(f)
+	if err != nil {
+		return nil, false
+	}
+
+	rows, err := db.Rows("account_settings")
+	if err != nil {
+		return nil, false
+	}
+
+	proxies := gajim1ProxiesIn(db)
+
+	res := make(map[string]*config.Account)
+	for _, r := range rows {
+		var s gajim1AccountSettings
+		if err := json.Unmarshal([]byte(sqliteString(r["settings"])), &s); err != nil {
+			continue
+		}
+
+		account := bareJIDOf(s.Account.Name + "@" + s.Account.Hostname)
+		if account == "" {
+			continue
+		}
+
+		ac := &config.Account{
+			Account:  account,
+			Nickname: s.Account.AccountLabel,
+			Password: s.Account.Password,
+			Port:     5222,
+			Proxies:  make([]string, 0),
+		}
+
+		if s.Account.UseCustomHost {
+			ac.Server = s.Account.CustomHost
+			if s.Account.CustomPort != 0 {
+				ac.Port = s.Account.CustomPort
+			}
+		}
+
+		if p, ok := proxies[s.Account.Proxy]; ok {
+			ac.Proxies = append(ac.Proxies, p.String())
+		}
+
+		res[sqliteString(r["account"])] = ac
+	}
+
+	return res, true
+}
+
+// importAllFrom reads the accounts, together with the keys and fingerprints of the
+// OTR plugin, which are named after the account
+func (g *gajim1Importer) importAllFrom(settingsFile, certsDir string, keyFiles []string, fprFiles []string) (*config.ApplicationConfig, bool) {
+	accounts, ok := g.importAccountsFrom(settingsFile)
+	if !ok || len(accounts) == 0 {
+		return nil, false
+	}
+
+	fprs := make(map[string][]*config.KnownFingerprint)
+	for _, kk := range fprFiles {
+		if nm, res, ok := g.importFingerprintsFrom(kk); ok {
+			fprs[nm] = res
+		}
+	}
+
+	keys := make(map[string][]byte)
+	for _, kk := range keyFiles {
+		if nm, res, ok := g.importKeyFrom(kk); ok {
+			keys[nm] = res
+		}
+	}
+
+	certs := certificatesIn(certsDir)
+
+	res := &config.ApplicationConfig{}
+	for name, ac := range accounts {
+		if key, ok := keys[name]; ok {
+			ac.PrivateKeys = [][]byte{key}
+		}
+		addFingerprintsTo(ac, fprs[name])
+		pinCertificatesFor(ac, certs)
+		res.Add(ac)
+	}
+
+	sort.Sort(config.ByAccountNameAlphabetic(res.Accounts))
+
+	return res, true
+}
+
+func (g *gajim1Importer) findFiles() (settingsFile, certsDir string, keyFiles []string, fingerprintFiles []string) {
+	configRoot, dataRoot := gajimGetConfigAndDataDirs()
+
+	settingsFile = filepath.Join(configRoot, gajim1SettingsFile)
+	certsDir = filepath.Join(dataRoot, gajim1CertificatesDir)
+
+	fingerprintFiles = getFilesMatching(dataRoot, gajimOtrDataFingerprintsExtension)
+	keyFiles = getFilesMatching(dataRoot, gajimOtrDataKeyExtension)
+
+	return
+}
+
+func (g *gajim1Importer) TryImport() []*config.ApplicationConfig {
+	var res []*config.ApplicationConfig
+
+	ac, ok := g.importAllFrom(g.findFiles())
+	if ok {
+		res = append(res, ac)
+	}
+
+	return res
+}
@@ -0,0 +1,98 @@
+package importer
+
+import (
+	"path/filepath"
+
+	"github.com/chadsec1/decoyim/config"
+	. "gopkg.in/check.v1"
+)
+
+type Gajim1Suite struct{}
+
+var _ = Suite(&Gajim1Suite{})
+
+func (s *Gajim1Suite) Test_Gajim1Importer_canImportAccountsFromSettings(c *C) {
+	importer := gajim1Importer{}
+
+	res, ok := importer.importAccountsFrom(testResourceFilename("gajim1_test_data/settings.sqlite"))
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res, HasLen, 2)
+
+	alice := res["alice@example.com"]
+	c.Assert(alice.Account, Equals, "alice@example.com")
+	c.Assert(alice.Nickname, Equals, "Alice at work")
+	c.Assert(alice.Password, Equals, "alices secret")
+	c.Assert(alice.Server, Equals, "xmpp.example.com")
+	c.Assert(alice.Port, Equals, 5223)
+	c.Assert(alice.Proxies, DeepEquals, []string{"http://alice:pw@proxy.example.com:3128"})
+
+	bob := res["bob"]
+	c.Assert(bob.Account, Equals, "bob@other.org")
+	c.Assert(bob.Server, Equals, "")
+	c.Assert(bob.Port, Equals, 5222)
+	c.Assert(bob.Proxies, DeepEquals, []string{"socks5://localhost:9050"})
+}
+
+func (s *Gajim1Suite) Test_Gajim1Importer_canDoAFullImport(c *C) {
+	importer := gajim1Importer{}
+	dir := testResourceFilename("gajim1_test_data")
+
+	res, ok := importer.importAllFrom(
+		filepath.Join(dir, "settings.sqlite"),
+		filepath.Join(dir, "certs"),
+		getFilesMatching(dir, gajimOtrDataKeyExtension),
+		getFilesMatching(dir, gajimOtrDataFingerprintsExtension),
+	)
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res.Accounts, HasLen, 2)
+
+	alice := res.Accounts[0]
+	c.Assert(alice.Account, Equals, "alice@example.com")
+	c.Assert(alice.PrivateKeys, HasLen, 1)
+	c.Assert(alice.Peers, HasLen, 5)
+
+	p, ok := alice.GetPeer("bla@rose.com")
+	c.Assert(ok, Equals, true)
+	c.Assert(p.Fingerprints[0].Trusted, Equals, true)
+	p, _ = alice.GetPeer("not@coyim.com")
+	c.Assert(p.Fingerprints, HasLen, 2)
+	c.Assert(p.Fingerprints[0].Trusted, Equals, false)
+
+	c.Assert(alice.Certificates, HasLen, 1)
+	c.Assert(alice.Certificates[0].Subject, Equals, "example.com")
+	c.Assert(alice.Certificates[0].FingerprintType, Equals, "SHA3-256")
+
+	bob := res.Accounts[1]
+	c.Assert(bob.Account, Equals, "bob@other.org")
+	c.Assert(bob.PrivateKeys, HasLen, 0)
+	c.Assert(bob.Peers, HasLen, 0)
+	c.Assert(bob.Certificates, HasLen, 1)
+	c.Assert(bob.Certificates[0].Subject, Equals, "other.org")
+}
+
+func (s *Gajim1Suite) Test_Gajim1Importer_failsWithoutSettings(c *C) {
+	importer := gajim1Importer{}
+
+	_, ok := importer.importAllFrom(testResourceFilename("gajim1_test_data/nonexistent.sqlite"), "", nil, nil)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *Gajim1Suite) Test_Gajim1Importer_failsOnTheSettingsOfOlderVersions(c *C) {
+	importer := gajim1Importer{}
+
+	_, ok := importer.importAllFrom(testResourceFilename("gajim_test_data/logs.db"), "", nil, nil)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *Gajim1Suite) Test_pinCertificatesFor_onlyPinsCertificatesForTheAccount(c *C) {
+	certs := certificatesIn(testResourceFilename("gajim1_test_data/certs"))
+	c.Assert(certs, HasLen, 2)
+
+	ac := &config.Account{Account: "someone@example.net", Server: "other.org"}
+	pinCertificatesFor(ac, certs)
+
+	c.Assert(ac.Certificates, HasLen, 1)
+	c.Assert(ac.Certificates[0].Subject, Equals, "other.org")
+}
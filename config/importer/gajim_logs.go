@@ -0,0 +1,165 @@
+package importer
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/chadsec1/decoyim/config/importer/sqlite"
+	"github.com/chadsec1/decoyim/session/history"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+)
+
+// In the Gajim data directory
+const gajimLogsFile = "logs.db"
+
+// The kinds of lines in the logs table
+const (
+	gajimKindRoomMessage       = 2
+	gajimKindSingleMessageRecv = 3
+	gajimKindChatMessageRecv   = 4
+	gajimKindSingleMessageSent = 5
+	gajimKindChatMessageSent   = 6
+)
+
+// The types of addresses in the jids table
+const gajimJIDTypeRoom = 1
+
+type gajimJID struct {
+	jid  string
+	room bool
+}
+
+func sqliteInt(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case float64:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+func sqliteTime(v interface{}) (time.Time, bool) {
+	switch n := v.(type) {
+	case int64:
+		return time.Unix(n, 0), true
+	case float64:
+		sec := int64(n)
+		return time.Unix(sec, int64((n-float64(sec))*float64(time.Second))), true
+	}
+	return time.Time{}, false
+}
+
+func sqliteString(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	}
+	return ""
+}
+
+func gajimJIDsIn(db *sqlite.Database) (map[int64]gajimJID, bool) {
+	rows, err := db.Rows("jids")
+	if err != nil {
+		return nil, false
+	}
+
+	res := make(map[int64]gajimJID)
+	for _, r := range rows {
+		id, ok := sqliteInt(r["jid_id"])
+		if !ok {
+			continue
+		}
+		tp, _ := sqliteInt(r["type"])
+		res[id] = gajimJID{sqliteString(r["jid"]), tp == gajimJIDTypeRoom}
+	}
+	return res, true
+}
+
+// gajimAccountsIn returns the accounts the logs belong to, for newer versions of Gajim that record them
+func gajimAccountsIn(db *sqlite.Database) map[int64]string {
+	res := make(map[int64]string)
+	rows, err := db.Rows("accounts")
+	if err != nil {
+		return res
+	}
+
+	for _, r := range rows {
+		if id, ok := sqliteInt(r["account_id"]); ok {
+			res[id] = bareJIDOf(sqliteString(r["jid"]))
+		}
+	}
+	return res
+}
+
+// importLogsFrom reads the Gajim logs database. Versions of Gajim before 1.0 don't record which
+// account the messages were sent or received on, so their conversations don't have an account.
+// Private messages with occupants of rooms are not imported, since they can't be told apart from
+// messages in the room itself.
+func (g *gajimImporter) importLogsFrom(f string) (Logs, bool) {
+	db, err := sqlite.Open(f)
+	if err != nil {
+		return nil, false
+	}
+
+	jids, ok := gajimJIDsIn(db)
+	if !ok {
+		return nil, false
+	}
+	accounts := gajimAccountsIn(db)
+
+	rows, err := db.Rows("logs")
+	if err != nil {
+		return nil, false
+	}
+
+	res := make(Logs)
+	for _, r := range rows {
+		jidID, _ := sqliteInt(r["jid_id"])
+		peer, known := jids[jidID]
+		if !known {
+			continue
+		}
+
+		bare, isBare := jid.Parse(peer.jid).(jid.Bare)
+		t, hasTime := sqliteTime(r["time"])
+		body := sqliteString(r["message"])
+		if !isBare || !hasTime || body == "" {
+			continue
+		}
+
+		accountID, _ := sqliteInt(r["account_id"])
+		c := history.Conversation{Account: accounts[accountID], Peer: bare.String(), Room: peer.room}
+		m := history.Message{Time: t, Body: body}
+
+		kind, _ := sqliteInt(r["kind"])
+		switch kind {
+		case gajimKindRoomMessage:
+			if !c.Room {
+				continue
+			}
+			m.From = sqliteString(r["contact_name"])
+		case gajimKindChatMessageRecv, gajimKindSingleMessageRecv:
+			m.From = c.Peer
+		case gajimKindChatMessageSent, gajimKindSingleMessageSent:
+			m.From = c.Account
+			m.Outgoing = true
+		default:
+			// Status changes and errors
+			continue
+		}
+
+		res.add(c, m)
+	}
+
+	return res, true
+}
+
+// TryImportLogs reads the message logs of Gajim
+func (g *gajimImporter) TryImportLogs() Logs {
+	_, dataRoot := gajimGetConfigAndDataDirs()
+	res, _ := g.importLogsFrom(filepath.Join(dataRoot, gajimLogsFile))
+	return res
+}
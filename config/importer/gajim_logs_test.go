@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/chadsec1/decoyim/session/history"
+	. "gopkg.in/check.v1"
+)
+
+type GajimLogsSuite struct{}
+
+var _ = Suite(&GajimLogsSuite{})
+
+func (s *GajimLogsSuite) Test_gajimImporter_importLogsFrom_readsMessagesWithoutAccounts(c *C) {
+	res, ok := (&gajimImporter{}).importLogsFrom(testResourceFilename("gajim_test_data/logs.db"))
+	c.Assert(ok, Equals, true)
+	c.Assert(res, HasLen, 2)
+
+	c.Assert(res[history.Conversation{Peer: "bob@example.com"}], DeepEquals, []history.Message{
+		{Time: time.Unix(1457089265, 0), From: "bob@example.com", Body: "hi alice"},
+		{Time: time.Unix(1457089270, 0), Outgoing: true, Body: "hello bob"},
+	})
+
+	c.Assert(res[history.Conversation{Peer: "room@conference.example.com", Room: true}], DeepEquals, []history.Message{
+		{Time: time.Unix(1457092810, 0), From: "carol", Body: "hello room"},
+	})
+}
+
+func (s *GajimLogsSuite) Test_gajimImporter_importLogsFrom_readsTheAccountsOfNewerVersions(c *C) {
+	res, ok := (&gajimImporter{}).importLogsFrom(testResourceFilename("gajim_test_data/logs-1.x.db"))
+	c.Assert(ok, Equals, true)
+
+	c.Assert(res.Accounts(), DeepEquals, []string{"alice@example.com", "other@example.org"})
+	c.Assert(res[history.Conversation{Account: "alice@example.com", Peer: "bob@example.com"}], DeepEquals, []history.Message{
+		{Time: time.Unix(1457089265, 500000000), From: "bob@example.com", Body: "hi alice"},
+	})
+	c.Assert(res[history.Conversation{Account: "other@example.org", Peer: "bob@example.com"}], DeepEquals, []history.Message{
+		{Time: time.Unix(1457089270, 250000000), From: "other@example.org", Outgoing: true, Body: "from the other account"},
+	})
+}
+
+func (s *GajimLogsSuite) Test_gajimImporter_importLogsFrom_failsWithoutADatabase(c *C) {
+	_, ok := (&gajimImporter{}).importLogsFrom(testResourceFilename("gajim_test_data/config"))
+	c.Assert(ok, Equals, false)
+
+	_, ok = (&gajimImporter{}).importLogsFrom("/does/not/exist/logs.db")
+	c.Assert(ok, Equals, false)
+}
@@ -0,0 +1,100 @@
+package importer
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/chadsec1/decoyim/session/history"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+)
+
+// Logs are the messages found in the logs of another application, by conversation.
+// Some applications don't record which account a conversation happened on - those
+// conversations have an empty account.
+type Logs map[history.Conversation][]history.Message
+
+func (l Logs) add(c history.Conversation, ms ...history.Message) {
+	if len(ms) > 0 {
+		l[c] = append(l[c], ms...)
+	}
+}
+
+// Accounts returns the accounts that have logs, in alphabetical order. If some logs are not tied
+// to any account, the empty string is among the accounts.
+func (l Logs) Accounts() []string {
+	seen := make(map[string]bool)
+	var result []string
+	for c := range l {
+		if !seen[c.Account] {
+			seen[c.Account] = true
+			result = append(result, c.Account)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ForAccount returns the logs of the given account, together with the logs that are not tied to
+// any account. All of them are marked as belonging to the account, and outgoing messages
+// without a sender are marked as sent from it.
+func (l Logs) ForAccount(account string) Logs {
+	result := make(Logs)
+	for c, ms := range l {
+		if c.Account != account && c.Account != "" {
+			continue
+		}
+
+		nc := c
+		nc.Account = account
+		for _, m := range ms {
+			if m.Outgoing && m.From == "" {
+				m.From = account
+			}
+			result.add(nc, m)
+		}
+	}
+	return result
+}
+
+// Count returns the number of messages in the logs
+func (l Logs) Count() int {
+	n := 0
+	for _, ms := range l {
+		n += len(ms)
+	}
+	return n
+}
+
+// TryImportAllLogs will try to read the message logs of all known importers
+func TryImportAllLogs() map[string]Logs {
+	res := make(map[string]Logs)
+
+	res["Adium"] = (&adiumImporter{}).TryImportLogs()
+	res["Gajim"] = (&gajimImporter{}).TryImportLogs()
+	res["Pidgin"] = (&pidginImporter{}).TryImportLogs()
+
+	return res
+}
+
+var (
+	logLineBreaks = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>`)
+	logTags       = regexp.MustCompile(`<[^>]*>`)
+)
+
+// plainTextOf returns the text in a message formatted with HTML, as used by many applications in their logs
+func plainTextOf(s string) string {
+	s = logLineBreaks.ReplaceAllString(s, "\n")
+	s = logTags.ReplaceAllString(s, "")
+	return strings.TrimSpace(html.UnescapeString(s))
+}
+
+// bareJIDOf returns the bare JID in the given string, or an empty string if it doesn't have one
+func bareJIDOf(s string) string {
+	j, ok := jid.TryParseBare(strings.TrimSpace(s))
+	if !ok {
+		return ""
+	}
+	return j.String()
+}
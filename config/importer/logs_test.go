@@ -0,0 +1,45 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/chadsec1/decoyim/session/history"
+	. "gopkg.in/check.v1"
+)
+
+type LogsSuite struct{}
+
+var _ = Suite(&LogsSuite{})
+
+func (s *LogsSuite) Test_Logs_ForAccount_includesLogsWithoutAnAccount(c *C) {
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	l := Logs{
+		{Account: "alice@example.com", Peer: "bob@example.com"}:   {{Time: t1, From: "bob@example.com", Body: "one"}},
+		{Account: "other@example.org", Peer: "bob@example.com"}:   {{Time: t1, From: "bob@example.com", Body: "two"}},
+		{Peer: "carol@example.com"}:                               {{Time: t1, Outgoing: true, Body: "three"}},
+		{Account: "alice@example.com", Peer: "carol@example.com"}: {{Time: t1.Add(time.Minute), From: "carol@example.com", Body: "four"}},
+	}
+
+	c.Assert(l.Accounts(), DeepEquals, []string{"", "alice@example.com", "other@example.org"})
+	c.Assert(l.Count(), Equals, 4)
+
+	res := l.ForAccount("alice@example.com")
+	c.Assert(res, HasLen, 2)
+	c.Assert(res.Count(), Equals, 3)
+	c.Assert(res[history.Conversation{Account: "alice@example.com", Peer: "bob@example.com"}], HasLen, 1)
+
+	carol := res[history.Conversation{Account: "alice@example.com", Peer: "carol@example.com"}]
+	c.Assert(carol, HasLen, 2)
+	for _, m := range carol {
+		if m.Outgoing {
+			c.Assert(m.From, Equals, "alice@example.com")
+		}
+	}
+
+	// The original logs are left alone
+	c.Assert(l[history.Conversation{Peer: "carol@example.com"}][0].From, Equals, "")
+}
+
+func (s *LogsSuite) Test_plainTextOf_removesTheMarkup(c *C) {
+	c.Assert(plainTextOf(`<div><span style="color: red">a &lt;b&gt;</span><br/>c<BR>d</div>`), Equals, "a <b>\nc\nd")
+}
@@ -0,0 +1,252 @@
+package importer
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chadsec1/decoyim/session/history"
+)
+
+// In the Pidgin config directory. Under it, there is one directory for every account,
+// with one directory for every peer, and one file for every conversation.
+const pidginLogsDir = "logs/jabber"
+
+// The directories of rooms have this suffix
+const pidginRoomLogSuffix = ".chat"
+
+// The names of the log files start with the time the conversation started
+const pidginLogFileTimeFormat = "2006-01-02.150405-0700"
+
+// Pidgin uses these colors for the names of the sender in HTML logs
+const (
+	pidginSentColor     = "16569e"
+	pidginReceivedColor = "a82f2f"
+)
+
+var (
+	pidginHTMLMessage = regexp.MustCompile(`^<(?:font|span)[^>]*#([0-9A-Fa-f]{6})[^>]*>(?:<font size="2">)?\(([^)]*)\)(?:</font>)? <b>(.*?):</b></(?:font|span)> ?(.*?)(?:<br/?>)?$`)
+	pidginTextMessage = regexp.MustCompile(`^\(([^)]*)\) (.*?): (.*)$`)
+)
+
+// The time of messages is written using the locale of the user, and only includes the date
+// if it's not the same day the conversation started
+var (
+	pidginTimeFormats     = []string{"03:04:05 PM", "3:04:05 PM", "15:04:05"}
+	pidginDateTimeFormats = []string{"01/02/2006 03:04:05 PM", "1/2/2006 3:04:05 PM", "01/02/2006 15:04:05", "2006-01-02 15:04:05", "02.01.2006 15:04:05", "02/01/2006 15:04:05"}
+)
+
+// pidginLogParser keeps track of the time of the last message, since most messages only have the time of day
+type pidginLogParser struct {
+	account string
+	peer    string
+	room    bool
+	last    time.Time
+}
+
+func (p *pidginLogParser) timeOf(s string) (time.Time, bool) {
+	loc := p.last.Location()
+	for _, f := range pidginDateTimeFormats {
+		if t, err := time.ParseInLocation(f, s, loc); err == nil {
+			p.last = t
+			return t, true
+		}
+	}
+
+	for _, f := range pidginTimeFormats {
+		if t, err := time.ParseInLocation(f, s, loc); err == nil {
+			y, m, d := p.last.Date()
+			t = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, loc)
+			if t.Before(p.last.Add(-time.Minute)) {
+				// The conversation went on past midnight
+				t = t.AddDate(0, 0, 1)
+			}
+			p.last = t
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// isOwn guesses if a message in a text log was sent by us. The logs only have the alias of the
+// sender, so messages are considered as sent by us when the alias is our address or the local
+// part of it, and as received otherwise.
+func (p *pidginLogParser) isOwn(sender string) bool {
+	if p.room {
+		return false
+	}
+
+	if b := bareJIDOf(sender); b != "" {
+		return b == p.account
+	}
+	return sender == strings.SplitN(p.account, "@", 2)[0]
+}
+
+func (p *pidginLogParser) message(t time.Time, sender, body string, outgoing bool) history.Message {
+	from := p.peer
+	switch {
+	case p.room:
+		from = sender
+	case outgoing:
+		from = p.account
+	}
+
+	return history.Message{Time: t, From: from, Outgoing: outgoing, Body: body}
+}
+
+func (p *pidginLogParser) parseHTML(lines []string) []history.Message {
+	var result []history.Message
+	for _, l := range lines {
+		parts := pidginHTMLMessage.FindStringSubmatch(l)
+		if parts == nil {
+			continue
+		}
+
+		color := strings.ToLower(parts[1])
+		if color != pidginSentColor && color != pidginReceivedColor {
+			// Status changes and other notices
+			continue
+		}
+
+		t, ok := p.timeOf(parts[2])
+		body := plainTextOf(parts[4])
+		if !ok || body == "" {
+			continue
+		}
+
+		result = append(result, p.message(t, plainTextOf(parts[3]), body, color == pidginSentColor))
+	}
+	return result
+}
+
+func (p *pidginLogParser) parseText(lines []string) []history.Message {
+	var result []history.Message
+	for _, l := range lines {
+		parts := pidginTextMessage.FindStringSubmatch(l)
+		if parts != nil {
+			if t, ok := p.timeOf(parts[1]); ok {
+				result = append(result, p.message(t, parts[2], parts[3], p.isOwn(parts[2])))
+				continue
+			}
+		}
+
+		if strings.HasPrefix(l, "(") || len(result) == 0 {
+			// Status changes and other notices, or the header of the file
+			continue
+		}
+
+		// A message spanning several lines
+		last := &result[len(result)-1]
+		last.Body = last.Body + "\n" + l
+	}
+	return result
+}
+
+func readLines(f string) ([]string, bool) {
+	file, err := os.Open(filepath.Clean(f))
+	if err != nil {
+		return nil, false
+	}
+	defer closeAndIgnore(file)
+
+	var result []string
+	sc := bufio.NewScanner(file)
+	sc.Buffer(nil, 1024*1024)
+	for sc.Scan() {
+		result = append(result, sc.Text())
+	}
+	return result, sc.Err() == nil
+}
+
+// importLogFilePidginStyle reads the messages in one Pidgin log file
+func importLogFilePidginStyle(f, account, peer string, room bool) ([]history.Message, bool) {
+	name := filepath.Base(f)
+	if len(name) < len(pidginLogFileTimeFormat) {
+		return nil, false
+	}
+
+	start, err := time.Parse(pidginLogFileTimeFormat, name[:len(pidginLogFileTimeFormat)])
+	if err != nil {
+		return nil, false
+	}
+
+	lines, ok := readLines(f)
+	if !ok {
+		return nil, false
+	}
+
+	p := &pidginLogParser{account: account, peer: peer, room: room, last: start}
+	switch filepath.Ext(name) {
+	case ".html", ".htm":
+		return p.parseHTML(lines), true
+	case ".txt":
+		return p.parseText(lines), true
+	}
+	return nil, false
+}
+
+func unescapePidginName(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+	return s
+}
+
+func subdirectoriesOf(dir string) []string {
+	var result []string
+	entries, err := ioutil.ReadDir(dir)
+	if err == nil {
+		for _, e := range entries {
+			if e.IsDir() {
+				result = append(result, e.Name())
+			}
+		}
+	}
+	return result
+}
+
+// importLogsPidginStyle reads all logs in a Pidgin logs directory
+func importLogsPidginStyle(dir string) Logs {
+	res := make(Logs)
+	for _, accountDir := range subdirectoriesOf(dir) {
+		account := bareJIDOf(unescapePidginName(accountDir))
+		if account == "" {
+			continue
+		}
+
+		for _, peerDir := range subdirectoriesOf(filepath.Join(dir, accountDir)) {
+			name := unescapePidginName(peerDir)
+			room := strings.HasSuffix(name, pidginRoomLogSuffix)
+			peer := bareJIDOf(strings.TrimSuffix(name, pidginRoomLogSuffix))
+			if peer == "" {
+				continue
+			}
+
+			c := history.Conversation{Account: account, Peer: peer, Room: room}
+			files, _ := ioutil.ReadDir(filepath.Join(dir, accountDir, peerDir))
+			for _, f := range files {
+				if f.IsDir() {
+					continue
+				}
+				ms, _ := importLogFilePidginStyle(filepath.Join(dir, accountDir, peerDir, f.Name()), account, peer, room)
+				res.add(c, ms...)
+			}
+		}
+	}
+	return res
+}
+
+// TryImportLogs reads the message logs of Pidgin
+func (p *pidginImporter) TryImportLogs() Logs {
+	dd, ok := p.findDir()
+	if !ok {
+		return nil
+	}
+	return importLogsPidginStyle(filepath.Join(dd, pidginLogsDir))
+}
@@ -0,0 +1,54 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/chadsec1/decoyim/session/history"
+	. "gopkg.in/check.v1"
+)
+
+type PidginLogsSuite struct{}
+
+var _ = Suite(&PidginLogsSuite{})
+
+func (s *PidginLogsSuite) Test_importLogsPidginStyle_readsTextAndHTMLLogs(c *C) {
+	cet := time.FixedZone("", 3600)
+	res := importLogsPidginStyle(testResourceFilename("pidgin_test_data/logs/jabber"))
+
+	c.Assert(res.Accounts(), DeepEquals, []string{"alice@example.com"})
+	c.Assert(res, HasLen, 2)
+
+	ms := res[history.Conversation{Account: "alice@example.com", Peer: "bob@example.com"}]
+	c.Assert(ms, HasLen, 7)
+
+	c.Assert(ms[0], DeepEquals, history.Message{Time: time.Date(2016, 3, 4, 12, 1, 5, 0, cet), From: "bob@example.com", Body: "hi alice"})
+	c.Assert(ms[1], DeepEquals, history.Message{Time: time.Date(2016, 3, 4, 12, 1, 10, 0, cet), From: "alice@example.com", Outgoing: true, Body: "hello bob\nthis is a second line"})
+	c.Assert(ms[2].Time, DeepEquals, time.Date(2016, 3, 4, 23, 59, 59, 0, cet))
+	c.Assert(ms[3].Time, DeepEquals, time.Date(2016, 3, 5, 0, 0, 30, 0, cet))
+	c.Assert(ms[4].Time, DeepEquals, time.Date(2016, 3, 6, 9, 0, 0, 0, cet))
+	c.Assert(ms[4].Outgoing, Equals, true)
+
+	c.Assert(ms[5], DeepEquals, history.Message{Time: time.Date(2016, 3, 7, 10, 0, 5, 0, cet), From: "bob@example.com", Body: "bold & beautiful"})
+	c.Assert(ms[6], DeepEquals, history.Message{Time: time.Date(2016, 3, 7, 10, 0, 9, 0, cet), From: "alice@example.com", Outgoing: true, Body: "first line\nsecond line"})
+}
+
+func (s *PidginLogsSuite) Test_importLogsPidginStyle_readsRoomLogs(c *C) {
+	cet := time.FixedZone("", 3600)
+	res := importLogsPidginStyle(testResourceFilename("pidgin_test_data/logs/jabber"))
+
+	ms := res[history.Conversation{Account: "alice@example.com", Peer: "room@conference.example.com", Room: true}]
+	c.Assert(ms, DeepEquals, []history.Message{
+		{Time: time.Date(2016, 3, 4, 13, 0, 10, 0, cet), From: "carol", Body: "hello room"},
+		{Time: time.Date(2016, 3, 4, 13, 0, 20, 0, cet), From: "alice", Outgoing: true, Body: "hi carol"},
+	})
+}
+
+func (s *PidginLogsSuite) Test_importLogsPidginStyle_returnsNothingWithoutLogs(c *C) {
+	c.Assert(importLogsPidginStyle(c.MkDir()), HasLen, 0)
+	c.Assert(importLogsPidginStyle("/does/not/exist"), HasLen, 0)
+}
+
+func (s *PidginLogsSuite) Test_importLogFilePidginStyle_failsForFilesNotNamedAfterTheTime(c *C) {
+	_, ok := importLogFilePidginStyle(testResourceFilename("pidgin_test_data/accounts.xml"), "alice@example.com", "bob@example.com", false)
+	c.Assert(ok, Equals, false)
+}
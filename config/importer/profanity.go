@@ -0,0 +1,233 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+)
+
+// In the XDG config and data directories
+const profanityDir = "profanity"
+
+// In the Profanity config directory
+const profanityPrefsFile = "profrc"
+
+// In the Profanity data directory
+const (
+	profanityAccountsFile = "accounts"
+	profanityTLSCertsFile = "tlscerts"
+	profanityOtrDir       = "otr"
+)
+
+// In the OTR directory of every account
+const (
+	profanityOtrKeysFile         = "keys.txt"
+	profanityOtrFingerprintsFile = "fingerprints.txt"
+)
+
+// The OTR policies of Profanity
+const (
+	profanityOTRManual        = "manual"
+	profanityOTROpportunistic = "opportunistic"
+	profanityOTRAlways        = "always"
+)
+
+type profanityImporter struct{}
+
+// keyFile is a file in the format of GLib key files, with groups of keys and values
+type keyFile map[string]map[string]string
+
+func unescapeKeyFileValue(s string) string {
+	return strings.NewReplacer(`\s`, " ", `\n`, "\n", `\t`, "\t", `\r`, "\r", `\\`, `\`).Replace(s)
+}
+
+func parseKeyFile(f string) (keyFile, bool) {
+	file, err := os.Open(filepath.Clean(f))
+	if err != nil {
+		return nil, false
+	}
+	defer closeAndIgnore(file)
+
+	res := make(keyFile)
+	var group map[string]string
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		ln := strings.TrimSpace(sc.Text())
+		switch {
+		case ln == "" || strings.HasPrefix(ln, "#"):
+		case strings.HasPrefix(ln, "[") && strings.HasSuffix(ln, "]"):
+			group = make(map[string]string)
+			res[ln[1:len(ln)-1]] = group
+		case group != nil && strings.Contains(ln, "="):
+			kv := strings.SplitN(ln, "=", 2)
+			group[strings.TrimSpace(kv[0])] = unescapeKeyFileValue(strings.TrimSpace(kv[1]))
+		}
+	}
+
+	return res, sc.Err() == nil
+}
+
+// listIn returns the values of a list, which are separated by semicolons
+func (k keyFile) listIn(group, key string) []string {
+	var res []string
+	for _, v := range strings.Split(k[group][key], ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func (p *profanityImporter) protocolMatches(s string) bool {
+	return s == "xmpp"
+}
+
+// otrDirFor returns the directory Profanity keeps the OTR keys and fingerprints of the account in
+func (p *profanityImporter) otrDirFor(dataDir, account string) string {
+	return filepath.Join(dataDir, profanityOtrDir, strings.Replace(account, "@", "_at_", -1))
+}
+
+func applyProfanityOTRPolicy(ac *config.Account, policy string) {
+	ac.OTRAutoTearDown = true
+	switch policy {
+	case profanityOTRAlways:
+		ac.AlwaysEncrypt = true
+		ac.OTRAutoStartSession = true
+	case profanityOTROpportunistic:
+		ac.OTRAutoStartSession = true
+		ac.OTRAutoAppendTag = true
+	}
+}
+
+// certificatePinsIn reads the certificates the user has decided to trust. Profanity only
+// remembers the fingerprint and the names in the certificate, not the certificate itself.
+func (p *profanityImporter) certificatePinsIn(certs keyFile) map[string][]*config.CertificatePin {
+	res := make(map[string][]*config.CertificatePin)
+	for fingerprint, values := range certs {
+		fpr, err := hex.DecodeString(strings.Replace(fingerprint, ":", "", -1))
+		if err != nil {
+			continue
+		}
+
+		pin := &config.CertificatePin{
+			Subject:     commonNameIn(values["subjectname"]),
+			Issuer:      commonNameIn(values["issuername"]),
+			Fingerprint: fpr,
+		}
+
+		switch len(fpr) {
+		case 20:
+			pin.FingerprintType = "SHA1"
+		case 32:
+			pin.FingerprintType = "SHA256"
+		default:
+			continue
+		}
+
+		domain := strings.ToLower(values["domain"])
+		res[domain] = append(res[domain], pin)
+	}
+	return res
+}
+
+// commonNameIn returns the common name in a distinguished name, or the whole name if it doesn't have one
+func commonNameIn(dn string) string {
+	for _, part := range strings.FieldsFunc(dn, func(r rune) bool { return r == ',' || r == '/' }) {
+		if kv := strings.SplitN(strings.TrimSpace(part), "=", 2); len(kv) == 2 && kv[0] == "CN" {
+			return kv[1]
+		}
+	}
+	return dn
+}
+
+func (p *profanityImporter) importAllFrom(prefsFile, dataDir string) (*config.ApplicationConfig, bool) {
+	accounts, ok := parseKeyFile(filepath.Join(dataDir, profanityAccountsFile))
+	if !ok {
+		return nil, false
+	}
+
+	globalPolicy := profanityOTRManual
+	if prefs, ok := parseKeyFile(prefsFile); ok && prefs["otr"]["policy"] != "" {
+		globalPolicy = prefs["otr"]["policy"]
+	}
+
+	certs, _ := parseKeyFile(filepath.Join(dataDir, profanityTLSCertsFile))
+	pins := p.certificatePinsIn(certs)
+
+	res := &config.ApplicationConfig{}
+	for name, values := range accounts {
+		account := bareJIDOf(values["jid"])
+		if account == "" {
+			account = bareJIDOf(name)
+		}
+		if account == "" {
+			continue
+		}
+
+		ac := &config.Account{
+			Account:  account,
+			Password: values["password"],
+			Server:   values["server"],
+			Port:     parseIntOr(values["port"], 5222),
+			Proxies:  make([]string, 0),
+		}
+
+		ac.ConnectTLS = values["tls.policy"] == "legacy" || values["tls.policy"] == "direct"
+
+		policy := values["otr.policy"]
+		if policy == "" {
+			policy = globalPolicy
+		}
+		applyProfanityOTRPolicy(ac, policy)
+
+		ac.AlwaysEncryptWith = accounts.listIn(name, "otr.always")
+		ac.DontEncryptWith = accounts.listIn(name, "otr.manual")
+		sort.Sort(byAlpha(ac.AlwaysEncryptWith))
+		sort.Sort(byAlpha(ac.DontEncryptWith))
+
+		otrDir := p.otrDirFor(dataDir, account)
+		if keys, ok := ImportKeysFromPidginStyle(filepath.Join(otrDir, profanityOtrKeysFile), p.protocolMatches); ok {
+			if key, ok := keys[account]; ok {
+				ac.PrivateKeys = [][]byte{key}
+			}
+		}
+		if fprs, ok := ImportFingerprintsFromPidginStyle(filepath.Join(otrDir, profanityOtrFingerprintsFile), p.protocolMatches); ok {
+			addFingerprintsTo(ac, fprs[account])
+		}
+
+		domain := jid.Parse(account).Host().String()
+		ac.Certificates = append(ac.Certificates, pins[domain]...)
+		if server := strings.ToLower(ac.Server); server != "" && server != domain {
+			ac.Certificates = append(ac.Certificates, pins[server]...)
+		}
+		sort.Sort(config.CertificatePinsByNaturalOrder(ac.Certificates))
+
+		res.Add(ac)
+	}
+
+	if len(res.Accounts) == 0 {
+		return nil, false
+	}
+
+	sort.Sort(config.ByAccountNameAlphabetic(res.Accounts))
+
+	return res, true
+}
+
+func (p *profanityImporter) TryImport() []*config.ApplicationConfig {
+	var res []*config.ApplicationConfig
+
+	prefsFile := filepath.Join(config.XdgConfigHome(), profanityDir, profanityPrefsFile)
+	ac, ok := p.importAllFrom(prefsFile, filepath.Join(config.XdgDataHome(), profanityDir))
+	if ok {
+		res = append(res, ac)
+	}
+
+	return res
+}
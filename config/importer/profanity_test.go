@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type ProfanitySuite struct{}
+
+var _ = Suite(&ProfanitySuite{})
+
+func (s *ProfanitySuite) Test_parseKeyFile_readsGroupsAndValues(c *C) {
+	res, ok := parseKeyFile(testResourceFilename("profanity_test_data/accounts"))
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res, HasLen, 2)
+	c.Assert(res["alice@example.com"]["password"], Equals, "alice secret")
+	c.Assert(res["bob@other.org"]["enabled"], Equals, "false")
+	c.Assert(res.listIn("alice@example.com", "otr.always"), DeepEquals, []string{"dave@example.com", "bob@other.org"})
+	c.Assert(res.listIn("bob@other.org", "otr.always"), IsNil)
+}
+
+func (s *ProfanitySuite) Test_parseKeyFile_failsOnMissingFile(c *C) {
+	_, ok := parseKeyFile(testResourceFilename("profanity_test_data/nonexistent"))
+	c.Assert(ok, Equals, false)
+}
+
+func (s *ProfanitySuite) Test_commonNameIn(c *C) {
+	c.Assert(commonNameIn("/C=US/CN=example.com/O=Example"), Equals, "example.com")
+	c.Assert(commonNameIn("C=US, CN=example.com"), Equals, "example.com")
+	c.Assert(commonNameIn("example.com"), Equals, "example.com")
+}
+
+func (s *ProfanitySuite) Test_ProfanityImporter_canDoAFullImport(c *C) {
+	importer := profanityImporter{}
+	dir := testResourceFilename("profanity_test_data")
+
+	res, ok := importer.importAllFrom(filepath.Join(dir, "profrc"), dir)
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res.Accounts, HasLen, 2)
+
+	alice := res.Accounts[0]
+	c.Assert(alice.Account, Equals, "alice@example.com")
+	c.Assert(alice.Password, Equals, "alice secret")
+	c.Assert(alice.Server, Equals, "xmpp.example.com")
+	c.Assert(alice.Port, Equals, 5223)
+	c.Assert(alice.ConnectTLS, Equals, true)
+	c.Assert(alice.AlwaysEncrypt, Equals, true)
+	c.Assert(alice.OTRAutoStartSession, Equals, true)
+	c.Assert(alice.OTRAutoAppendTag, Equals, false)
+	c.Assert(alice.AlwaysEncryptWith, DeepEquals, []string{"bob@other.org", "dave@example.com"})
+	c.Assert(alice.DontEncryptWith, DeepEquals, []string{"carol@example.com"})
+	c.Assert(alice.PrivateKeys, HasLen, 1)
+	c.Assert(alice.Peers, HasLen, 2)
+
+	p, ok := alice.GetPeer("bob@other.org")
+	c.Assert(ok, Equals, true)
+	c.Assert(p.Fingerprints[0].Fingerprint, DeepEquals, decode("27cc5b34c0a5dca7b0b2b3657b5da0fcb1845253"))
+	c.Assert(p.Fingerprints[0].Trusted, Equals, true)
+	p, _ = alice.GetPeer("carol@example.com")
+	c.Assert(p.Fingerprints[0].Trusted, Equals, false)
+
+	c.Assert(alice.Certificates, HasLen, 1)
+	c.Assert(alice.Certificates[0].Subject, Equals, "example.com")
+	c.Assert(alice.Certificates[0].FingerprintType, Equals, "SHA1")
+	c.Assert(alice.Certificates[0].Fingerprint, DeepEquals, decode("e3c7780f35629a4a8fb842210615ffd6801df71e"))
+
+	bob := res.Accounts[1]
+	c.Assert(bob.Account, Equals, "bob@other.org")
+	c.Assert(bob.Port, Equals, 5222)
+	c.Assert(bob.ConnectTLS, Equals, false)
+	c.Assert(bob.AlwaysEncrypt, Equals, false)
+	c.Assert(bob.OTRAutoStartSession, Equals, true)
+	c.Assert(bob.OTRAutoAppendTag, Equals, true)
+	c.Assert(bob.PrivateKeys, HasLen, 0)
+	c.Assert(bob.Certificates, HasLen, 0)
+}
+
+func (s *ProfanitySuite) Test_ProfanityImporter_usesManualPolicyWithoutPreferences(c *C) {
+	importer := profanityImporter{}
+	dir := testResourceFilename("profanity_test_data")
+
+	res, ok := importer.importAllFrom(filepath.Join(dir, "nonexistent"), dir)
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res.Accounts[1].OTRAutoStartSession, Equals, false)
+	c.Assert(res.Accounts[1].OTRAutoAppendTag, Equals, false)
+}
+
+func (s *ProfanitySuite) Test_ProfanityImporter_failsWithoutAccounts(c *C) {
+	importer := profanityImporter{}
+
+	_, ok := importer.importAllFrom("", testResourceFilename("dino_test_data"))
+	c.Assert(ok, Equals, false)
+}
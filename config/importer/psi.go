@@ -0,0 +1,212 @@
+package importer
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/chadsec1/decoyim/config"
+)
+
+// In the system config and data directories. Psi+ and Psi keep their files in the same way.
+var psiDirs = []string{"psi+", "psi"}
+
+// In the Psi directories. There is one directory for every profile in it,
+// both in the config and in the data directory.
+const psiProfilesDir = "profiles"
+
+// In the profile directory in the config directory
+const psiAccountsFile = "accounts.xml"
+
+// In the profile directory in the data directory, written by the OTR plugin
+const (
+	psiOtrDataKeyFile          = "otr.keys"
+	psiOtrDataFingerprintsFile = "otr.fingerprints"
+)
+
+// In the Psi data directory. The certificates the user has decided to trust.
+const psiCertificatesDir = "certs"
+
+type psiImporter struct{}
+
+type psiOptionsXML struct {
+	Accounts psiAccountListXML `xml:"accounts"`
+	Proxies  psiProxyListXML   `xml:"proxies"`
+}
+
+// Psi names the accounts and proxies a0, a1 and so on
+type psiAccountListXML struct {
+	Accounts []psiAccountXML `xml:",any"`
+}
+
+type psiProxyListXML struct {
+	Proxies []psiProxyXML `xml:",any"`
+}
+
+type psiAccountXML struct {
+	XMLName     xml.Name
+	ID          string `xml:"id"`
+	JID         string `xml:"jid"`
+	Name        string `xml:"name"`
+	Password    string `xml:"password"`
+	UseHostPort bool   `xml:"use-host-port"`
+	Host        string `xml:"host"`
+	Port        string `xml:"port"`
+	ProxyID     string `xml:"proxy-id"`
+	SSL         string `xml:"ssl"`
+}
+
+type psiProxyXML struct {
+	XMLName xml.Name
+	ID      string `xml:"id"`
+	Type    string `xml:"type"`
+	Host    string `xml:"host"`
+	Port    string `xml:"port"`
+	UseAuth bool   `xml:"useAuth"`
+	User    string `xml:"user"`
+	Pass    string `xml:"pass"`
+}
+
+func (p *psiProxyXML) is(id string) bool {
+	return id != "" && (p.ID == id || p.XMLName.Local == id)
+}
+
+func (p *psiProxyXML) String() string {
+	tp := p.Type
+	if tp == "socks" {
+		tp = "socks5"
+	}
+
+	user := p.User
+	if !p.UseAuth {
+		user = ""
+	}
+
+	return composeProxyString(tp, user, p.Pass, p.Host, p.Port)
+}
+
+// decodePsiPassword reverses the obfuscation Psi uses for passwords: every character
+// is written as four hexadecimal digits, after XOR with the characters of the JID
+func decodePsiPassword(pass, key string) string {
+	k := utf16.Encode([]rune(key))
+	if len(k) == 0 {
+		return pass
+	}
+
+	var res []uint16
+	for i := 0; i+4 <= len(pass); i += 4 {
+		x, err := strconv.ParseUint(pass[i:i+4], 16, 16)
+		if err != nil {
+			return ""
+		}
+		res = append(res, uint16(x)^k[len(res)%len(k)])
+	}
+
+	return string(utf16.Decode(res))
+}
+
+func (p *psiImporter) protocolMatches(s string) bool {
+	return s == "prpl-jabber"
+}
+
+// importAccountsFrom returns the accounts in the Psi accounts file, by the names the OTR plugin uses for them
+func (p *psiImporter) importAccountsFrom(f string) (map[string]*config.Account, bool) {
+	content, err := ioutil.ReadFile(filepath.Clean(f))
+	if err != nil {
+		return nil, false
+	}
+
+	var opts psiOptionsXML
+	if err := xml.Unmarshal(content, &opts); err != nil {
+		return nil, false
+	}
+
+	res := make(map[string]*config.Account)
+	for _, a := range opts.Accounts.Accounts {
+		account := bareJIDOf(a.JID)
+		if account == "" {
+			continue
+		}
+
+		ac := &config.Account{
+			Account:  account,
+			Nickname: a.Name,
+			Password: decodePsiPassword(a.Password, a.JID),
+			Port:     5222,
+			Proxies:  make([]string, 0),
+		}
+
+		if a.UseHostPort {
+			ac.Server = a.Host
+			ac.Port = parseIntOr(a.Port, 5222)
+		}
+
+		ac.ConnectTLS = a.SSL == "legacy"
+
+		for _, px := range opts.Proxies.Proxies {
+			if px.is(a.ProxyID) {
+				ac.Proxies = append(ac.Proxies, px.String())
+			}
+		}
+
+		name := a.ID
+		if name == "" {
+			name = account
+		}
+		res[name] = ac
+	}
+
+	return res, true
+}
+
+func (p *psiImporter) importAllFrom(accountsFile, keyFile, fprFile, certsDir string) (*config.ApplicationConfig, bool) {
+	accounts, ok := p.importAccountsFrom(accountsFile)
+	if !ok || len(accounts) == 0 {
+		return nil, false
+	}
+
+	keys, _ := ImportKeysFromPidginStyle(keyFile, p.protocolMatches)
+	fprs, _ := ImportFingerprintsFromPidginStyle(fprFile, p.protocolMatches)
+	certs := certificatesIn(certsDir)
+
+	res := &config.ApplicationConfig{}
+	for name, ac := range accounts {
+		if key, ok := keys[name]; ok {
+			ac.PrivateKeys = [][]byte{key}
+		}
+		addFingerprintsTo(ac, fprs[name])
+		pinCertificatesFor(ac, certs)
+		res.Add(ac)
+	}
+
+	sort.Sort(config.ByAccountNameAlphabetic(res.Accounts))
+
+	return res, true
+}
+
+func (p *psiImporter) TryImport() []*config.ApplicationConfig {
+	var res []*config.ApplicationConfig
+
+	for _, d := range psiDirs {
+		configDir := filepath.Join(config.SystemConfigDir(), d)
+		dataDir := filepath.Join(config.SystemDataDir(), d)
+
+		for _, profile := range subdirectoriesOf(filepath.Join(configDir, psiProfilesDir)) {
+			profileDataDir := filepath.Join(dataDir, psiProfilesDir, profile)
+			ac, ok := p.importAllFrom(
+				filepath.Join(configDir, psiProfilesDir, profile, psiAccountsFile),
+				filepath.Join(profileDataDir, psiOtrDataKeyFile),
+				filepath.Join(profileDataDir, psiOtrDataFingerprintsFile),
+				filepath.Join(dataDir, psiCertificatesDir),
+			)
+			if ok {
+				res = append(res, ac)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,78 @@
+package importer
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type PsiSuite struct{}
+
+var _ = Suite(&PsiSuite{})
+
+func (s *PsiSuite) Test_decodePsiPassword(c *C) {
+	c.Assert(decodePsiPassword("0002000300", "abc"), Equals, "ca")
+	c.Assert(decodePsiPassword("00020003", ""), Equals, "00020003")
+	c.Assert(decodePsiPassword("", "abc"), Equals, "")
+	c.Assert(decodePsiPassword("zzzz", "abc"), Equals, "")
+}
+
+func (s *PsiSuite) Test_PsiImporter_canImportAccountsFromFile(c *C) {
+	importer := psiImporter{}
+
+	res, ok := importer.importAccountsFrom(testResourceFilename("psi_test_data/accounts.xml"))
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res, HasLen, 2)
+
+	alice := res["{5b7e0bb6-3c53-4d5c-9a6c-0c1f5d2e6f11}"]
+	c.Assert(alice.Account, Equals, "alice@example.com")
+	c.Assert(alice.Nickname, Equals, "Alice")
+	c.Assert(alice.Password, Equals, "correct horse battery staple")
+	c.Assert(alice.Server, Equals, "xmpp.example.com")
+	c.Assert(alice.Port, Equals, 5223)
+	c.Assert(alice.ConnectTLS, Equals, true)
+	c.Assert(alice.Proxies, DeepEquals, []string{"socks5://127.0.0.1:9050"})
+
+	bob := res["{0d6f1e51-6a2b-4f3c-8b1e-1e9a0f7c2d22}"]
+	c.Assert(bob.Account, Equals, "bob@other.org")
+	c.Assert(bob.Password, Equals, "")
+	c.Assert(bob.Server, Equals, "")
+	c.Assert(bob.Port, Equals, 5222)
+	c.Assert(bob.ConnectTLS, Equals, false)
+	c.Assert(bob.Proxies, HasLen, 0)
+}
+
+func (s *PsiSuite) Test_PsiImporter_canDoAFullImport(c *C) {
+	importer := psiImporter{}
+
+	res, ok := importer.importAllFrom(
+		testResourceFilename("psi_test_data/accounts.xml"),
+		testResourceFilename("psi_test_data/otr.keys"),
+		testResourceFilename("psi_test_data/otr.fingerprints"),
+		testResourceFilename("psi_test_data/certs"),
+	)
+
+	c.Assert(ok, Equals, true)
+	c.Assert(res.Accounts, HasLen, 2)
+
+	alice := res.Accounts[0]
+	c.Assert(alice.Account, Equals, "alice@example.com")
+	c.Assert(alice.PrivateKeys, HasLen, 1)
+	c.Assert(alice.Peers, HasLen, 2)
+	p, _ := alice.GetPeer("bob@other.org")
+	c.Assert(p.Fingerprints[0].Trusted, Equals, true)
+	c.Assert(alice.Certificates, HasLen, 1)
+	c.Assert(alice.Certificates[0].Subject, Equals, "example.com")
+
+	bob := res.Accounts[1]
+	c.Assert(bob.Account, Equals, "bob@other.org")
+	c.Assert(bob.PrivateKeys, HasLen, 0)
+	c.Assert(bob.Peers, HasLen, 1)
+	c.Assert(bob.Certificates, HasLen, 0)
+}
+
+func (s *PsiSuite) Test_PsiImporter_failsOnBadAccountsFile(c *C) {
+	importer := psiImporter{}
+
+	_, ok := importer.importAllFrom(testResourceFilename("psi_test_data/otr.fingerprints"), "", "", "")
+	c.Assert(ok, Equals, false)
+}
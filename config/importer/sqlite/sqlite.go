@@ -0,0 +1,509 @@
+// Package sqlite reads the tables of SQLite database files. It only implements what is needed to
+// import data from other applications: reading every row of a table, from a database that is
+// not being written to. It understands UTF-8 databases, including the changes that are still in
+// a write-ahead log next to the file.
+package sqlite
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotDatabase is returned when the file is not an SQLite database that can be read
+var ErrNotDatabase = errors.New("not a supported SQLite database")
+
+// ErrCorrupted is returned when the structure of the database doesn't make sense
+var ErrCorrupted = errors.New("the SQLite database is corrupted")
+
+// ErrNoSuchTable is returned when asking for a table that doesn't exist
+var ErrNoSuchTable = errors.New("no such table")
+
+const (
+	headerSize   = 100
+	magic        = "SQLite format 3\x00"
+	encodingUTF8 = 1
+
+	pageInteriorTable = 0x05
+	pageLeafTable     = 0x0d
+
+	// maxDepth guards against b-trees that are too deep to be real in damaged files
+	maxDepth = 64
+)
+
+// Database is an SQLite database read completely into memory
+type Database struct {
+	content  []byte
+	pageSize int
+	usable   int
+	tables   map[string]*table
+}
+
+type table struct {
+	rootPage int
+	columns  []string
+	// rowidColumn is the column that is an alias for the rowid, or -1
+	rowidColumn int
+	// real marks the columns with REAL affinity, where SQLite stores integral values as integers
+	real []bool
+}
+
+// Row is one row of a table, by column name. Values are nil, int64, float64, string or []byte.
+type Row map[string]interface{}
+
+// Open reads the database in the given file. The transactions committed to the write-ahead log
+// next to it, if there is one, are read as well.
+func Open(path string) (*Database, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	wal, err := ioutil.ReadFile(filepath.Clean(path + walSuffix))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(wal) > 0 {
+		if content, err = applyWAL(content, wal); err != nil {
+			return nil, err
+		}
+	}
+
+	return Parse(content)
+}
+
+// Parse reads the database from the content of a database file
+func Parse(content []byte) (*Database, error) {
+	if len(content) < headerSize || string(content[:len(magic)]) != magic {
+		return nil, ErrNotDatabase
+	}
+
+	pageSize := int(binary.BigEndian.Uint16(content[16:]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 {
+		return nil, ErrNotDatabase
+	}
+
+	if binary.BigEndian.Uint32(content[56:]) != encodingUTF8 {
+		return nil, ErrNotDatabase
+	}
+
+	db := &Database{
+		content:  content,
+		pageSize: pageSize,
+		usable:   pageSize - int(content[20]),
+		tables:   make(map[string]*table),
+	}
+
+	if err := db.readSchema(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func (db *Database) readSchema() error {
+	return db.walk(1, func(_ int64, values []interface{}) error {
+		if len(values) < 5 {
+			return ErrCorrupted
+		}
+
+		kind, _ := values[0].(string)
+		name, _ := values[1].(string)
+		root, _ := values[3].(int64)
+		sql, _ := values[4].(string)
+		if kind != "table" || root <= 0 {
+			return nil
+		}
+
+		columns, rowidColumn, real := columnsOf(sql)
+		db.tables[strings.ToLower(name)] = &table{
+			rootPage:    int(root),
+			columns:     columns,
+			rowidColumn: rowidColumn,
+			real:        real,
+		}
+		return nil
+	})
+}
+
+// HasTable returns true if the database has a table with the given name
+func (db *Database) HasTable(name string) bool {
+	_, ok := db.tables[strings.ToLower(name)]
+	return ok
+}
+
+// Columns returns the names of the columns of the table, in order
+func (db *Database) Columns(name string) ([]string, error) {
+	t, ok := db.tables[strings.ToLower(name)]
+	if !ok {
+		return nil, ErrNoSuchTable
+	}
+	return t.columns, nil
+}
+
+// Rows returns all rows of the table, in the order of their rowid
+func (db *Database) Rows(name string) ([]Row, error) {
+	t, ok := db.tables[strings.ToLower(name)]
+	if !ok {
+		return nil, ErrNoSuchTable
+	}
+
+	var result []Row
+	err := db.walk(t.rootPage, func(rowid int64, values []interface{}) error {
+		row := make(Row, len(t.columns))
+		for i, c := range t.columns {
+			if i < len(values) {
+				row[c] = values[i]
+				if v, ok := values[i].(int64); ok && t.real[i] {
+					row[c] = float64(v)
+				}
+			} else {
+				// Columns added after the row was written
+				row[c] = nil
+			}
+		}
+		if t.rowidColumn >= 0 {
+			row[t.columns[t.rowidColumn]] = rowid
+		}
+		result = append(result, row)
+		return nil
+	})
+
+	return result, err
+}
+
+// pages returns the number of pages in the database
+func (db *Database) pages() int {
+	return len(db.content) / db.pageSize
+}
+
+func (db *Database) page(n int) ([]byte, error) {
+	start := (n - 1) * db.pageSize
+	if n < 1 || start+db.pageSize > len(db.content) {
+		return nil, ErrCorrupted
+	}
+	return db.content[start : start+db.pageSize], nil
+}
+
+// walk calls the function with the rowid and values of every row in the table b-tree starting at the given page
+func (db *Database) walk(root int, f func(int64, []interface{}) error) error {
+	w := &walker{db: db, f: f, visited: make(map[int]bool)}
+	return w.walk(root, 0)
+}
+
+// walker keeps track of the pages a walk has been through. In a b-tree every page has only one parent,
+// so a page that is reached again means the file is damaged. Since no page is read twice, a walk never
+// goes through more pages than the file has, whatever the pages in it point to.
+type walker struct {
+	db      *Database
+	f       func(int64, []interface{}) error
+	visited map[int]bool
+}
+
+func (w *walker) walk(n, depth int) error {
+	db, f := w.db, w.f
+	if depth > maxDepth || w.visited[n] || len(w.visited) >= db.pages() {
+		return ErrCorrupted
+	}
+	w.visited[n] = true
+
+	p, err := db.page(n)
+	if err != nil {
+		return err
+	}
+
+	h := 0
+	if n == 1 {
+		h = headerSize
+	}
+	if len(p) < h+12 {
+		return ErrCorrupted
+	}
+
+	kind := p[h]
+	cells := int(binary.BigEndian.Uint16(p[h+3:]))
+	pointers := h + 8
+	if kind == pageInteriorTable {
+		pointers = h + 12
+	}
+	if pointers+2*cells > len(p) {
+		return ErrCorrupted
+	}
+
+	for i := 0; i < cells; i++ {
+		offset := int(binary.BigEndian.Uint16(p[pointers+2*i:]))
+		if offset >= len(p) {
+			return ErrCorrupted
+		}
+
+		switch kind {
+		case pageInteriorTable:
+			if offset+4 > len(p) {
+				return ErrCorrupted
+			}
+			if err := w.walk(int(binary.BigEndian.Uint32(p[offset:])), depth+1); err != nil {
+				return err
+			}
+		case pageLeafTable:
+			rowid, payload, err := db.leafCell(p, offset)
+			if err != nil {
+				return err
+			}
+			values, err := decodeRecord(payload)
+			if err != nil {
+				return err
+			}
+			if err := f(rowid, values); err != nil {
+				return err
+			}
+		default:
+			return ErrCorrupted
+		}
+	}
+
+	if kind == pageInteriorTable {
+		return w.walk(int(binary.BigEndian.Uint32(p[h+8:])), depth+1)
+	}
+	return nil
+}
+
+// leafCell returns the rowid and the whole payload of the cell, following overflow pages if needed
+func (db *Database) leafCell(p []byte, offset int) (int64, []byte, error) {
+	size, n := varint(p[offset:])
+	if n == 0 {
+		return 0, nil, ErrCorrupted
+	}
+	offset += n
+
+	rowid, n := varint(p[offset:])
+	if n == 0 {
+		return 0, nil, ErrCorrupted
+	}
+	offset += n
+
+	total := int(size)
+	maxLocal := db.usable - 35
+	local := total
+	if total > maxLocal {
+		minLocal := (db.usable-12)*32/255 - 23
+		local = minLocal + (total-minLocal)%(db.usable-4)
+		if local > maxLocal {
+			local = minLocal
+		}
+	}
+
+	if total < 0 || total > len(db.content) || offset+local > len(p) {
+		return 0, nil, ErrCorrupted
+	}
+
+	payload := make([]byte, 0, total)
+	payload = append(payload, p[offset:offset+local]...)
+	if local == total {
+		return int64(rowid), payload, nil
+	}
+
+	if offset+local+4 > len(p) {
+		return 0, nil, ErrCorrupted
+	}
+	next := int(binary.BigEndian.Uint32(p[offset+local:]))
+	for len(payload) < total {
+		if next == 0 || len(payload) > len(db.content) {
+			return 0, nil, ErrCorrupted
+		}
+		op, err := db.page(next)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		chunk := op[4:db.usable]
+		if rest := total - len(payload); rest < len(chunk) {
+			chunk = chunk[:rest]
+		}
+		payload = append(payload, chunk...)
+		next = int(binary.BigEndian.Uint32(op))
+	}
+
+	return int64(rowid), payload, nil
+}
+
+// varint decodes an SQLite variable length integer, returning the number of bytes used, or 0 if there weren't enough
+func varint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 9; i++ {
+		if i >= len(b) {
+			return 0, 0
+		}
+		if i == 8 {
+			return v<<8 | uint64(b[i]), 9
+		}
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i]&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return v, 9
+}
+
+func decodeRecord(payload []byte) ([]interface{}, error) {
+	headerLen, n := varint(payload)
+	if n == 0 || int(headerLen) > len(payload) || int(headerLen) < n {
+		return nil, ErrCorrupted
+	}
+
+	var types []uint64
+	for h := payload[n:headerLen]; len(h) > 0; {
+		t, n := varint(h)
+		if n == 0 {
+			return nil, ErrCorrupted
+		}
+		types = append(types, t)
+		h = h[n:]
+	}
+
+	body := payload[headerLen:]
+	values := make([]interface{}, 0, len(types))
+	for _, t := range types {
+		size := serialSize(t)
+		if size > uint64(len(body)) {
+			return nil, ErrCorrupted
+		}
+		values = append(values, decodeValue(t, body[:size]))
+		body = body[size:]
+	}
+
+	return values, nil
+}
+
+// serialSize returns the size of a value of the given serial type. It's kept unsigned, since
+// serial types of damaged records can give sizes that don't fit in an int.
+func serialSize(t uint64) uint64 {
+	switch {
+	case t <= 4:
+		return t
+	case t == 5:
+		return 6
+	case t == 6 || t == 7:
+		return 8
+	case t < 12:
+		return 0
+	case t%2 == 0:
+		return (t - 12) / 2
+	default:
+		return (t - 13) / 2
+	}
+}
+
+func decodeValue(t uint64, b []byte) interface{} {
+	switch {
+	case t == 0:
+		return nil
+	case t >= 1 && t <= 6:
+		// Big endian two's complement of any size
+		v := int64(int8(b[0]))
+		for _, x := range b[1:] {
+			v = v<<8 | int64(x)
+		}
+		return v
+	case t == 7:
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	case t == 8:
+		return int64(0)
+	case t == 9:
+		return int64(1)
+	case t >= 12 && t%2 == 0:
+		return append([]byte{}, b...)
+	case t >= 13:
+		return string(b)
+	}
+	return nil
+}
+
+// columnsOf returns the names of the columns in a CREATE TABLE statement, which of
+// them is an alias for the rowid, if any, and which of them have REAL affinity
+func columnsOf(sql string) ([]string, int, []bool) {
+	start, end := strings.IndexByte(sql, '('), strings.LastIndexByte(sql, ')')
+	if start < 0 || end < start {
+		return nil, -1, nil
+	}
+
+	var columns []string
+	var real []bool
+	rowidColumn := -1
+	for _, def := range splitTopLevel(sql[start+1 : end]) {
+		name, rest := splitName(strings.TrimSpace(def))
+		if name == "" {
+			continue
+		}
+
+		switch strings.ToUpper(name) {
+		case "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "CONSTRAINT":
+			continue
+		}
+
+		upper := strings.ToUpper(strings.Join(strings.Fields(rest), " "))
+		if strings.HasPrefix(upper, "INTEGER") && strings.Contains(upper, "PRIMARY KEY") && !strings.Contains(upper, "DESC") {
+			rowidColumn = len(columns)
+		}
+		columns = append(columns, name)
+		real = append(real, hasRealAffinity(upper))
+	}
+
+	return columns, rowidColumn, real
+}
+
+// hasRealAffinity follows the rules SQLite uses to decide the affinity of a column from its declared type
+func hasRealAffinity(definition string) bool {
+	declared := strings.Fields(definition)
+	if len(declared) == 0 {
+		return false
+	}
+	t := declared[0]
+	if strings.Contains(t, "INT") || strings.Contains(t, "CHAR") || strings.Contains(t, "CLOB") || strings.Contains(t, "TEXT") || strings.Contains(t, "BLOB") {
+		return false
+	}
+	return strings.Contains(t, "REAL") || strings.Contains(t, "FLOA") || strings.Contains(t, "DOUB")
+}
+
+func splitTopLevel(s string) []string {
+	var result []string
+	depth, last := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(result, s[last:])
+}
+
+// splitName returns the name at the start of a column definition, without quotes, and the rest of the definition
+func splitName(def string) (string, string) {
+	if def == "" {
+		return "", ""
+	}
+
+	closing := map[byte]byte{'"': '"', '`': '`', '\'': '\'', '[': ']'}
+	if c, ok := closing[def[0]]; ok {
+		if i := strings.IndexByte(def[1:], c); i >= 0 {
+			return def[1 : i+1], def[i+2:]
+		}
+	}
+
+	if i := strings.IndexAny(def, " \t\n\r("); i >= 0 {
+		return def[:i], def[i:]
+	}
+	return def, ""
+}
@@ -0,0 +1,196 @@
+package sqlite
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type SQLiteSuite struct{}
+
+var _ = Suite(&SQLiteSuite{})
+
+func testDatabase(c *C) *Database {
+	_, filename, _, _ := runtime.Caller(0)
+	db, err := Open(filepath.Join(filepath.Dir(filename), "sqlite_test_data/test.db"))
+	c.Assert(err, IsNil)
+	return db
+}
+
+func (s *SQLiteSuite) Test_Open_readsTheTablesOfTheDatabase(c *C) {
+	db := testDatabase(c)
+
+	c.Assert(db.HasTable("people"), Equals, true)
+	c.Assert(db.HasTable("PEOPLE"), Equals, true)
+	c.Assert(db.HasTable("notes_body"), Equals, false)
+	c.Assert(db.HasTable("nothing"), Equals, false)
+
+	columns, err := db.Columns("people")
+	c.Assert(err, IsNil)
+	c.Assert(columns, DeepEquals, []string{"id", "name", "age", "score", "avatar"})
+
+	_, err = db.Columns("nothing")
+	c.Assert(err, Equals, ErrNoSuchTable)
+}
+
+func (s *SQLiteSuite) Test_Database_Rows_readsAllRowsOfATableSpreadOverManyPages(c *C) {
+	db := testDatabase(c)
+
+	rows, err := db.Rows("people")
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 300)
+
+	c.Assert(rows[0], DeepEquals, Row{
+		"id":     int64(1),
+		"name":   "person 1",
+		"age":    int64(1000000),
+		"score":  0.25,
+		"avatar": []byte{1, 1, 1},
+	})
+
+	c.Assert(rows[299]["id"], Equals, int64(300))
+	c.Assert(rows[299]["name"], Equals, "person 300")
+	c.Assert(rows[299]["age"], Equals, int64(-300))
+	c.Assert(rows[299]["score"], Equals, 75.0)
+}
+
+func (s *SQLiteSuite) Test_Database_Rows_followsOverflowPagesAndFillsInAddedColumns(c *C) {
+	db := testDatabase(c)
+
+	rows, err := db.Rows("notes")
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 2)
+
+	c.Assert(rows[0]["body"], Equals, strings.Repeat("long ", 2000))
+	c.Assert(rows[0]["extra"], IsNil)
+	c.Assert(rows[1]["body"], IsNil)
+}
+
+func (s *SQLiteSuite) Test_Parse_rejectsFilesThatAreNotDatabases(c *C) {
+	_, err := Parse([]byte("hello"))
+	c.Assert(err, Equals, ErrNotDatabase)
+
+	_, err = Parse(make([]byte, 4096))
+	c.Assert(err, Equals, ErrNotDatabase)
+}
+
+func (s *SQLiteSuite) Test_Parse_returnsAnErrorForTruncatedDatabases(c *C) {
+	_, filename, _, _ := runtime.Caller(0)
+	content, err := ioutil.ReadFile(filepath.Join(filepath.Dir(filename), "sqlite_test_data/test.db"))
+	c.Assert(err, IsNil)
+
+	db, err := Parse(content[:2048])
+	if err == nil {
+		_, err = db.Rows("people")
+	}
+	c.Assert(err, Equals, ErrCorrupted)
+}
+
+func (s *SQLiteSuite) Test_decodeRecord_returnsAnErrorForHugeSerialTypes(c *C) {
+	// A header with the serial type 0xffffffffffffffff, followed by some bytes of the body
+	payload := []byte{10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 'a', 'b'}
+
+	_, err := decodeRecord(payload)
+	c.Assert(err, Equals, ErrCorrupted)
+}
+
+func walTestFiles(c *C) ([]byte, []byte) {
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Join(filepath.Dir(filename), "sqlite_test_data")
+	content, err := ioutil.ReadFile(filepath.Join(dir, "wal.db"))
+	c.Assert(err, IsNil)
+	wal, err := ioutil.ReadFile(filepath.Join(dir, "wal.db-wal"))
+	c.Assert(err, IsNil)
+	return content, wal
+}
+
+func namesIn(c *C, db *Database) []interface{} {
+	rows, err := db.Rows("people")
+	c.Assert(err, IsNil)
+	var res []interface{}
+	for _, r := range rows {
+		res = append(res, r["name"])
+	}
+	return res
+}
+
+func (s *SQLiteSuite) Test_Open_readsTheTransactionsInTheWriteAheadLog(c *C) {
+	_, filename, _, _ := runtime.Caller(0)
+	db, err := Open(filepath.Join(filepath.Dir(filename), "sqlite_test_data/wal.db"))
+	c.Assert(err, IsNil)
+	c.Assert(namesIn(c, db), DeepEquals, []interface{}{"someone else", "person 2", "person 3", "person 4"})
+
+	content, _ := walTestFiles(c)
+	db, err = Parse(content)
+	c.Assert(err, IsNil)
+	c.Assert(namesIn(c, db), DeepEquals, []interface{}{"person 1", "person 2", "person 3"})
+}
+
+func (s *SQLiteSuite) Test_applyWAL_leavesOutATransactionThatWasNotWrittenCompletely(c *C) {
+	content, wal := walTestFiles(c)
+
+	applied, err := applyWAL(content, wal[:len(wal)-100])
+	c.Assert(err, IsNil)
+	db, err := Parse(applied)
+	c.Assert(err, IsNil)
+	c.Assert(namesIn(c, db), DeepEquals, []interface{}{"person 1", "person 2", "person 3", "person 4"})
+
+	damaged := append([]byte{}, wal...)
+	damaged[len(damaged)-1] ^= 0xff
+	applied, err = applyWAL(content, damaged)
+	c.Assert(err, IsNil)
+	db, err = Parse(applied)
+	c.Assert(err, IsNil)
+	c.Assert(namesIn(c, db), DeepEquals, []interface{}{"person 1", "person 2", "person 3", "person 4"})
+}
+
+func (s *SQLiteSuite) Test_columnsOf_findsTheColumnsAndTheRowidAlias(c *C) {
+	columns, rowid, _ := columnsOf("CREATE TABLE logs (log_line_id INTEGER PRIMARY KEY AUTOINCREMENT UNIQUE, jid_id INTEGER, `time` INTEGER, [kind] INTEGER, message TEXT, PRIMARY KEY(jid_id, time), CHECK (kind IN (1, 2)))")
+	c.Assert(columns, DeepEquals, []string{"log_line_id", "jid_id", "time", "kind", "message"})
+	c.Assert(rowid, Equals, 0)
+
+	columns, rowid, real := columnsOf(`CREATE TABLE "a b" ("first col" TEXT, second NUMERIC(10, 2), third DOUBLE PRECISION)`)
+	c.Assert(columns, DeepEquals, []string{"first col", "second", "third"})
+	c.Assert(rowid, Equals, -1)
+	c.Assert(real, DeepEquals, []bool{false, false, true})
+}
+
+// damagedPeopleTable returns the test database with the interior root page of the people table changed by the function
+func damagedPeopleTable(c *C, damage func(p []byte, cells, root int)) *Database {
+	db := testDatabase(c)
+	root := db.tables["people"].rootPage
+	p, err := db.page(root)
+	c.Assert(err, IsNil)
+	c.Assert(p[0], Equals, byte(pageInteriorTable))
+
+	damage(p, int(binary.BigEndian.Uint16(p[3:])), root)
+	return db
+}
+
+func (s *SQLiteSuite) Test_Database_Rows_returnsAnErrorWhenAPageIsReachedTwice(c *C) {
+	db := damagedPeopleTable(c, func(p []byte, _, _ int) {
+		first := int(binary.BigEndian.Uint16(p[12:]))
+		copy(p[8:12], p[first:first+4])
+	})
+
+	_, err := db.Rows("people")
+	c.Assert(err, Equals, ErrCorrupted)
+}
+
+func (s *SQLiteSuite) Test_Database_Rows_returnsAnErrorForPagesThatPointBackToThemselves(c *C) {
+	// Every child of the root is the root again, which would be visited once for every path through it
+	db := damagedPeopleTable(c, func(p []byte, cells, root int) {
+		for i := 0; i < cells; i++ {
+			offset := int(binary.BigEndian.Uint16(p[12+2*i:]))
+			binary.BigEndian.PutUint32(p[offset:], uint32(root))
+		}
+		binary.BigEndian.PutUint32(p[8:], uint32(root))
+	})
+
+	_, err := db.Rows("people")
+	c.Assert(err, Equals, ErrCorrupted)
+}
@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// The write-ahead log is described in https://www.sqlite.org/fileformat2.html#the_write_ahead_log
+const (
+	walSuffix          = "-wal"
+	walMagic           = 0x377f0682
+	walHeaderSize      = 32
+	walFrameHeaderSize = 24
+)
+
+// applyWAL returns the content of the database as SQLite sees it, with the transactions committed to the
+// write-ahead log written into it. Like SQLite does, the log is only read up to the first frame that doesn't
+// belong to it or wasn't written completely, and frames after the last commit are left out.
+func applyWAL(content, wal []byte) ([]byte, error) {
+	if len(wal) < walHeaderSize || binary.BigEndian.Uint32(wal)&^1 != walMagic {
+		// SQLite ignores a log without a valid header
+		return content, nil
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if binary.BigEndian.Uint32(wal)&1 == 1 {
+		order = binary.BigEndian
+	}
+
+	pageSize := int(binary.BigEndian.Uint32(wal[8:]))
+	if pageSize < 512 || pageSize > 65536 || pageSize&(pageSize-1) != 0 {
+		return content, nil
+	}
+
+	s0, s1 := walChecksum(order, wal[:24], 0, 0)
+	if s0 != binary.BigEndian.Uint32(wal[24:]) || s1 != binary.BigEndian.Uint32(wal[28:]) {
+		return content, nil
+	}
+	salt := wal[16:24]
+
+	if len(content) >= headerSize && databasePageSize(content) != pageSize {
+		return nil, ErrCorrupted
+	}
+
+	result := content
+	var pending [][]byte
+	for off := walHeaderSize; off+walFrameHeaderSize+pageSize <= len(wal); off += walFrameHeaderSize + pageSize {
+		h := wal[off : off+walFrameHeaderSize]
+		frame := wal[off : off+walFrameHeaderSize+pageSize]
+		if !bytes.Equal(h[8:16], salt) || binary.BigEndian.Uint32(h) == 0 {
+			break
+		}
+
+		s0, s1 = walChecksum(order, h[:8], s0, s1)
+		s0, s1 = walChecksum(order, frame[walFrameHeaderSize:], s0, s1)
+		if s0 != binary.BigEndian.Uint32(h[16:]) || s1 != binary.BigEndian.Uint32(h[20:]) {
+			break
+		}
+
+		pending = append(pending, frame)
+		if pages := int(binary.BigEndian.Uint32(h[4:])); pages > 0 {
+			// The pages the database grows with have to come from the log
+			if pages > (len(content)+len(wal))/pageSize {
+				return nil, ErrCorrupted
+			}
+			result = writeFrames(result, pending, pages, pageSize)
+			pending = nil
+		}
+	}
+
+	return result, nil
+}
+
+func databasePageSize(content []byte) int {
+	pageSize := int(binary.BigEndian.Uint16(content[16:]))
+	if pageSize == 1 {
+		return 65536
+	}
+	return pageSize
+}
+
+// writeFrames returns a copy of the content with the pages in the frames of a committed transaction written into it.
+// The database has the given number of pages after the transaction.
+func writeFrames(content []byte, frames [][]byte, pages, pageSize int) []byte {
+	result := make([]byte, pages*pageSize)
+	copy(result, content)
+
+	for _, f := range frames {
+		n := int(binary.BigEndian.Uint32(f))
+		if n <= pages {
+			copy(result[(n-1)*pageSize:], f[walFrameHeaderSize:])
+		}
+	}
+
+	return result
+}
+
+// walChecksum continues the checksum of the log over the given data, which is a multiple of 8 bytes long
+func walChecksum(order binary.ByteOrder, data []byte, s0, s1 uint32) (uint32, uint32) {
+	for i := 0; i+8 <= len(data); i += 8 {
+		s0 += order.Uint32(data[i:]) + s1
+		s1 += order.Uint32(data[i+4:]) + s0
+	}
+	return s0, s1
+}
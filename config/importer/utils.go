@@ -1,9 +1,16 @@
 package importer
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/digests"
+	"github.com/chadsec1/decoyim/xmpp/jid"
 )
 
 func composeProxyString(tp, user, pass, host, port string) string {
@@ -54,3 +61,54 @@ func ifExistsDir(fs []string, d string) []string {
 	}
 	return fs
 }
+
+// addFingerprintsTo adds the known fingerprints to the peers of the account
+func addFingerprintsTo(ac *config.Account, fprs []*config.KnownFingerprint) {
+	sort.Sort(config.LegacyByNaturalOrder(fprs))
+	for _, kfpr := range fprs {
+		fpr, _ := ac.EnsurePeer(kfpr.UserID).EnsureHasFingerprint(kfpr.Fingerprint)
+		if !kfpr.Untrusted {
+			fpr.Trusted = true
+		}
+	}
+}
+
+// certificatesIn reads all certificates in the files of the given directory,
+// no matter if they are PEM or DER encoded
+func certificatesIn(dir string) []*x509.Certificate {
+	var result []*x509.Certificate
+	for _, f := range ifExistsDir(nil, dir) {
+		content, err := ioutil.ReadFile(filepath.Clean(f))
+		if err != nil {
+			continue
+		}
+
+		found := false
+		for rest := content; ; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if cert, err := x509.ParseCertificate(block.Bytes); block.Type == "CERTIFICATE" && err == nil {
+				result = append(result, cert)
+				found = true
+			}
+		}
+
+		if cert, err := x509.ParseCertificate(content); !found && err == nil {
+			result = append(result, cert)
+		}
+	}
+	return result
+}
+
+// pinCertificatesFor pins the certificates that are valid for the domain or the server of the account
+func pinCertificatesFor(ac *config.Account, certs []*x509.Certificate) {
+	domain := jid.Parse(ac.Account).Host().String()
+	for _, cert := range certs {
+		if cert.VerifyHostname(domain) == nil || (ac.Server != "" && cert.VerifyHostname(ac.Server) == nil) {
+			ac.SaveCert(cert.Subject.CommonName, cert.Issuer.CommonName, digests.Sha3_256(cert.Raw))
+		}
+	}
+}
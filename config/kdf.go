@@ -0,0 +1,118 @@
+package config
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The versions of the encryption parameters. Files written before the parameters had versions use scrypt.
+const (
+	encryptionParametersScrypt   = 1
+	encryptionParametersArgon2id = 2
+
+	currentEncryptionParametersVersion = encryptionParametersArgon2id
+)
+
+// New Argon2id parameters are calibrated so that generating the keys takes about this long on the current machine
+const argon2TargetDuration = time.Second
+
+// Memory is calibrated before time, as RFC 9106, section 4 recommends: as much memory as fits in the target time
+// is used first, and then more passes. Memory is kept within bounds, since the keys have to be generated again
+// on every machine the configuration file is opened on - including ones with less memory than this one.
+const (
+	argon2MinMemory  = 64 * 1024  // In KiB
+	argon2MaxMemory  = 512 * 1024 // In KiB
+	argon2MinTime    = 3
+	argon2MaxTime    = 64
+	argon2MaxThreads = 4
+)
+
+var errUnsupportedEncryptionParameters = errors.New("the encryption parameters are from a newer version of the application")
+
+func (p *EncryptionParameters) version() int {
+	if p.Version == 0 {
+		return encryptionParametersScrypt
+	}
+	return p.Version
+}
+
+// isOutdated returns true if new parameters would generate stronger keys than these
+func (p *EncryptionParameters) isOutdated() bool {
+	return p.version() < currentEncryptionParametersVersion
+}
+
+// kdfID identifies the keys generated with these parameters - parameters that only differ in the nonce generate the same keys
+func (p *EncryptionParameters) kdfID() string {
+	return fmt.Sprintf("%d/%s/%d/%d/%d/%d/%d/%d", p.version(), hex.EncodeToString(p.saltInternal), p.N, p.R, p.P, p.Time, p.Memory, p.Threads)
+}
+
+type argon2Cost struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+var (
+	calibrateArgon2Once sync.Once
+	calibratedArgon2    argon2Cost
+)
+
+// calibrateArgon2 returns the Argon2id cost to use for new parameters. It is only measured once.
+var calibrateArgon2 = func() argon2Cost {
+	calibrateArgon2Once.Do(func() {
+		calibratedArgon2 = measureArgon2Cost(argon2TargetDuration)
+	})
+	return calibratedArgon2
+}
+
+// timeArgon2 returns how long one pass of Argon2id with the given memory takes
+var timeArgon2 = func(memory uint32, threads uint8) time.Duration {
+	start := time.Now()
+	_ = argon2.IDKey([]byte("calibration"), make([]byte, saltLen), 1, memory, threads, aesKeyLen+macKeyLen)
+	return time.Since(start)
+}
+
+// measureArgon2Cost doubles the memory of Argon2id for as long as the minimum number of passes fits in the target
+// time, and then uses as many passes as fit in it - but never less than the recommended minimums
+func measureArgon2Cost(target time.Duration) argon2Cost {
+	threads := runtime.NumCPU()
+	if threads > argon2MaxThreads {
+		threads = argon2MaxThreads
+	}
+
+	res := argon2Cost{time: argon2MinTime, memory: argon2MinMemory, threads: uint8(threads)}
+
+	took := timeArgon2(res.memory, res.threads)
+	for res.memory < argon2MaxMemory && 2*took*argon2MinTime <= target {
+		res.memory *= 2
+		took = timeArgon2(res.memory, res.threads)
+	}
+
+	if took > 0 {
+		passes := target / took
+		if passes > argon2MaxTime {
+			passes = argon2MaxTime
+		}
+		if uint32(passes) > res.time {
+			res.time = uint32(passes)
+		}
+	}
+
+	return res
+}
+
+func generateKeyMaterial(password string, params EncryptionParameters) []byte {
+	if params.version() == encryptionParametersArgon2id {
+		return argon2.IDKey([]byte(password), params.saltInternal, params.Time, params.Memory, params.Threads, aesKeyLen+macKeyLen)
+	}
+
+	res, _ := scrypt.Key([]byte(password), params.saltInternal, params.N, params.R, params.P, aesKeyLen+macKeyLen)
+	return res
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"bytes"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+	. "gopkg.in/check.v1"
+)
+
+type KDFSuite struct{}
+
+var _ = Suite(&KDFSuite{})
+
+func cheapArgon2() func() {
+	orig := calibrateArgon2
+	calibrateArgon2 = func() argon2Cost {
+		return argon2Cost{time: 1, memory: 64, threads: 1}
+	}
+	return func() {
+		calibrateArgon2 = orig
+	}
+}
+
+func cheapScryptParameters() EncryptionParameters {
+	res := EncryptionParameters{N: 16, R: 1, P: 1}
+	res.regenerateNonce()
+	res.saltInternal = genRand(saltLen)
+	return res
+}
+
+func (s *KDFSuite) Test_newEncryptionParameters_usesArgon2id(c *C) {
+	defer cheapArgon2()()
+
+	p := newEncryptionParameters()
+	c.Assert(p.Version, Equals, encryptionParametersArgon2id)
+	c.Assert(p.Time, Equals, uint32(1))
+	c.Assert(p.Memory, Equals, uint32(64))
+	c.Assert(p.N, Equals, 0)
+	c.Assert(p.isOutdated(), Equals, false)
+}
+
+func (s *KDFSuite) Test_GenerateKeys_usesTheKDFOfTheParameters(c *C) {
+	defer cheapArgon2()()
+
+	p := newEncryptionParameters()
+	key, macKey := GenerateKeys(testPassword, p)
+	expected := argon2.IDKey([]byte(testPassword), p.saltInternal, 1, 64, 1, aesKeyLen+macKeyLen)
+	c.Assert(key, DeepEquals, expected[:aesKeyLen])
+	c.Assert(macKey, DeepEquals, expected[aesKeyLen:])
+
+	old := p
+	old.Version = 0
+	old.N, old.R, old.P = 16, 1, 1
+	key2, _ := GenerateKeys(testPassword, old)
+	c.Assert(bytes.Equal(key, key2), Equals, false)
+}
+
+func (s *KDFSuite) Test_EncryptionParameters_isOutdatedWithoutVersion(c *C) {
+	p := EncryptionParameters{N: 262144, R: 8, P: 1}
+	c.Assert(p.version(), Equals, encryptionParametersScrypt)
+	c.Assert(p.isOutdated(), Equals, true)
+}
+
+func (s *KDFSuite) Test_EncryptionParameters_deserialize_rejectsUnknownParameters(c *C) {
+	p := &EncryptionParameters{Version: currentEncryptionParametersVersion + 1, Nonce: "dbd8f7642b05349123d59d1b", Salt: "e18cb93a823465d2797539ebc5f3c0fd"}
+	c.Assert(p.deserialize(), Equals, errUnsupportedEncryptionParameters)
+
+	p = &EncryptionParameters{Version: encryptionParametersArgon2id, Nonce: "dbd8f7642b05349123d59d1b", Salt: "e18cb93a823465d2797539ebc5f3c0fd", Memory: 64}
+	c.Assert(p.deserialize(), Equals, errUnsupportedEncryptionParameters)
+
+	p.Time, p.Threads = 1, 1
+	c.Assert(p.deserialize(), IsNil)
+}
+
+func (s *KDFSuite) Test_EncryptionParameters_kdfID_ignoresTheNonce(c *C) {
+	p := cheapScryptParameters()
+	p2 := p
+	p2.regenerateNonce()
+	c.Assert(p2.kdfID(), Equals, p.kdfID())
+
+	p2.saltInternal = genRand(saltLen)
+	c.Assert(p2.kdfID() == p.kdfID(), Equals, false)
+}
+
+// passTakes makes one pass of Argon2id take the given time for every 64 MiB of memory
+func passTakes(t time.Duration) func() {
+	orig := timeArgon2
+	timeArgon2 = func(memory uint32, _ uint8) time.Duration {
+		return t * time.Duration(memory/argon2MinMemory)
+	}
+	return func() {
+		timeArgon2 = orig
+	}
+}
+
+func (s *KDFSuite) Test_measureArgon2Cost_neverGoesBelowTheMinimum(c *C) {
+	cost := measureArgon2Cost(0)
+	c.Assert(cost.time, Equals, uint32(argon2MinTime))
+	c.Assert(cost.memory, Equals, uint32(argon2MinMemory))
+	c.Assert(cost.threads > 0, Equals, true)
+}
+
+func (s *KDFSuite) Test_measureArgon2Cost_usesMoreMemoryBeforeMorePasses(c *C) {
+	defer passTakes(50 * time.Millisecond)()
+
+	// 3 passes with 256 MiB take 600ms, but with 512 MiB they would take 1.2s
+	cost := measureArgon2Cost(time.Second)
+	c.Assert(cost.memory, Equals, uint32(256*1024))
+	c.Assert(cost.time, Equals, uint32(5))
+}
+
+func (s *KDFSuite) Test_measureArgon2Cost_neverGoesAboveTheMaximum(c *C) {
+	defer passTakes(time.Millisecond)()
+
+	cost := measureArgon2Cost(time.Hour)
+	c.Assert(cost.memory, Equals, uint32(argon2MaxMemory))
+	c.Assert(cost.time, Equals, uint32(argon2MaxTime))
+}
+
+func (s *KDFSuite) Test_PasswordKeySupplier_generatesKeysForNewParametersWithTheSamePassword(c *C) {
+	defer cheapArgon2()()
+
+	asked := 0
+	ks := PasswordKeySupplier(func(bool) (string, bool) {
+		asked++
+		return testPassword, true
+	})
+
+	old := cheapScryptParameters()
+	key, macKey, ok := ks.GenerateKey(old)
+	c.Assert(ok, Equals, true)
+	expectedKey, expectedMacKey := GenerateKeys(testPassword, old)
+	c.Assert(key, DeepEquals, expectedKey)
+	c.Assert(macKey, DeepEquals, expectedMacKey)
+
+	np, ok := ks.(keyUpgrader).upgradedParameters()
+	c.Assert(ok, Equals, true)
+	c.Assert(np.Version, Equals, currentEncryptionParametersVersion)
+
+	key, _, ok = ks.GenerateKey(*np)
+	c.Assert(ok, Equals, true)
+	expectedKey, _ = GenerateKeys(testPassword, *np)
+	c.Assert(key, DeepEquals, expectedKey)
+
+	_, _, _ = ks.GenerateKey(old)
+	c.Assert(asked, Equals, 1)
+
+	ks.Invalidate()
+	_, ok = ks.(keyUpgrader).upgradedParameters()
+	c.Assert(ok, Equals, false)
+	_, _, _ = ks.GenerateKey(old)
+	c.Assert(asked, Equals, 2)
+}
+
+func (s *KDFSuite) Test_PasswordKeySupplier_doesntUpgradeCurrentParameters(c *C) {
+	defer cheapArgon2()()
+
+	ks := PasswordKeySupplier(func(bool) (string, bool) {
+		return testPassword, true
+	})
+
+	_, _, ok := ks.GenerateKey(newEncryptionParameters())
+	c.Assert(ok, Equals, true)
+	_, ok = ks.(keyUpgrader).upgradedParameters()
+	c.Assert(ok, Equals, false)
+}
+
+func (s *KDFSuite) Test_PasswordKeySupplier_failsWithoutPassword(c *C) {
+	laf := false
+	ks := PasswordKeySupplier(func(lastAttemptFailed bool) (string, bool) {
+		laf = lastAttemptFailed
+		return "", false
+	})
+
+	ks.LastAttemptFailed()
+	_, _, ok := ks.GenerateKey(cheapScryptParameters())
+	c.Assert(ok, Equals, false)
+	c.Assert(laf, Equals, true)
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/coyim/otr3"
+)
+
+// LibOTRClient tells how a client that uses libotr names XMPP accounts in its otr.private_key and otr.fingerprints files
+type LibOTRClient struct {
+	Name     string
+	Protocol string
+	// AccountSuffix is added to the account name. Pidgin keeps the separator of the resource in it.
+	AccountSuffix string
+}
+
+// The clients the keys and fingerprints can be exported for
+var (
+	PidginOTRClient    = LibOTRClient{Name: "Pidgin", Protocol: "prpl-jabber", AccountSuffix: "/"}
+	ProfanityOTRClient = LibOTRClient{Name: "Profanity", Protocol: "xmpp"}
+)
+
+// LibOTRClients are the clients the keys and fingerprints can be exported for, with the most common first
+var LibOTRClients = []LibOTRClient{PidginOTRClient, ProfanityOTRClient}
+
+func (c LibOTRClient) accountName(a *Account) string {
+	return a.Account + c.AccountSuffix
+}
+
+// LibOTRPrivateKeys returns the private keys of the account the way libotr keeps them for the client.
+// Keys that can't be parsed are left out.
+func (a *Account) LibOTRPrivateKeys(c LibOTRClient) []*otr3.Account {
+	var result []*otr3.Account
+
+	for _, pp := range a.AllPrivateKeys() {
+		_, ok, parsedKey := otr3.ParsePrivateKey(pp)
+		if ok {
+			result = append(result, &otr3.Account{
+				Name:     c.accountName(a),
+				Protocol: c.Protocol,
+				Key:      parsedKey,
+			})
+		}
+	}
+
+	return result
+}
+
+// WriteLibOTRFingerprints writes the fingerprints of the peers of the account the way libotr keeps them for the client.
+// Trusted fingerprints are marked as verified.
+func (a *Account) WriteLibOTRFingerprints(w io.Writer, c LibOTRClient) error {
+	bw := bufio.NewWriter(w)
+
+	for _, p := range a.Peers {
+		for _, fpr := range p.Fingerprints {
+			if len(fpr.Fingerprint) == 0 {
+				continue
+			}
+
+			trusted := ""
+			if fpr.Trusted {
+				trusted = "\tverified"
+			}
+			if _, err := fmt.Fprintf(bw, "%s\t%s\t%s\t%x%s\n", p.UserID, c.accountName(a), c.Protocol, fpr.Fingerprint, trusted); err != nil {
+				return err
+			}
+		}
+	}
+
+	return bw.Flush()
+}
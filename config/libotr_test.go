@@ -0,0 +1,65 @@
+package config
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/coyim/otr3"
+	. "gopkg.in/check.v1"
+)
+
+type LibOTRSuite struct{}
+
+var _ = Suite(&LibOTRSuite{})
+
+func (s *LibOTRSuite) Test_Account_LibOTRPrivateKeys_canBeReadByLibOTR(c *C) {
+	keys, err := otr3.GenerateMissingKeys(nil)
+	c.Assert(err, IsNil)
+	a := &Account{Account: "someone@example.org", PrivateKeys: append(SerializedKeys(keys), []byte{0x01})}
+
+	f := filepath.Join(c.MkDir(), "otr.private_key")
+	c.Assert(otr3.ExportKeysToFile(a.LibOTRPrivateKeys(PidginOTRClient), f), IsNil)
+
+	res, err := otr3.ImportKeysFromFile(f)
+	c.Assert(err, IsNil)
+	c.Assert(res, HasLen, 1)
+	c.Assert(res[0].Name, Equals, "someone@example.org/")
+	c.Assert(res[0].Protocol, Equals, "prpl-jabber")
+	c.Assert(res[0].Key.PublicKey().Fingerprint(), DeepEquals, keys[0].PublicKey().Fingerprint())
+}
+
+func (s *LibOTRSuite) Test_Account_LibOTRPrivateKeys_namesTheAccountTheWayTheClientDoes(c *C) {
+	keys, err := otr3.GenerateMissingKeys(nil)
+	c.Assert(err, IsNil)
+	a := &Account{Account: "someone@example.org", PrivateKeys: SerializedKeys(keys)}
+
+	res := a.LibOTRPrivateKeys(ProfanityOTRClient)
+	c.Assert(res, HasLen, 1)
+	c.Assert(res[0].Name, Equals, "someone@example.org")
+	c.Assert(res[0].Protocol, Equals, "xmpp")
+}
+
+func (s *LibOTRSuite) Test_Account_WriteLibOTRFingerprints_marksTrustedFingerprintsAsVerified(c *C) {
+	a := &Account{
+		Account: "someone@example.org",
+		Peers: []*Peer{
+			{UserID: "alice@example.org", Fingerprints: []*Fingerprint{
+				{Fingerprint: []byte{0x01, 0xAB}, Trusted: true},
+				{Fingerprint: []byte{0x02, 0xCD}},
+				{Fingerprint: []byte{}},
+			}},
+			{UserID: "bob@example.org"},
+		},
+	}
+
+	var pidgin, profanity bytes.Buffer
+	c.Assert(a.WriteLibOTRFingerprints(&pidgin, PidginOTRClient), IsNil)
+	c.Assert(a.WriteLibOTRFingerprints(&profanity, ProfanityOTRClient), IsNil)
+
+	c.Assert(pidgin.String(), Equals, ""+
+		"alice@example.org\tsomeone@example.org/\tprpl-jabber\t01ab\tverified\n"+
+		"alice@example.org\tsomeone@example.org/\tprpl-jabber\t02cd\n")
+	c.Assert(profanity.String(), Equals, ""+
+		"alice@example.org\tsomeone@example.org\txmpp\t01ab\tverified\n"+
+		"alice@example.org\tsomeone@example.org\txmpp\t02cd\n")
+}
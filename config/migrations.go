@@ -0,0 +1,113 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrConfigurationFromNewerVersion is returned when the configuration file was written by a newer version of the
+// application. It isn't opened, since saving it again would drop everything this version doesn't know about.
+var ErrConfigurationFromNewerVersion = errors.New("the configuration file was written by a newer version of the application")
+
+// migration is one step in bringing the configuration format up to date. A step has to leave a configuration
+// that is already up to date alone, since files written before there were versions go through all the steps.
+type migration struct {
+	description string
+	apply       func(*ApplicationConfig) bool
+}
+
+// migrations are the steps that bring a configuration up to date, in order. The version of a configuration is the
+// number of steps it has been through, so new steps are only ever added at the end.
+var migrations = []migration{
+	{"move the known fingerprints to the peers", eachAccount((*Account).updateFingerprintsToLatestVersion)},
+	{"remove empty fingerprints", eachAccount((*Account).removeEmptyFingerprints)},
+	{"move the server certificate hash to the certificate pins", eachAccount((*Account).updateCertificatePins)},
+	{"move the deprecated private key to the private keys", eachAccount((*Account).updatePrivateKeys)},
+}
+
+// currentConfigurationVersion is the version of the configuration this version of the application writes
+var currentConfigurationVersion = len(migrations)
+
+func eachAccount(f func(*Account) bool) func(*ApplicationConfig) bool {
+	return func(a *ApplicationConfig) bool {
+		changed := false
+		for _, acc := range a.Accounts {
+			changed = f(acc) || changed
+		}
+		return changed
+	}
+}
+
+// migrate runs the steps the configuration hasn't been through yet, and returns true if any of them changed it
+func (a *ApplicationConfig) migrate() bool {
+	changed := false
+	for v := a.Version; v < currentConfigurationVersion; v++ {
+		if migrations[v].apply(a) {
+			log.WithField("step", migrations[v].description).Info("Updated the configuration")
+			changed = true
+		}
+	}
+
+	changed = changed || a.Version != currentConfigurationVersion
+	a.Version = currentConfigurationVersion
+	return changed
+}
+
+// checkVersion makes sure the configuration that was just read can be used by this version of the application
+func (a *ApplicationConfig) checkVersion() error {
+	if a.Version > currentConfigurationVersion {
+		return ErrConfigurationFromNewerVersion
+	}
+	return nil
+}
+
+// versionBackupName returns the name of the backup made before a configuration file with the given version is changed
+func versionBackupName(name string, version int, t time.Time) string {
+	return fmt.Sprintf("%s.v%d-%s.backup~", name, version, t.UTC().Format("20060102T150405Z"))
+}
+
+func versionBackupsOf(name string) []string {
+	res, _ := filepath.Glob(name + ".v*.backup~")
+	return res
+}
+
+// backUpBeforeMigration keeps the configuration file as it is, before it's changed to the current version
+func (a *ApplicationConfig) backUpBeforeMigration() error {
+	if a.filename == "" || !fileExists(a.filename) {
+		return nil
+	}
+
+	lock, err := lockConfigFile(a.filename, false)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	return linkOrCopy(a.filename, versionBackupName(a.filename, a.Version, time.Now()), 0600)
+}
+
+// updateToLatestVersion runs all the steps on the account on its own, for accounts that come from somewhere
+// other than the configuration file
+func (a *Account) updateToLatestVersion() bool {
+	single := &ApplicationConfig{Accounts: []*Account{a}}
+
+	changed := false
+	for _, m := range migrations {
+		changed = m.apply(single) || changed
+	}
+	return changed
+}
+
+func (a *Account) updatePrivateKeys() bool {
+	if len(a.DeprecatedPrivateKey) == 0 {
+		return false
+	}
+
+	a.PrivateKeys = a.AllPrivateKeys()
+	a.DeprecatedPrivateKey = nil
+	return true
+}
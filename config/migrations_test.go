@@ -0,0 +1,146 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type MigrationsSuite struct{}
+
+var _ = Suite(&MigrationsSuite{})
+
+func configWithAccount(acc *Account) *ApplicationConfig {
+	return &ApplicationConfig{Accounts: []*Account{acc}}
+}
+
+func (s *MigrationsSuite) Test_migrations_areInTheOrderTheyWereAdded(c *C) {
+	var descriptions []string
+	for _, m := range migrations {
+		descriptions = append(descriptions, m.description)
+	}
+
+	c.Assert(descriptions, DeepEquals, []string{
+		"move the known fingerprints to the peers",
+		"remove empty fingerprints",
+		"move the server certificate hash to the certificate pins",
+		"move the deprecated private key to the private keys",
+	})
+	c.Assert(currentConfigurationVersion, Equals, 4)
+}
+
+func (s *MigrationsSuite) Test_migration1_movesKnownFingerprintsToThePeers(c *C) {
+	a := configWithAccount(&Account{
+		LegacyKnownFingerprints: []KnownFingerprint{
+			{UserID: "one@some.org", Fingerprint: []byte{0x01, 0x02}, Untrusted: false},
+		},
+	})
+
+	c.Assert(migrations[0].apply(a), Equals, true)
+	c.Assert(a.Accounts[0].LegacyKnownFingerprints, HasLen, 0)
+	p, ok := a.Accounts[0].GetPeer("one@some.org")
+	c.Assert(ok, Equals, true)
+	c.Assert(p.Fingerprints, DeepEquals, []*Fingerprint{{Fingerprint: []byte{0x01, 0x02}, Trusted: true}})
+
+	c.Assert(migrations[0].apply(a), Equals, false)
+}
+
+func (s *MigrationsSuite) Test_migration2_removesEmptyFingerprints(c *C) {
+	a := configWithAccount(&Account{
+		Peers: []*Peer{{UserID: "one@some.org", Fingerprints: []*Fingerprint{{Fingerprint: []byte{}}, {Fingerprint: []byte{0x01}}}}},
+	})
+
+	c.Assert(migrations[1].apply(a), Equals, true)
+	c.Assert(a.Accounts[0].Peers[0].Fingerprints, DeepEquals, []*Fingerprint{{Fingerprint: []byte{0x01}}})
+
+	c.Assert(migrations[1].apply(a), Equals, false)
+}
+
+func (s *MigrationsSuite) Test_migration3_movesTheServerCertificateHashToThePins(c *C) {
+	a := configWithAccount(&Account{
+		LegacyServerCertificateSHA256: "0102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F20",
+	})
+
+	c.Assert(migrations[2].apply(a), Equals, true)
+	c.Assert(a.Accounts[0].LegacyServerCertificateSHA256, Equals, "")
+	c.Assert(a.Accounts[0].Certificates, HasLen, 1)
+	c.Assert(a.Accounts[0].Certificates[0].FingerprintType, Equals, "SHA256")
+
+	c.Assert(migrations[2].apply(a), Equals, false)
+}
+
+func (s *MigrationsSuite) Test_migration4_movesTheDeprecatedPrivateKeyToThePrivateKeys(c *C) {
+	a := configWithAccount(&Account{
+		PrivateKeys:          [][]byte{{0x01}},
+		DeprecatedPrivateKey: []byte{0x02},
+	})
+
+	c.Assert(migrations[3].apply(a), Equals, true)
+	c.Assert(a.Accounts[0].DeprecatedPrivateKey, IsNil)
+	c.Assert(a.Accounts[0].PrivateKeys, DeepEquals, [][]byte{{0x01}, {0x02}})
+
+	c.Assert(migrations[3].apply(a), Equals, false)
+}
+
+func (s *MigrationsSuite) Test_ApplicationConfig_migrate_onlyRunsTheStepsNotYetMade(c *C) {
+	a := configWithAccount(&Account{
+		Peers:                []*Peer{{UserID: "one@some.org", Fingerprints: []*Fingerprint{{Fingerprint: []byte{}}}}},
+		DeprecatedPrivateKey: []byte{0x02},
+	})
+	a.Version = 2
+
+	c.Assert(a.migrate(), Equals, true)
+	c.Assert(a.Version, Equals, currentConfigurationVersion)
+	c.Assert(a.Accounts[0].Peers[0].Fingerprints, HasLen, 1)
+	c.Assert(a.Accounts[0].PrivateKeys, DeepEquals, [][]byte{{0x02}})
+
+	c.Assert(a.migrate(), Equals, false)
+}
+
+func (s *MigrationsSuite) Test_ApplicationConfig_UpdateToLatestVersion_backsUpTheFileFirst(c *C) {
+	dir := c.MkDir()
+	configFile := filepath.Join(dir, "accounts.json")
+	original := `{"Accounts": [{"Account": "test1@example.com", "PrivateKey": "AQ=="}]}`
+	c.Assert(ioutil.WriteFile(configFile, []byte(original), 0600), IsNil)
+
+	a, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+
+	c.Assert(a.UpdateToLatestVersion(), Equals, true)
+	c.Assert(a.Save(nil), IsNil)
+
+	backups := versionBackupsOf(configFile)
+	c.Assert(backups, HasLen, 1)
+	c.Assert(filepath.Base(backups[0]), Matches, `accounts\.json\.v0-\d{8}T\d{6}Z\.backup~`)
+	content, _ := ioutil.ReadFile(backups[0])
+	c.Assert(string(content), Equals, original)
+
+	again, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(again.Version, Equals, currentConfigurationVersion)
+	c.Assert(again.UpdateToLatestVersion(), Equals, false)
+	c.Assert(versionBackupsOf(configFile), HasLen, 1)
+}
+
+func (s *MigrationsSuite) Test_LoadOrCreate_refusesConfigurationsFromNewerVersions(c *C) {
+	configFile := filepath.Join(c.MkDir(), "accounts.json")
+	c.Assert(ioutil.WriteFile(configFile, []byte(`{"Version": 1000, "Accounts": [{"Account": "test1@example.com"}], "SomethingNew": true}`), 0600), IsNil)
+
+	_, ok, err := LoadOrCreate(configFile, nil)
+	c.Assert(ok, Equals, true)
+	c.Assert(err, Equals, ErrConfigurationFromNewerVersion)
+}
+
+func (s *MigrationsSuite) Test_LoadOrCreate_startsNewConfigurationsInTheCurrentVersion(c *C) {
+	a, _, err := LoadOrCreate(filepath.Join(c.MkDir(), "accounts.json"), nil)
+	c.Assert(err, Equals, errInvalidConfigFile)
+	c.Assert(a.Version, Equals, currentConfigurationVersion)
+	c.Assert(a.UpdateToLatestVersion(), Equals, false)
+}
+
+func (s *MigrationsSuite) Test_versionBackupName(c *C) {
+	t := time.Date(2020, 5, 17, 10, 4, 5, 0, time.UTC)
+	c.Assert(versionBackupName("accounts.json", 3, t), Equals, "accounts.json.v3-20200517T100405Z.backup~")
+}
@@ -42,6 +42,10 @@ type Peer struct {
 
 	Groups       []string `json:",omitempty"`
 	Fingerprints []*Fingerprint
+
+	// HistoryMaxMessages and HistoryMaxAgeDays override the history retention of the account for this peer
+	HistoryMaxMessages int `json:",omitempty"`
+	HistoryMaxAgeDays  int `json:",omitempty"`
 }
 
 // MarshalJSON is used to create a JSON representation of this fingerprint
@@ -81,12 +85,6 @@ func (s ByNaturalOrder) Less(i, j int) bool {
 }
 func (s ByNaturalOrder) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
-func (a *Account) updateToLatestVersion() bool {
-	return a.updateFingerprintsToLatestVersion() ||
-		a.removeEmptyFingerprints() ||
-		a.updateCertificatePins()
-}
-
 func (a *Account) removeEmptyFingerprints() bool {
 	changed := false
 
@@ -224,10 +222,11 @@ func (a *Account) RemoveFingerprint(uid string, fpr []byte) bool {
 
 	newFprs := make([]*Fingerprint, 0, len(p.Fingerprints))
 	for _, f := range p.Fingerprints {
-		if !bytes.Equal(f.Fingerprint, fpr) {
-			newFprs = append(newFprs, f)
+		if bytes.Equal(f.Fingerprint, fpr) {
 			result = true
+			continue
 		}
+		newFprs = append(newFprs, f)
 	}
 	p.Fingerprints = newFprs
 	return result
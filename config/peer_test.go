@@ -142,6 +142,22 @@ func (s *AccountPeerSuite) Test_Account_RemoveFingerprint_thatPeerDoesntHave(c *
 	c.Assert(res, Equals, false)
 }
 
+func (s *AccountPeerSuite) Test_Account_RemoveFingerprint_thatPeerDoesntHaveAmongOthers(c *C) {
+	a := &Account{
+		Peers: []*Peer{
+			{UserID: "one"},
+			{UserID: "four", Fingerprints: []*Fingerprint{
+				{Fingerprint: []byte{0xFF, 0xFE}},
+				{Fingerprint: []byte{0x99, 0xAA}},
+			}},
+			{UserID: "three"},
+		},
+	}
+	res := a.RemoveFingerprint("four", []byte{0x01, 0x02})
+	c.Assert(res, Equals, false)
+	c.Assert(a.Peers[1].Fingerprints, HasLen, 2)
+}
+
 func (s *AccountPeerSuite) Test_Account_RemoveFingerprint_thatPeerDoesHave(c *C) {
 	a := &Account{
 		Peers: []*Peer{
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Named profiles are kept in directories of their own, in this directory of the configuration directory.
+// Every profile has its own configuration file, and the history and other data files are kept next to it.
+// The profile without a name is the configuration directory itself.
+const profilesDirName = "profiles"
+
+const maxProfileNameLength = 64
+
+var (
+	// ErrInvalidProfileName is returned when a profile name can't be used as the name of a directory
+	ErrInvalidProfileName = errors.New("profile names can only have letters, digits, dots, dashes and underscores")
+	// ErrProfileExists is returned when creating or renaming to a profile that already exists
+	ErrProfileExists = errors.New("there is already a profile with that name")
+	// ErrNoSuchProfile is returned when renaming or deleting a profile that doesn't exist
+	ErrNoSuchProfile = errors.New("there is no profile with that name")
+	// ErrProfileInUse is returned when renaming or deleting a profile that another instance is reading or writing
+	ErrProfileInUse = errors.New("the profile is in use by another instance")
+	// ErrConfigFileWithProfile is returned when both a configuration file and a profile are given on the command line
+	ErrConfigFileWithProfile = errors.New("a configuration file and a profile can't be used at the same time")
+)
+
+// ValidProfileName returns true if the name can be used for a profile. The name is used for
+// directories and settings paths, so only a few characters are allowed.
+func ValidProfileName(name string) bool {
+	if name == "" || name == "." || name == ".." || len(name) > maxProfileNameLength {
+		return false
+	}
+
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func profilesDir() string {
+	return filepath.Join(configDir(), profilesDirName)
+}
+
+func profileDir(name string) string {
+	return filepath.Join(profilesDir(), name)
+}
+
+// ProfileExists returns true if the named profile has been created
+func ProfileExists(name string) bool {
+	if !ValidProfileName(name) {
+		return false
+	}
+	st, err := os.Stat(profileDir(name))
+	return err == nil && st.IsDir()
+}
+
+// ProfileConfigFile returns the configuration file of the named profile, creating the profile if it doesn't exist yet.
+// The profile without a name uses the standard configuration file.
+func ProfileConfigFile(name string) (string, error) {
+	if name == "" {
+		return findConfigFile(""), nil
+	}
+
+	if !ValidProfileName(name) {
+		return "", ErrInvalidProfileName
+	}
+
+	return configFileIn(profileDir(name)), nil
+}
+
+// Profiles returns the names of the named profiles, in alphabetical order
+func Profiles() []string {
+	var res []string
+	entries, _ := ioutil.ReadDir(profilesDir())
+	for _, e := range entries {
+		if e.IsDir() && ValidProfileName(e.Name()) {
+			res = append(res, e.Name())
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// CreateProfile creates a new, empty profile
+func CreateProfile(name string) error {
+	if !ValidProfileName(name) {
+		return ErrInvalidProfileName
+	}
+
+	if ProfileExists(name) {
+		return ErrProfileExists
+	}
+
+	ensureDir(profilesDir(), 0700)
+	return os.Mkdir(profileDir(name), 0700)
+}
+
+// lockProfile takes the lock for the configuration file of the profile, so no other instance reads or writes it.
+// It fails if another instance holds the lock, instead of waiting for it.
+func lockProfile(name string) (*fileLock, error) {
+	lock, err := tryLockConfigFile(configFileIn(profileDir(name)))
+	if err == errConfigFileLocked {
+		return nil, ErrProfileInUse
+	}
+	return lock, err
+}
+
+// RenameProfile gives the profile a new name. It fails if another instance is reading or writing the profile.
+func RenameProfile(name, newName string) error {
+	if !ValidProfileName(name) || !ValidProfileName(newName) {
+		return ErrInvalidProfileName
+	}
+
+	if !ProfileExists(name) {
+		return ErrNoSuchProfile
+	}
+
+	if ProfileExists(newName) {
+		return ErrProfileExists
+	}
+
+	lock, err := lockProfile(name)
+	if err != nil {
+		return err
+	}
+	// The lock file is in the directory, and open files keep directories from being renamed on Windows
+	lock.unlock()
+
+	return osRename(profileDir(name), profileDir(newName))
+}
+
+// DeleteProfile removes the profile, with its configuration file, its history and all its other data.
+// It fails if another instance is reading or writing the profile.
+func DeleteProfile(name string) error {
+	if !ValidProfileName(name) {
+		return ErrInvalidProfileName
+	}
+
+	if !ProfileExists(name) {
+		return ErrNoSuchProfile
+	}
+
+	lock, err := lockProfile(name)
+	if err != nil {
+		return err
+	}
+
+	// Everything but the lock file is removed while the lock is held, since open files can't be removed on Windows
+	dir := profileDir(name)
+	entries, _ := ioutil.ReadDir(dir)
+	for _, e := range entries {
+		f := filepath.Join(dir, e.Name())
+		if f == lock.f.Name() {
+			continue
+		}
+		if err := os.RemoveAll(f); err != nil {
+			lock.unlock()
+			return err
+		}
+	}
+	lock.unlock()
+
+	return os.RemoveAll(dir)
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type ProfilesSuite struct {
+	origSystemConfigDir func() string
+	dir                 string
+}
+
+var _ = Suite(&ProfilesSuite{})
+
+func (s *ProfilesSuite) SetUpTest(c *C) {
+	s.origSystemConfigDir = SystemConfigDir
+	s.dir = c.MkDir()
+	SystemConfigDir = func() string { return s.dir }
+}
+
+func (s *ProfilesSuite) TearDownTest(c *C) {
+	SystemConfigDir = s.origSystemConfigDir
+}
+
+func (s *ProfilesSuite) Test_ValidProfileName_onlyAllowsNamesThatAreSafeAsDirectories(c *C) {
+	c.Assert(ValidProfileName("work"), Equals, true)
+	c.Assert(ValidProfileName("Personal_2.old-one"), Equals, true)
+
+	c.Assert(ValidProfileName(""), Equals, false)
+	c.Assert(ValidProfileName("."), Equals, false)
+	c.Assert(ValidProfileName(".."), Equals, false)
+	c.Assert(ValidProfileName("../work"), Equals, false)
+	c.Assert(ValidProfileName("my work"), Equals, false)
+	c.Assert(ValidProfileName("wörk"), Equals, false)
+	c.Assert(ValidProfileName(string(make([]byte, maxProfileNameLength+1))), Equals, false)
+}
+
+func (s *ProfilesSuite) Test_ProfileConfigFile_keepsEveryProfileInItsOwnDirectory(c *C) {
+	f, err := ProfileConfigFile("work")
+	c.Assert(err, IsNil)
+	c.Assert(f, Equals, filepath.Join(s.dir, "decoyim", "profiles", "work", "accounts.json"))
+	c.Assert(ProfileExists("work"), Equals, true)
+
+	f, err = ProfileConfigFile("")
+	c.Assert(err, IsNil)
+	c.Assert(f, Equals, filepath.Join(s.dir, "decoyim", "accounts.json"))
+
+	_, err = ProfileConfigFile("../work")
+	c.Assert(err, Equals, ErrInvalidProfileName)
+}
+
+func (s *ProfilesSuite) Test_ProfileConfigFile_findsTheEncryptedFile(c *C) {
+	c.Assert(CreateProfile("work"), IsNil)
+	a := &ApplicationConfig{filename: filepath.Join(profileDir("work"), "accounts.json.enc")}
+	a.Add(&Account{Account: "someone@example.org"})
+	c.Assert(a.Save(nil), IsNil)
+
+	f, _ := ProfileConfigFile("work")
+	c.Assert(f, Equals, a.filename)
+}
+
+func (s *ProfilesSuite) Test_CreateProfile_RenameProfile_and_DeleteProfile(c *C) {
+	c.Assert(Profiles(), HasLen, 0)
+
+	c.Assert(CreateProfile("work"), IsNil)
+	c.Assert(CreateProfile("personal"), IsNil)
+	c.Assert(CreateProfile("work"), Equals, ErrProfileExists)
+	c.Assert(CreateProfile("a/b"), Equals, ErrInvalidProfileName)
+	c.Assert(Profiles(), DeepEquals, []string{"personal", "work"})
+
+	c.Assert(RenameProfile("work", "personal"), Equals, ErrProfileExists)
+	c.Assert(RenameProfile("nothing", "something"), Equals, ErrNoSuchProfile)
+	c.Assert(RenameProfile("work", "job"), IsNil)
+	c.Assert(Profiles(), DeepEquals, []string{"job", "personal"})
+
+	c.Assert(DeleteProfile("work"), Equals, ErrNoSuchProfile)
+	c.Assert(DeleteProfile(".."), Equals, ErrInvalidProfileName)
+	c.Assert(DeleteProfile("job"), IsNil)
+	c.Assert(Profiles(), DeepEquals, []string{"personal"})
+	c.Assert(fileExists(filepath.Join(s.dir, "decoyim")), Equals, true)
+}
+
+func (s *ProfilesSuite) Test_RenameProfile_and_DeleteProfile_refuseWhileAnotherInstanceHoldsTheLock(c *C) {
+	c.Assert(CreateProfile("work"), IsNil)
+	f, err := ProfileConfigFile("work")
+	c.Assert(err, IsNil)
+	c.Assert(ioutil.WriteFile(f, []byte(twoWritersTestConfig), 0600), IsNil)
+
+	lock, err := lockConfigFile(f, false)
+	c.Assert(err, IsNil)
+
+	c.Assert(RenameProfile("work", "job"), Equals, ErrProfileInUse)
+	c.Assert(DeleteProfile("work"), Equals, ErrProfileInUse)
+	c.Assert(Profiles(), DeepEquals, []string{"work"})
+	c.Assert(fileExists(f), Equals, true)
+
+	lock.unlock()
+	c.Assert(DeleteProfile("work"), IsNil)
+	c.Assert(Profiles(), HasLen, 0)
+}
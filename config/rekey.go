@@ -0,0 +1,243 @@
+package config
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// keyUpgrader is implemented by key suppliers that have keys for new encryption parameters,
+// after being asked for keys for outdated ones
+type keyUpgrader interface {
+	upgradedParameters() (*EncryptionParameters, bool)
+}
+
+// upgradeEncryptionParameters re-encrypts the configuration file and the data files with new
+// encryption parameters, if the current ones are outdated and the key supplier already has keys
+// for new ones. It returns true if anything was upgraded.
+func (a *ApplicationConfig) upgradeEncryptionParameters(ks KeySupplier) (bool, error) {
+	if !a.shouldEncrypt || a.params == nil || !a.params.isOutdated() {
+		return false, nil
+	}
+
+	u, ok := ks.(keyUpgrader)
+	if !ok {
+		return false, nil
+	}
+
+	np, ok := u.upgradedParameters()
+	if !ok {
+		return false, nil
+	}
+
+	return true, a.rekey(np, ks)
+}
+
+// The files a re-key writes next to the originals, before any of the originals is replaced
+const rekeyExtension = ".rekey~"
+
+// The journal lists the files a re-key is replacing. Once it is written, the re-key is finished
+// even if the application stops in the middle of it - on the next start, if needed.
+const rekeyJournalFile = "rekey.journal~"
+
+// rekey changes the encryption parameters of the configuration file and of the data files next to it.
+// The keys derived for other purposes don't change, since they are derived from a secret of their own.
+// Either all the files are changed or none of them are, even if the application stops in the middle of it.
+func (a *ApplicationConfig) rekey(np *EncryptionParameters, ks KeySupplier) error {
+	old := *a.params
+
+	if _, _, ok := ks.GenerateKey(old); !ok {
+		return errNoPasswordSupplied
+	}
+
+	if _, _, ok := ks.GenerateKey(*np); !ok {
+		return errNoPasswordSupplied
+	}
+
+	lock, err := lockConfigFile(a.filename, true)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	var written []string
+	for _, f := range a.encryptedDataFiles() {
+		ok, err := reencryptFile(f, old, np, ks)
+		if err != nil {
+			discardRekey(written)
+			return err
+		}
+		if ok {
+			written = append(written, f)
+		}
+	}
+
+	a.onBeforeSave()
+	a.params = np
+	contents, err := a.serialize()
+	if err == nil {
+		contents, err = a.encrypt(contents, ks)
+	}
+	if err == nil {
+		err = writeAndSync(a.filename+rekeyExtension, contents, 0600)
+	}
+	if err != nil {
+		a.params = &old
+		discardRekey(append(written, a.filename))
+		return err
+	}
+	a.keySupplier = ks
+	written = append(written, a.filename)
+
+	dir := filepath.Dir(a.filename)
+	if err := writeRekeyJournal(dir, written); err != nil {
+		a.params = &old
+		discardRekey(written)
+		return err
+	}
+
+	if err := finishRekey(dir); err != nil {
+		return err
+	}
+
+	a.rememberFileContent(contents)
+	a.rememberSaved()
+	return nil
+}
+
+// encryptedDataFiles returns the encrypted files in the data directory of the profile, not counting the configuration file itself
+func (a *ApplicationConfig) encryptedDataFiles() []string {
+	var res []string
+	dir := a.dataDirectory()
+	entries, _ := ioutil.ReadDir(dir)
+	for _, e := range entries {
+		f := filepath.Join(dir, e.Name())
+		if !e.IsDir() && strings.HasSuffix(e.Name(), encryptedFileEnding) && f != a.filename {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// reencryptFile writes the file encrypted with the new parameters next to it, if it's encrypted with the old ones.
+// It returns true if it did.
+func reencryptFile(f string, old EncryptionParameters, np *EncryptionParameters, ks KeySupplier) (bool, error) {
+	contents, err := ioutil.ReadFile(filepath.Clean(f))
+	if err != nil {
+		return false, err
+	}
+
+	data, err := parseEncryptedData(contents)
+	if err != nil || data.Params.kdfID() != old.kdfID() {
+		return false, nil
+	}
+
+	plain, _, err := decryptConfiguration(contents, ks)
+	if err != nil {
+		return false, err
+	}
+
+	params := *np
+	params.regenerateNonce()
+	contents, err = encryptConfiguration(string(plain), &params, ks)
+	if err != nil {
+		return false, err
+	}
+
+	return true, writeAndSync(f+rekeyExtension, contents, 0600)
+}
+
+func discardRekey(files []string) {
+	for _, f := range files {
+		_ = os.Remove(f + rekeyExtension)
+	}
+}
+
+// writeRekeyJournal is the point after which a re-key can't be undone. The files are
+// listed relative to the directory of the journal, the one of the configuration file.
+var writeRekeyJournal = func(dir string, files []string) error {
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		n, err := filepath.Rel(dir, f)
+		if err != nil {
+			return err
+		}
+		names = append(names, n)
+	}
+
+	contents, err := json.Marshal(names)
+	if err != nil {
+		return err
+	}
+
+	return atomicWrite(filepath.Join(dir, rekeyJournalFile), contents, 0600)
+}
+
+// finishRekey replaces the files in the journal with the ones written next to them. It can be run again
+// if it is interrupted. The backups of the replaced files are removed, since they can still be opened with the old keys.
+func finishRekey(dir string) error {
+	journal := filepath.Join(dir, rekeyJournalFile)
+	contents, err := ioutil.ReadFile(filepath.Clean(journal))
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if err := json.Unmarshal(contents, &names); err != nil {
+		return err
+	}
+
+	for _, n := range names {
+		f := filepath.Join(dir, filepath.Clean(n))
+		if fileExists(f + rekeyExtension) {
+			if err := osRename(f+rekeyExtension, f); err != nil {
+				return err
+			}
+		}
+		removeBackupsOf(f)
+		syncDir(filepath.Dir(f))
+	}
+
+	if err := os.Remove(journal); err != nil {
+		return err
+	}
+	syncDir(dir)
+
+	return nil
+}
+
+// recoverInterruptedRekey is done with the configuration file locked, since another instance might be in the middle of a re-key
+func (a *ApplicationConfig) recoverInterruptedRekey() error {
+	if !fileExists(a.filename) && !fileExists(a.filename+tmpExtension) {
+		// Without a configuration file, no other instance can be using it
+		return recoverInterruptedRekey(filepath.Dir(a.filename))
+	}
+
+	lock, err := lockConfigFile(a.filename, true)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	return recoverInterruptedRekey(filepath.Dir(a.filename))
+}
+
+// recoverInterruptedRekey finishes a re-key that was interrupted after the journal was written,
+// and throws away what an earlier interrupted re-key had written, otherwise
+func recoverInterruptedRekey(dir string) error {
+	if fileExists(filepath.Join(dir, rekeyJournalFile)) {
+		return finishRekey(dir)
+	}
+
+	discardRekeyLeftovers(dir)
+	return nil
+}
+
+func discardRekeyLeftovers(dir string) {
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "*"+rekeyExtension))
+	for _, f := range leftovers {
+		_ = os.Remove(f)
+	}
+}
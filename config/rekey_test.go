@@ -0,0 +1,105 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type RekeySuite struct{}
+
+var _ = Suite(&RekeySuite{})
+
+const rekeyTestConfig = `{"Accounts": [{"Account": "test1@example.com"}]}`
+
+func writeEncryptedTestFile(c *C, f, content string, params EncryptionParameters) {
+	ks := FunctionKeySupplier(func(p EncryptionParameters, _ bool) ([]byte, []byte, bool) {
+		key, macKey := GenerateKeys(testPassword, p)
+		return key, macKey, true
+	})
+	params.regenerateNonce()
+	contents, err := encryptConfiguration(content, &params, ks)
+	c.Assert(err, IsNil)
+	c.Assert(ioutil.WriteFile(f, contents, 0600), IsNil)
+}
+
+func (s *RekeySuite) Test_LoadOrCreate_upgradesOutdatedEncryptionParameters(c *C) {
+	defer cheapArgon2()()
+
+	dir := c.MkDir()
+	configFile := filepath.Join(dir, "accounts.json.enc")
+	dataFile := filepath.Join(dir, "outbox.json.enc")
+	otherFile := filepath.Join(dir, "other.json.enc")
+
+	old := cheapScryptParameters()
+	writeEncryptedTestFile(c, configFile, rekeyTestConfig, old)
+	writeEncryptedTestFile(c, dataFile, "some important data", old)
+	writeEncryptedTestFile(c, otherFile, "encrypted with something else", cheapScryptParameters())
+
+	asked := 0
+	ks := PasswordKeySupplier(func(bool) (string, bool) {
+		asked++
+		return testPassword, true
+	})
+
+	a, ok, err := LoadOrCreate(configFile, ks)
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(a.Accounts, HasLen, 1)
+	c.Assert(a.params.Version, Equals, currentEncryptionParametersVersion)
+
+	contents, _ := ioutil.ReadFile(configFile)
+	ed, err := ownSlotIn(contents, a)
+	c.Assert(err, IsNil)
+	c.Assert(ed.Params.Version, Equals, currentEncryptionParametersVersion)
+
+	contents, _ = ioutil.ReadFile(dataFile)
+	ed, _ = parseEncryptedData(contents)
+	c.Assert(ed.Params.kdfID(), Equals, a.params.kdfID())
+
+	contents, _ = ioutil.ReadFile(otherFile)
+	ed, _ = parseEncryptedData(contents)
+	c.Assert(ed.Params.Version, Equals, 0)
+
+	data, err := a.DataFile("outbox.json").Load()
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "some important data")
+
+	k, err := a.DeriveKey("history")
+	c.Assert(err, IsNil)
+	c.Assert(asked, Equals, 1)
+	oldKey, oldMacKey := GenerateKeys(testPassword, old)
+	c.Assert(k, Not(DeepEquals), deriveKey(append(oldKey, oldMacKey...), "history"))
+
+	a2, ok, err := LoadOrCreate(configFile, PasswordKeySupplier(func(bool) (string, bool) {
+		return testPassword, true
+	}))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	k2, _ := a2.DeriveKey("history")
+	c.Assert(k2, DeepEquals, k)
+}
+
+func (s *RekeySuite) Test_LoadOrCreate_keepsOutdatedParametersWithOtherKeySuppliers(c *C) {
+	dir := c.MkDir()
+	configFile := filepath.Join(dir, "accounts.json.enc")
+
+	old := cheapScryptParameters()
+	writeEncryptedTestFile(c, configFile, rekeyTestConfig, old)
+
+	a, ok, err := LoadOrCreate(configFile, CachingKeySupplier(func(p EncryptionParameters, _ bool) ([]byte, []byte, bool) {
+		key, macKey := GenerateKeys(testPassword, p)
+		return key, macKey, true
+	}))
+	c.Assert(ok, Equals, true)
+	c.Assert(err, IsNil)
+	c.Assert(a.params.isOutdated(), Equals, true)
+	c.Assert(a.KeyDerivationSecret, Equals, "")
+}
+
+func (s *RekeySuite) Test_ApplicationConfig_turnOffEncryption_forgetsTheKeyDerivationSecret(c *C) {
+	a := &ApplicationConfig{filename: filepath.Join(c.MkDir(), "accounts.json.enc"), shouldEncrypt: true, KeyDerivationSecret: "0102"}
+	c.Assert(a.turnOffEncryption(), Equals, true)
+	c.Assert(a.KeyDerivationSecret, Equals, "")
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"errors"
+	"net"
+	"net/url"
+
+	ournet "github.com/chadsec1/decoyim/net"
+	"golang.org/x/net/proxy"
+)
+
+var (
+	// ErrDNSWouldLeak is returned when the account should fail closed, but connecting would make DNS queries outside of its proxies
+	ErrDNSWouldLeak = errors.New("connecting would make DNS queries outside of the proxies")
+
+	// ErrTorResolverNeedsTorProxy is returned when the Tor resolver is chosen but the proxy that connects to the server,
+	// the first one in the list of proxies, is not a SOCKS proxy
+	ErrTorResolverNeedsTorProxy = errors.New("the Tor resolver needs the first proxy in the list, which connects to the server, to be a Tor SOCKS proxy")
+
+	// ErrDoHResolverNeedsURL is returned when the DNS-over-HTTPS resolver is chosen without an endpoint
+	ErrDoHResolverNeedsURL = errors.New("the DNS-over-HTTPS resolver needs the URL of an endpoint")
+)
+
+// CreateResolver returns the DNS resolver chosen for this account, making its queries through the given proxy dialer when possible
+func (a *Account) CreateResolver(dialer proxy.Dialer) (ournet.Resolver, error) {
+	if a.DNSFailClosed || a.IsTorOnly() {
+		if err := a.checkProxiesDontLeakDNS(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch a.DNSResolver {
+	case "", "tcp":
+		return &ournet.TCPResolver{Dialer: dialer, Server: a.DNSResolverAddress}, nil
+	case "doh":
+		if a.DNSResolverAddress == "" {
+			return nil, ErrDoHResolverNeedsURL
+		}
+		return &ournet.DoHResolver{Dialer: dialer, URL: a.DNSResolverAddress}, nil
+	case "tor":
+		return torResolverFor(a.proxiesWithTorIsolation(), a.IsTorOnly())
+	case "system":
+		// The system resolver doesn't know about our proxies
+		if a.IsTorOnly() || (a.DNSFailClosed && len(a.Proxies) > 0) {
+			return nil, ErrDNSWouldLeak
+		}
+		return &ournet.SystemResolver{}, nil
+	}
+
+	return nil, errors.New("unknown DNS resolver: " + a.DNSResolver)
+}
+
+// checkProxiesDontLeakDNS makes sure we won't have to resolve the address of the first proxy in the chain outside of it
+func (a *Account) checkProxiesDontLeakDNS() error {
+	if len(a.Proxies) == 0 {
+		return nil
+	}
+
+	u, err := url.Parse(a.Proxies[len(a.Proxies)-1])
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme == "socks5+unix" || (u.Scheme == "tor-auto" && u.Host == "") {
+		return nil
+	}
+
+	host := u.Hostname()
+	if host == "localhost" || net.ParseIP(host) != nil {
+		return nil
+	}
+
+	return ErrDNSWouldLeak
+}
+
+func torResolverFor(proxies []string, torOnly bool) (ournet.Resolver, error) {
+	if len(proxies) == 0 {
+		return nil, ErrTorResolverNeedsTorProxy
+	}
+
+	u, err := url.Parse(proxies[0])
+	if err != nil {
+		return nil, errors.New("Failed to parse " + proxies[0] + " as a URL: " + err.Error())
+	}
+
+	// The proxies after the first one in the list are used to reach it
+	forward, err := buildProxyChain(proxies[1:], torOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ournet.TorResolver{
+		Network: "tcp",
+		Address: u.Host,
+		Auth:    genTorAutoAuth(u),
+		Forward: forward,
+	}
+
+	switch u.Scheme {
+	case "tor-auto":
+		r.Address = genTorAutoAddr(u)
+		if r.Address == "" {
+			return nil, ErrTorNotRunning
+		}
+	case "socks5":
+		if u.User == nil {
+			r.Auth = nil
+		}
+	case "socks5+unix":
+		r.Network = "unix"
+		r.Address = u.Path
+		if u.User == nil {
+			r.Auth = nil
+		}
+	default:
+		return nil, ErrTorResolverNeedsTorProxy
+	}
+
+	return r, nil
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	ournet "github.com/chadsec1/decoyim/net"
+	. "gopkg.in/check.v1"
+)
+
+type ResolverSuite struct{}
+
+var _ = Suite(&ResolverSuite{})
+
+func (s *ResolverSuite) Test_Account_CreateResolver_usesDNSOverTCPByDefault(c *C) {
+	a := &Account{DNSResolverAddress: "192.0.2.53:53"}
+
+	r, e := a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+	c.Assert(r, DeepEquals, &ournet.TCPResolver{Server: "192.0.2.53:53"})
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_createsADoHResolver(c *C) {
+	a := &Account{DNSResolver: "doh", DNSResolverAddress: "https://dns.example.org/dns-query"}
+
+	r, e := a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+	c.Assert(r, DeepEquals, &ournet.DoHResolver{URL: "https://dns.example.org/dns-query"})
+
+	a.DNSResolverAddress = ""
+	_, e = a.CreateResolver(nil)
+	c.Assert(e, Equals, ErrDoHResolverNeedsURL)
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_createsATorResolverForTheLastProxy(c *C) {
+	a := &Account{DNSResolver: "tor", Proxies: []string{"socks5://u:p@127.0.0.1:9050"}}
+
+	r, e := a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+	tr := r.(*ournet.TorResolver)
+	c.Assert(tr.Network, Equals, "tcp")
+	c.Assert(tr.Address, Equals, "127.0.0.1:9050")
+	c.Assert(tr.Auth.User, Equals, "u")
+	c.Assert(tr.Auth.Password, Equals, "p")
+	c.Assert(tr.Forward, IsNil)
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_createsATorResolverForTorAuto(c *C) {
+	origTornetAddress := tornetAddress
+	defer func() {
+		tornetAddress = origTornetAddress
+	}()
+	tornetAddress = func() string { return "127.0.0.1:9150" }
+
+	a := &Account{DNSResolver: "tor", Proxies: []string{"tor-auto://"}}
+
+	r, e := a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+	c.Assert(r.(*ournet.TorResolver).Address, Equals, "127.0.0.1:9150")
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_failsForTorWithoutASOCKSProxy(c *C) {
+	_, e := (&Account{DNSResolver: "tor"}).CreateResolver(nil)
+	c.Assert(e, Equals, ErrTorResolverNeedsTorProxy)
+
+	_, e = (&Account{DNSResolver: "tor", Proxies: []string{"http://127.0.0.1:8080"}}).CreateResolver(nil)
+	c.Assert(e, Equals, ErrTorResolverNeedsTorProxy)
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_usesTheFirstProxyInTheListForTor(c *C) {
+	a := &Account{DNSResolver: "tor", Proxies: []string{"socks5://127.0.0.1:9050", "http://127.0.0.1:8080"}}
+	r, e := a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+	c.Assert(r.(*ournet.TorResolver).Address, Equals, "127.0.0.1:9050")
+	c.Assert(r.(*ournet.TorResolver).Forward, NotNil)
+
+	a.Proxies = []string{"http://127.0.0.1:8080", "socks5://127.0.0.1:9050"}
+	_, e = a.CreateResolver(nil)
+	c.Assert(e, Equals, ErrTorResolverNeedsTorProxy)
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_failsClosedForTheSystemResolverWithProxies(c *C) {
+	a := &Account{DNSResolver: "system"}
+	r, e := a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+	c.Assert(r, DeepEquals, &ournet.SystemResolver{})
+
+	a.Proxies = []string{"socks5://127.0.0.1:9050"}
+	_, e = a.CreateResolver(nil)
+	c.Assert(e, IsNil)
+
+	a.DNSFailClosed = true
+	_, e = a.CreateResolver(nil)
+	c.Assert(e, Equals, ErrDNSWouldLeak)
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_failsClosedIfTheFirstProxyNeedsALookup(c *C) {
+	a := &Account{DNSFailClosed: true, Proxies: []string{"socks5://proxy.example.org:1080"}}
+	_, e := a.CreateResolver(nil)
+	c.Assert(e, Equals, ErrDNSWouldLeak)
+
+	for _, px := range []string{"socks5://localhost:9050", "socks5://[::1]:9050", "tor-auto://", "socks5+unix:///tmp/tor.sock"} {
+		a.Proxies = []string{"socks5://proxy.example.org:1080", px}
+		_, e = a.CreateResolver(nil)
+		c.Assert(e, IsNil)
+	}
+}
+
+func (s *ResolverSuite) Test_Account_CreateResolver_failsForUnknownResolvers(c *C) {
+	_, e := (&Account{DNSResolver: "carrier-pigeon"}).CreateResolver(nil)
+	c.Assert(e, ErrorMatches, "unknown DNS resolver: carrier-pigeon")
+}
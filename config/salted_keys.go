@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/chadsec1/decoyim/sasl"
+)
+
+// SaltedKeys contains the keys derived from the account password for one SCRAM mechanism.
+// They can be used to authenticate instead of the password.
+type SaltedKeys struct {
+	Mechanism  string
+	Salt       []byte
+	Iterations int
+	ClientKey  []byte
+	ServerKey  []byte
+}
+
+// SaltedKeysFor returns the salted keys stored for the given mechanism, if any
+func (a *Account) SaltedKeysFor(mechanism string) (sasl.SaltedKeys, bool) {
+	for _, k := range a.SaltedKeys {
+		if k.Mechanism == mechanism {
+			return sasl.SaltedKeys{
+				Salt:       k.Salt,
+				Iterations: k.Iterations,
+				ClientKey:  k.ClientKey,
+				ServerKey:  k.ServerKey,
+			}, true
+		}
+	}
+
+	return sasl.SaltedKeys{}, false
+}
+
+// SaveSaltedKeys stores the salted keys for the given mechanism, replacing any existing ones.
+// Since the keys can be used instead of the password, the plaintext password is forgotten.
+func (a *Account) SaveSaltedKeys(mechanism string, keys sasl.SaltedKeys) {
+	sk := &SaltedKeys{
+		Mechanism:  mechanism,
+		Salt:       keys.Salt,
+		Iterations: keys.Iterations,
+		ClientKey:  keys.ClientKey,
+		ServerKey:  keys.ServerKey,
+	}
+
+	a.Password = ""
+
+	for ix, k := range a.SaltedKeys {
+		if k.Mechanism == mechanism {
+			a.SaltedKeys[ix] = sk
+			return
+		}
+	}
+
+	a.SaltedKeys = append(a.SaltedKeys, sk)
+}
+
+// HasSaltedKeys returns true if this account has salted keys that might be used instead of the password
+func (a *Account) HasSaltedKeys() bool {
+	return a.StoreSaltedKeys && len(a.SaltedKeys) > 0
+}
+
+// ClearSaltedKeys removes all salted keys, for example after the password was changed
+func (a *Account) ClearSaltedKeys() {
+	a.SaltedKeys = nil
+}
+
+// MarshalJSON is used to create a JSON representation of this account. The password is left out when the account
+// keeps salted keys instead, so it never reaches the configuration file or its backups.
+func (a *Account) MarshalJSON() ([]byte, error) {
+	type serializedAccount Account
+	res := serializedAccount(*a)
+	if a.StoreSaltedKeys {
+		res.Password = ""
+	}
+	return json.Marshal(&res)
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/chadsec1/decoyim/sasl"
+
+	. "gopkg.in/check.v1"
+)
+
+type SaltedKeysSuite struct{}
+
+var _ = Suite(&SaltedKeysSuite{})
+
+func (s *SaltedKeysSuite) Test_Account_SaltedKeysFor_returnsFalseWhenNotFound(c *C) {
+	a := &Account{
+		SaltedKeys: []*SaltedKeys{{Mechanism: "SCRAM-SHA-1"}},
+	}
+
+	_, ok := a.SaltedKeysFor("SCRAM-SHA-256")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *SaltedKeysSuite) Test_Account_SaveSaltedKeys_addsKeysAndForgetsThePassword(c *C) {
+	a := &Account{Password: "secret"}
+	keys := sasl.SaltedKeys{
+		Salt:       []byte{0x01, 0x02},
+		Iterations: 4096,
+		ClientKey:  []byte{0x03},
+		ServerKey:  []byte{0x04},
+	}
+
+	a.SaveSaltedKeys("SCRAM-SHA-256", keys)
+
+	c.Assert(a.Password, Equals, "")
+	res, ok := a.SaltedKeysFor("SCRAM-SHA-256")
+	c.Assert(ok, Equals, true)
+	c.Assert(res, DeepEquals, keys)
+}
+
+func (s *SaltedKeysSuite) Test_Account_SaveSaltedKeys_replacesKeysForTheSameMechanism(c *C) {
+	a := &Account{
+		SaltedKeys: []*SaltedKeys{
+			{Mechanism: "SCRAM-SHA-1", Iterations: 1},
+			{Mechanism: "SCRAM-SHA-256", Iterations: 2},
+		},
+	}
+
+	a.SaveSaltedKeys("SCRAM-SHA-256", sasl.SaltedKeys{Iterations: 3})
+
+	c.Assert(a.SaltedKeys, HasLen, 2)
+	c.Assert(a.SaltedKeys[0].Iterations, Equals, 1)
+	c.Assert(a.SaltedKeys[1].Iterations, Equals, 3)
+}
+
+func (s *SaltedKeysSuite) Test_Account_HasSaltedKeys(c *C) {
+	a := &Account{SaltedKeys: []*SaltedKeys{{Mechanism: "SCRAM-SHA-1"}}}
+	c.Assert(a.HasSaltedKeys(), Equals, false)
+
+	a.StoreSaltedKeys = true
+	c.Assert(a.HasSaltedKeys(), Equals, true)
+
+	a.ClearSaltedKeys()
+	c.Assert(a.HasSaltedKeys(), Equals, false)
+}
+
+func (s *SaltedKeysSuite) Test_ApplicationConfig_Save_neverWritesThePasswordOfAccountsWithSaltedKeys(c *C) {
+	configFile := filepath.Join(c.MkDir(), "accounts.json")
+	a := &ApplicationConfig{filename: configFile}
+	a.Add(&Account{Account: "test1@example.com", Password: "very secret password", StoreSaltedKeys: true})
+	a.Add(&Account{Account: "test2@example.com", Password: "the other password"})
+
+	c.Assert(a.Save(nil), IsNil)
+	a.Accounts[0].SaveSaltedKeys("SCRAM-SHA-1", sasl.SaltedKeys{Iterations: 4096})
+	c.Assert(a.Save(nil), IsNil)
+
+	for _, f := range append([]string{configFile}, backupsOf(configFile)...) {
+		content, err := ioutil.ReadFile(f)
+		c.Assert(err, IsNil)
+		c.Assert(strings.Contains(string(content), "very secret password"), Equals, false)
+		c.Assert(strings.Contains(string(content), "the other password"), Equals, true)
+	}
+
+	b, _, err := LoadOrCreate(configFile, nil)
+	c.Assert(err, IsNil)
+	c.Assert(b.Accounts[0].Password, Equals, "")
+	c.Assert(b.Accounts[0].SaltedKeys, HasLen, 1)
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// torIsolationLock guards the isolation usernames of all accounts, since they are read
+// by the connections of the accounts while the user can renew them
+var torIsolationLock = sync.Mutex{}
+
+// TorIsolationUsername returns the SOCKS username used for the automatic Tor proxies of this account.
+// Tor puts streams with different SOCKS credentials on different circuits, so until the isolation is
+// renewed the connections of this account will not share circuits with any other account.
+func (a *Account) TorIsolationUsername() string {
+	torIsolationLock.Lock()
+	defer torIsolationLock.Unlock()
+
+	if len(a.torIsolation) == 0 {
+		a.torIsolation = genTorAutoUsername()
+	}
+
+	return a.torIsolation
+}
+
+// RenewTorIsolation makes the following connections of this account use new circuits
+func (a *Account) RenewTorIsolation() {
+	torIsolationLock.Lock()
+	defer torIsolationLock.Unlock()
+
+	a.torIsolation = genTorAutoUsername()
+}
+
+// proxiesWithTorIsolation returns the proxies of the account, where automatic Tor proxies without
+// credentials get the isolation credentials of the account
+func (a *Account) proxiesWithTorIsolation() []string {
+	result := make([]string, 0, len(a.Proxies))
+	for _, px := range a.Proxies {
+		if strings.HasPrefix(px, "tor-auto://") {
+			if u, err := url.Parse(px); err == nil && u.User == nil {
+				username := a.TorIsolationUsername()
+				u.User = url.UserPassword(username, username)
+				px = u.String()
+			}
+		}
+		result = append(result, px)
+	}
+
+	return result
+}
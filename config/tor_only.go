@@ -0,0 +1,60 @@
+package config
+
+import (
+	"errors"
+	"net/url"
+
+	ournet "github.com/chadsec1/decoyim/net"
+	"golang.org/x/net/proxy"
+)
+
+// ErrTorOnlyNeedsLocalProxy is returned when only connections through Tor are allowed, but the account doesn't
+// connect through a Tor proxy on the local machine
+var ErrTorOnlyNeedsLocalProxy = errors.New("only connections through Tor are allowed, but the account doesn't connect through a local Tor proxy")
+
+// IsTorOnly returns true if this account can only connect through Tor, either because of its own setting
+// or because that is enforced for all connections
+func (a *Account) IsTorOnly() bool {
+	return a.TorOnly || ournet.Dialers.TorOnly()
+}
+
+// checkTorOnly makes sure that the first proxy in the chain is a SOCKS proxy on the local machine, when
+// connections have to go through Tor
+func (a *Account) checkTorOnly() error {
+	if !a.IsTorOnly() {
+		return nil
+	}
+
+	if len(a.Proxies) == 0 {
+		return ErrTorOnlyNeedsLocalProxy
+	}
+
+	u, err := url.Parse(a.Proxies[len(a.Proxies)-1])
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "socks5+unix":
+		return nil
+	case "tor-auto":
+		if u.Host == "" || ournet.IsLocalHost(u.Hostname()) {
+			return nil
+		}
+	case "socks5":
+		if ournet.IsLocalHost(u.Hostname()) {
+			return nil
+		}
+	}
+
+	return ErrTorOnlyNeedsLocalProxy
+}
+
+// proxyChain returns the dialer going through the proxies of this account
+func (a *Account) proxyChain() (proxy.Dialer, error) {
+	if err := a.checkTorOnly(); err != nil {
+		return nil, err
+	}
+
+	return buildProxyChain(a.proxiesWithTorIsolation(), a.IsTorOnly())
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	ournet "github.com/chadsec1/decoyim/net"
+	. "gopkg.in/check.v1"
+)
+
+type TorOnlySuite struct{}
+
+var _ = Suite(&TorOnlySuite{})
+
+func (s *TorOnlySuite) Test_Account_checkTorOnly_acceptsLocalTorProxies(c *C) {
+	for _, px := range []string{
+		"tor-auto://",
+		"tor-auto://127.0.0.1:9150",
+		"socks5://localhost:9050",
+		"socks5://[::1]:9050",
+		"socks5+unix:///var/run/tor/socks",
+	} {
+		a := &Account{TorOnly: true, Proxies: []string{"socks5://remote.example.org:1080", px}}
+		c.Check(a.checkTorOnly(), IsNil, Commentf("proxy %s", px))
+	}
+}
+
+func (s *TorOnlySuite) Test_Account_checkTorOnly_refusesConnectionsThatCouldBypassTor(c *C) {
+	for _, proxies := range [][]string{
+		nil,
+		{"socks5://tor.example.org:9050"},
+		{"http://127.0.0.1:8080"},
+		{"tor-auto://10.0.0.1:9050"},
+	} {
+		a := &Account{TorOnly: true, Proxies: proxies}
+		c.Check(a.checkTorOnly(), Equals, ErrTorOnlyNeedsLocalProxy, Commentf("proxies %v", proxies))
+	}
+}
+
+func (s *TorOnlySuite) Test_Account_checkTorOnly_followsTheGlobalSetting(c *C) {
+	a := &Account{}
+	c.Check(a.checkTorOnly(), IsNil)
+
+	ournet.Dialers.SetTorOnly(true)
+	defer ournet.Dialers.SetTorOnly(false)
+
+	c.Check(a.IsTorOnly(), Equals, true)
+	c.Check(a.checkTorOnly(), Equals, ErrTorOnlyNeedsLocalProxy)
+}
+
+func (s *TorOnlySuite) Test_Account_CreateTorProxy_refusesToConnectDirectlyWhenTorOnly(c *C) {
+	a := &Account{TorOnly: true}
+
+	_, err := a.CreateTorProxy()
+	c.Check(err, Equals, ErrTorOnlyNeedsLocalProxy)
+}
+
+func (s *TorOnlySuite) Test_Account_CreateResolver_refusesTheSystemResolverWhenTorOnly(c *C) {
+	a := &Account{TorOnly: true, DNSResolver: "system", Proxies: []string{"socks5://127.0.0.1:9050"}}
+
+	_, err := a.CreateResolver(nil)
+	c.Check(err, Equals, ErrDNSWouldLeak)
+}
+
+func (s *TorOnlySuite) Test_buildProxyChain_refusesToReachARemoteFirstProxyWhenTorOnly(c *C) {
+	chain, err := buildProxyChain([]string{"socks5://192.0.2.1:1080"}, true)
+	c.Assert(err, IsNil)
+
+	_, err = chain.Dial("tcp", "jabber.example.org:5222")
+	c.Check(err, ErrorMatches, ".*"+ournet.ErrWouldBypassTor.Error())
+}
@@ -74,4 +74,6 @@ func torAutoProxy(u *url.URL, d proxy.Dialer) (proxy.Dialer, error) {
 func init() {
 	proxy.RegisterDialerType("socks5+unix", socks5UnixProxy)
 	proxy.RegisterDialerType("tor-auto", torAutoProxy)
+	proxy.RegisterDialerType("http", ournet.HTTPConnect)
+	proxy.RegisterDialerType("https", ournet.HTTPConnect)
 }
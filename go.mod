@@ -18,6 +18,7 @@ require (
 	github.com/xdg/stringprep v1.0.3
 	golang.org/x/crypto v0.5.0
 	golang.org/x/net v0.5.0
+	golang.org/x/sys v0.4.0
 	golang.org/x/text v0.6.0
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c
 	howett.net/plist v1.0.0
@@ -35,7 +36,6 @@ require (
 	github.com/stretchr/objx v0.5.0 // indirect
 	golang.org/x/lint v0.0.0-20210508222113-6edffad5e616 // indirect
 	golang.org/x/mod v0.7.0 // indirect
-	golang.org/x/sys v0.4.0 // indirect
 	golang.org/x/tools v0.5.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -3,12 +3,14 @@ package gui
 import (
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/chadsec1/decoyim/config"
 	"github.com/chadsec1/decoyim/decoylog"
 	"github.com/chadsec1/decoyim/i18n"
+	ournet "github.com/chadsec1/decoyim/net"
 	"github.com/chadsec1/decoyim/session/access"
 	"github.com/chadsec1/decoyim/session/events"
 	"github.com/chadsec1/decoyim/xmpp/interfaces"
@@ -22,6 +24,9 @@ type account struct {
 	currentNotification gtki.InfoBar
 	xmlConsole          gtki.Dialog
 
+	// reconnectingNotification tells when the next attempt to reconnect is, while it is shown
+	reconnectingNotification gtki.InfoBar
+
 	// c contains all conversations. the ones indexed with a "resourced" JID will be locked to that view
 	// everything else will be indexed with a bare jid
 	c map[string]conversationView
@@ -38,6 +43,10 @@ type account struct {
 	askingForPassword bool
 	cachedPassword    string
 
+	// torFailures counts the failed connection attempts over Tor since the last new identity
+	torFailures     int
+	torFailuresLock sync.Mutex
+
 	log decoylog.Logger
 
 	events chan interface{}
@@ -213,19 +222,25 @@ func (account *account) runSessionObserver(u *gtkUI) {
 		case events.Event:
 			switch t.Type {
 			case events.Connected, events.Disconnected, events.Connecting:
-				doInUIThread(func() {
-					account.sessionObserverLock.RLock()
-					defer account.sessionObserverLock.RUnlock()
-					u.updateGlobalMenuStatus()
-					for _, ff := range account.connectionEventHandlers {
-						ff()
-					}
-				})
+				account.runConnectionEventHandlers(u)
 			}
+		case events.Reconnect:
+			account.runConnectionEventHandlers(u)
 		}
 	}
 }
 
+func (account *account) runConnectionEventHandlers(u *gtkUI) {
+	doInUIThread(func() {
+		account.sessionObserverLock.RLock()
+		defer account.sessionObserverLock.RUnlock()
+		u.updateGlobalMenuStatus()
+		for _, ff := range account.connectionEventHandlers {
+			ff()
+		}
+	})
+}
+
 func (account *account) observeConnectionEvents(u *gtkUI, f func()) {
 	account.sessionObserverLock.Lock()
 	defer account.sessionObserverLock.Unlock()
@@ -274,6 +289,26 @@ func (account *account) createDisconnectItem(u *gtkUI) gtki.MenuItem {
 	return disconnectItem
 }
 
+func (account *account) createReconnectNowItem(u *gtkUI) gtki.MenuItem {
+	reconnectNowItem, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("Reconnect _now"))
+	_ = reconnectNowItem.Connect("activate", account.session.ReconnectNow)
+	reconnectNowItem.SetSensitive(account.session.IsReconnecting())
+	account.observeConnectionEvents(u, func() {
+		reconnectNowItem.SetSensitive(account.session.IsReconnecting())
+	})
+	return reconnectNowItem
+}
+
+func (account *account) createStopReconnectingItem(u *gtkUI) gtki.MenuItem {
+	stopReconnectingItem, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("_Stop trying to reconnect"))
+	_ = stopReconnectingItem.Connect("activate", account.session.StopReconnecting)
+	stopReconnectingItem.SetSensitive(account.session.IsReconnecting())
+	account.observeConnectionEvents(u, func() {
+		stopReconnectingItem.SetSensitive(account.session.IsReconnecting())
+	})
+	return stopReconnectingItem
+}
+
 func (account *account) createSeparatorItem() gtki.MenuItem {
 	sep, _ := g.gtk.SeparatorMenuItemNew()
 	return sep
@@ -302,6 +337,12 @@ func (account *account) createChangePasswordItem(u *gtkUI) gtki.MenuItem {
 	return changePasswordItem
 }
 
+func (account *account) createExportItem() gtki.MenuItem {
+	exportItem, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("E_xport..."))
+	_ = exportItem.Connect("activate", account.export)
+	return exportItem
+}
+
 func (account *account) createRemoveItem() gtki.MenuItem {
 	removeItem, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("_Remove"))
 	_ = removeItem.Connect("activate", account.remove)
@@ -348,13 +389,19 @@ func (account *account) createSubmenu(u *gtkUI) gtki.Menu {
 
 	m.Append(account.createConnectItem(u))
 	m.Append(account.createDisconnectItem(u))
+	m.Append(account.createReconnectNowItem(u))
+	m.Append(account.createStopReconnectingItem(u))
 	m.Append(account.createCheckConnectionItem(u))
 	m.Append(account.createSeparatorItem())
 	m.Append(account.createConnectionItem(u))
 	m.Append(account.createEditItem())
 	m.Append(account.createChangePasswordItem(u))
+	m.Append(account.createExportItem())
 	m.Append(account.createRemoveItem())
 	m.Append(account.createSeparatorItem())
+	m.Append(account.createExportServerDataItem(u))
+	m.Append(account.createImportServerDataItem(u))
+	m.Append(account.createSeparatorItem())
 	m.Append(account.createConnectAutomaticallyItem())
 	m.Append(account.createAlwaysEncryptItem())
 
@@ -398,6 +445,10 @@ func (account *account) connectionInfo() {
 	account.executeCmd(connectionInfoCmd{account})
 }
 
+func (account *account) export() {
+	account.executeCmd(exportAccountCmd{account})
+}
+
 func (account *account) remove() {
 	account.executeCmd(removeAccountCmd{account})
 }
@@ -445,6 +496,22 @@ func (account *account) buildConnectionFailureNotification(moreInfo func()) gtki
 	return account.buildNotification("ConnectionFailureNotification", i18n.Localf("Connection failure\n%s", account.Account()), moreInfo)
 }
 
+func (account *account) buildTorBootstrappingNotification(st *ournet.TorBootstrapStatus) gtki.InfoBar {
+	return account.buildNotification("ConnectingAccountInfo", i18n.Localf("Waiting for Tor to start (%d%%)\n%s", st.Progress, st.Summary), nil)
+}
+
+func (account *account) buildReconnectingNotification(ev events.Reconnect) gtki.InfoBar {
+	seconds := int(time.Until(ev.NextAttempt).Seconds())
+	if seconds < 0 {
+		seconds = 0
+	}
+	return account.buildNotification("ConnectingAccountInfo", i18n.Localf("Reconnecting in %d seconds (attempt %d)\n%s", seconds, ev.Attempt, account.Account()), nil)
+}
+
+func (account *account) buildTorIsolationUnconfirmedNotification(moreInfo func()) gtki.InfoBar {
+	return account.buildNotification("ConnectionFailureNotification", i18n.Localf("Couldn't confirm Tor isolation\n%s", account.Account()), moreInfo)
+}
+
 func (account *account) buildTorNotRunningNotification(moreInfo func()) gtki.InfoBar {
 	return account.buildNotification("TorNotRunningNotification", i18n.Local("Tor is not currently running"), moreInfo)
 }
@@ -465,6 +532,16 @@ func (account *account) removeCurrentNotificationIf(ib gtki.InfoBar) {
 	}
 }
 
+// removeReconnectingNotification removes the notification about the next attempt to reconnect, if it is still shown
+func (account *account) removeReconnectingNotification() {
+	if account.reconnectingNotification == nil {
+		return
+	}
+
+	account.removeCurrentNotificationIf(account.reconnectingNotification)
+	account.reconnectingNotification = nil
+}
+
 func (account *account) setCurrentNotification(ib gtki.InfoBar, notificationArea gtki.Box) {
 	account.Lock()
 	defer account.Unlock()
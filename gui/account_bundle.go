@@ -0,0 +1,343 @@
+package gui
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/i18n"
+	rosters "github.com/chadsec1/decoyim/roster"
+	"github.com/chadsec1/decoyim/session"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+const accountBundleExtension = ".decoyim-account"
+
+type accountBundleExportView struct {
+	u       *gtkUI
+	account *account
+	// canExportHistory is true if the account keeps a history that can be read
+	canExportHistory bool
+
+	dialog         gtki.Dialog      `gtk-widget:"ExportAccountBundle"`
+	message        gtki.Label       `gtk-widget:"message"`
+	password       gtki.Entry       `gtk-widget:"password"`
+	password2      gtki.Entry       `gtk-widget:"password2"`
+	includeRoster  gtki.CheckButton `gtk-widget:"includeRoster"`
+	includeHistory gtki.CheckButton `gtk-widget:"includeHistory"`
+	exportButton   gtki.Button      `gtk-widget:"export"`
+}
+
+// exportAccountBundle lets the user write the account to a file encrypted with a passphrase, to import it somewhere else
+func (u *gtkUI) exportAccountBundle(a *account) {
+	v := &accountBundleExportView{u: u, account: a}
+
+	builder := newBuilder("ExportAccountBundle")
+	panicOnDevError(builder.bindObjects(v))
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_cancel": v.dialog.Destroy,
+		"on_export": v.export,
+	})
+
+	// The history is encrypted with the main password, so it can only be read if the configuration file is encrypted
+	v.canExportHistory = a.session.GetConfig().KeepHistory && u.config().HasEncryptedStorage()
+	v.includeHistory.SetSensitive(v.canExportHistory)
+
+	v.dialog.SetTransientFor(u.window)
+	v.dialog.ShowAll()
+}
+
+// suggestedBundleName returns the file name offered when choosing where to save the account
+func suggestedBundleName(account string) string {
+	return strings.NewReplacer("@", "_at_", "/", "_").Replace(account) + accountBundleExtension
+}
+
+// contactsForBundle returns the contacts of the roster, in the order of their JIDs
+func contactsForBundle(peers []*rosters.Peer) []config.BundledContact {
+	var result []config.BundledContact
+	for _, p := range peers {
+		var groups []string
+		for g := range p.Groups {
+			groups = append(groups, g)
+		}
+		sort.Strings(groups)
+
+		result = append(result, config.BundledContact{
+			JID:    p.Jid.String(),
+			Name:   p.Name,
+			Groups: groups,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].JID < result[j].JID
+	})
+
+	return result
+}
+
+func (v *accountBundleExportView) export() {
+	pass1, _ := v.password.GetText()
+	pass2, _ := v.password2.GetText()
+	if len(pass1) == 0 {
+		v.message.SetMarkup(i18n.Local("<b>Passphrase can not be empty</b> - please try again"))
+		v.password.GrabFocus()
+		return
+	}
+	if pass1 != pass2 {
+		v.message.SetLabel(i18n.Local("Passphrases have to be the same - please try again"))
+		v.password.GrabFocus()
+		return
+	}
+
+	conf := v.account.session.GetConfig()
+	path, ok := chooseBundleFile(v.dialog, gtki.FILE_CHOOSER_ACTION_SAVE, suggestedBundleName(conf.Account))
+	if !ok {
+		return
+	}
+
+	b, err := config.NewAccountBundle(conf)
+	if err != nil {
+		v.u.hasLog.log.WithError(err).Warn("couldn't copy the account to export")
+		v.message.SetLabel(i18n.Local("The account couldn't be exported."))
+		return
+	}
+
+	if v.includeRoster.GetActive() {
+		b.Roster = contactsForBundle(v.account.session.R().ToSlice())
+	}
+	withHistory := v.canExportHistory && v.includeHistory.GetActive()
+
+	v.exportButton.SetSensitive(false)
+	v.message.SetLabel(i18n.Local("Exporting the account..."))
+
+	// Generating the key takes a while, so it can't happen in the UI thread
+	go func() {
+		err := v.write(b, path, pass1, withHistory)
+		doInUIThread(func() {
+			v.exportButton.SetSensitive(true)
+			if err != nil {
+				v.u.hasLog.log.WithError(err).WithField("file", path).Warn("couldn't export the account")
+				v.message.SetLabel(i18n.Local("The account couldn't be written to the file."))
+				return
+			}
+
+			v.dialog.Destroy()
+			v.u.notify(i18n.Local("Account exported"), i18n.Localf("The account was written to %s.", path))
+		})
+	}()
+}
+
+func (v *accountBundleExportView) write(b *config.AccountBundle, path, passphrase string, withHistory bool) error {
+	if withHistory {
+		store, err := session.OpenHistory(v.u.config())
+		if err != nil {
+			return err
+		}
+		if b.History, err = store.ExportAccount(b.Account.Account); err != nil {
+			return err
+		}
+	}
+
+	content, err := b.Encrypt(passphrase)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+func chooseBundleFile(parent gtki.Window, action gtki.FileChooserAction, suggested string) (string, bool) {
+	title, button := i18n.Local("Choose where to save the account"), i18n.Local("_Save")
+	if action == gtki.FILE_CHOOSER_ACTION_OPEN {
+		title, button = i18n.Local("Choose the account to import"), i18n.Local("_Open")
+	}
+
+	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
+		title,
+		parent,
+		action,
+		i18n.Local("_Cancel"),
+		gtki.RESPONSE_CANCEL,
+		button,
+		gtki.RESPONSE_OK,
+	)
+	defer dialog.Destroy()
+
+	if action == gtki.FILE_CHOOSER_ACTION_SAVE {
+		dialog.SetCurrentName(suggested)
+		dialog.SetDoOverwriteConfirmation(true)
+	}
+
+	if gtki.ResponseType(dialog.Run()) != gtki.RESPONSE_OK {
+		return "", false
+	}
+	return dialog.GetFilename(), true
+}
+
+type accountBundleImportView struct {
+	u    *gtkUI
+	path string
+
+	dialog        gtki.Dialog      `gtk-widget:"ImportAccountBundle"`
+	message       gtki.Label       `gtk-widget:"message"`
+	password      gtki.Entry       `gtk-widget:"password"`
+	preferBundle  gtki.RadioButton `gtk-widget:"preferBundle"`
+	importHistory gtki.CheckButton `gtk-widget:"importHistory"`
+	importButton  gtki.Button      `gtk-widget:"import"`
+}
+
+// importAccountBundle adds an account exported with exportAccountBundle, or merges it into the account that is already here
+func (u *gtkUI) importAccountBundle() {
+	path, ok := chooseBundleFile(u.window, gtki.FILE_CHOOSER_ACTION_OPEN, "")
+	if !ok {
+		return
+	}
+
+	v := &accountBundleImportView{u: u, path: path}
+
+	builder := newBuilder("ImportAccountBundle")
+	panicOnDevError(builder.bindObjects(v))
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_cancel": v.dialog.Destroy,
+		"on_import": v.doImport,
+	})
+
+	v.importHistory.SetSensitive(u.config().HasEncryptedStorage())
+
+	v.dialog.SetTransientFor(u.window)
+	v.dialog.ShowAll()
+}
+
+func (v *accountBundleImportView) doImport() {
+	passphrase, _ := v.password.GetText()
+	merge := config.KeepExisting
+	if v.preferBundle.GetActive() {
+		merge = config.PreferBundle
+	}
+	withHistory := v.u.config().HasEncryptedStorage() && v.importHistory.GetActive()
+
+	v.importButton.SetSensitive(false)
+	v.message.SetLabel(i18n.Local("Opening the account..."))
+
+	go func() {
+		b, err := v.open(passphrase)
+		doInUIThread(func() {
+			v.importButton.SetSensitive(true)
+			switch err {
+			case nil:
+				v.dialog.Destroy()
+				go v.u.mergeAccountBundle(b, merge, withHistory)
+			case config.ErrWrongBundlePassphrase:
+				v.message.SetLabel(i18n.Local("Incorrect passphrase entered, please try again."))
+				v.password.GrabFocus()
+			case config.ErrNotAnAccountBundle, config.ErrUnsupportedBundleVersion:
+				v.message.SetLabel(err.Error())
+			default:
+				v.u.hasLog.log.WithError(err).WithField("file", v.path).Warn("couldn't read the account to import")
+				v.message.SetLabel(i18n.Local("The file couldn't be read."))
+			}
+		})
+	}()
+}
+
+func (v *accountBundleImportView) open(passphrase string) (*config.AccountBundle, error) {
+	content, err := ioutil.ReadFile(v.path)
+	if err != nil {
+		return nil, err
+	}
+	return config.OpenAccountBundle(content, passphrase)
+}
+
+func (u *gtkUI) mergeAccountBundle(b *config.AccountBundle, merge config.BundleMerge, withHistory bool) {
+	accountsLock.Lock()
+	res := u.config().ImportAccountBundle(b, merge)
+	if withHistory && len(b.History) > 0 {
+		res.Account.KeepHistory = true
+	}
+	accountsLock.Unlock()
+
+	if err := u.saveConfigInternal(); err != nil {
+		u.hasLog.log.WithField("account", res.Account.Account).WithError(err).Warn("Failed to save config")
+		u.notify(i18n.Local("Failure importing the account"), i18n.Local("The configuration couldn't be saved."))
+		return
+	}
+
+	msg := describeBundleImport(res, merge)
+
+	if withHistory && len(b.History) > 0 {
+		added, err := u.importBundledHistory(res.Account.Account, b)
+		if err != nil {
+			u.hasLog.log.WithError(err).Warn("Failed to import the history of the account")
+			msg += " " + i18n.Local("The history of conversations couldn't be imported.")
+		} else {
+			msg += " " + i18n.Localf("%d messages were added to the history.", added)
+		}
+	}
+
+	u.notify(i18n.Local("Account imported"), msg)
+}
+
+func (u *gtkUI) importBundledHistory(account string, b *config.AccountBundle) (int, error) {
+	store, err := session.OpenHistory(u.config())
+	if err != nil {
+		return 0, err
+	}
+
+	added, err := store.ImportAccount(account, b.History)
+	if err != nil {
+		return added, err
+	}
+
+	return added, store.SaveIndex()
+}
+
+func describeBundleImport(res *config.BundleImport, merge config.BundleMerge) string {
+	if res.New {
+		return i18n.Localf("The account %s was added.", res.Account.Account)
+	}
+
+	msg := i18n.Localf("The account %s was already here. %d keys, %d fingerprints, %d certificate pins and %d contacts were added to it.",
+		res.Account.Account, res.AddedKeys, res.AddedFingerprints, res.AddedPins, res.AddedPeers)
+
+	if res.Conflicts > 0 {
+		if merge == config.PreferBundle {
+			msg += " " + i18n.Localf("%d keys or fingerprints were different in the file, and the ones from the file are used now.", res.Conflicts)
+		} else {
+			msg += " " + i18n.Localf("%d keys or fingerprints were different in the file, and the ones that were already here were kept.", res.Conflicts)
+		}
+	}
+
+	if res.SettingConflicts > 0 {
+		if merge == config.PreferBundle {
+			msg += " " + i18n.Localf("%d settings were different in the file, and the ones from the file are used now.", res.SettingConflicts)
+		} else {
+			msg += " " + i18n.Localf("%d settings were different in the file, and the ones that were already here were kept.", res.SettingConflicts)
+		}
+	}
+
+	if len(res.KeptCredentials) > 0 {
+		names := make([]string, len(res.KeptCredentials))
+		for i, c := range res.KeptCredentials {
+			names[i] = describeBundleCredential(c)
+		}
+		msg += " " + i18n.Localf("These credentials were different in the file, and the ones that were already here were kept: %s. Change them in the account details if needed.", strings.Join(names, ", "))
+	}
+
+	return msg
+}
+
+func describeBundleCredential(name string) string {
+	switch name {
+	case "Password":
+		return i18n.Local("password")
+	case "Proxies":
+		return i18n.Local("proxies")
+	case "TorControlPassword":
+		return i18n.Local("Tor control password")
+	}
+	return name
+}
@@ -0,0 +1,42 @@
+package gui
+
+import (
+	"github.com/chadsec1/decoyim/config"
+	rosters "github.com/chadsec1/decoyim/roster"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+
+	. "gopkg.in/check.v1"
+)
+
+type AccountBundleSuite struct{}
+
+var _ = Suite(&AccountBundleSuite{})
+
+func (s *AccountBundleSuite) Test_suggestedBundleName(c *C) {
+	c.Assert(suggestedBundleName("someone@example.org"), Equals, "someone_at_example.org.decoyim-account")
+}
+
+func (s *AccountBundleSuite) Test_contactsForBundle_keepsNamesAndGroupsInOrder(c *C) {
+	peers := []*rosters.Peer{
+		{Jid: jid.NR("bob@example.org"), Name: "Bob"},
+		{Jid: jid.NR("alice@example.org"), Name: "Alice", Groups: map[string]bool{"Work": true, "Friends": true}},
+	}
+
+	c.Assert(contactsForBundle(peers), DeepEquals, []config.BundledContact{
+		{JID: "alice@example.org", Name: "Alice", Groups: []string{"Friends", "Work"}},
+		{JID: "bob@example.org", Name: "Bob"},
+	})
+}
+
+func (s *AccountBundleSuite) Test_describeBundleImport_tellsWhichCredentialsWereKept(c *C) {
+	res := &config.BundleImport{
+		Account:          &config.Account{Account: "someone@example.org"},
+		SettingConflicts: 2,
+		KeptCredentials:  []string{"Password", "Proxies"},
+	}
+
+	msg := describeBundleImport(res, config.PreferBundle)
+
+	c.Assert(msg, Matches, ".*2 settings were different in the file, and the ones from the file are used now.*")
+	c.Assert(msg, Matches, ".*kept: password, proxies\\..*")
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/chadsec1/decoyim/i18n"
 	"github.com/chadsec1/decoyim/net"
 	"github.com/chadsec1/decoyim/session/access"
+	ourtls "github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp"
 	"github.com/coyim/gotk3adapter/gtki"
 )
@@ -35,6 +36,16 @@ type accountDetailsData struct {
 	fingerprintsMessage gtki.Label        `gtk-widget:"fingerprintsMessage"`
 	pinningPolicy       gtki.ComboBoxText `gtk-widget:"pinningPolicyValue"`
 	pinsView            gtki.TreeView     `gtk-widget:"pins-view"`
+	saslDowngradePolicy gtki.ComboBoxText `gtk-widget:"saslDowngradePolicyValue"`
+	storeSaltedKeys     gtki.CheckButton  `gtk-widget:"storeSaltedKeys"`
+	danePolicy          gtki.ComboBoxText `gtk-widget:"danePolicyValue"`
+	dnssecResolver      gtki.Entry        `gtk-widget:"dnssecResolver"`
+	dnsResolver         gtki.ComboBoxText `gtk-widget:"dnsResolverValue"`
+	dnsResolverAddress  gtki.Entry        `gtk-widget:"dnsResolverAddress"`
+	dnsFailClosed       gtki.CheckButton  `gtk-widget:"dnsFailClosed"`
+	torOnly             gtki.CheckButton  `gtk-widget:"torOnly"`
+	torControlPassword  gtki.Entry        `gtk-widget:"torControlPassword"`
+	keepHistory         gtki.CheckButton  `gtk-widget:"keepHistory"`
 }
 
 func getBuilderAndAccountDialogDetails() *accountDetailsData {
@@ -82,6 +93,44 @@ func findPinningPolicyFor(t string) int {
 	return -1
 }
 
+func findSASLDowngradePolicyFor(t string) int {
+	switch t {
+	case "none":
+		return 0
+	case "", "ask":
+		return 1
+	case "deny":
+		return 2
+	}
+	return -1
+}
+
+func findDANEPolicyFor(t string) int {
+	switch t {
+	case "", "none":
+		return 0
+	case "opportunistic":
+		return 1
+	case "require":
+		return 2
+	}
+	return -1
+}
+
+func findDNSResolverFor(t string) int {
+	switch t {
+	case "", "tcp":
+		return 0
+	case "doh":
+		return 1
+	case "tor":
+		return 2
+	case "system":
+		return 3
+	}
+	return -1
+}
+
 func filterCertificates(oldCerts []*config.CertificatePin, newList gtki.ListStore) []*config.CertificatePin {
 	allPins := make(map[string]bool)
 
@@ -111,7 +160,7 @@ func (u *gtkUI) connectionInfoDialog(account *account) {
 	builder := newBuilder(dialogID)
 
 	var dialog gtki.Dialog
-	var server, tlsAlgo, tlsVersion, tlsFingerprint gtki.Label
+	var server, tlsAlgo, tlsVersion, tlsFingerprint, dane gtki.Label
 	var pinCertButton gtki.Button
 
 	builder.getItems(
@@ -120,6 +169,7 @@ func (u *gtkUI) connectionInfoDialog(account *account) {
 		"tlsAlgoValue", &tlsAlgo,
 		"tlsVersionValue", &tlsVersion,
 		"tlsFingerprintValue", &tlsFingerprint,
+		"daneValue", &dane,
 		"pin-cert", &pinCertButton,
 	)
 
@@ -144,6 +194,8 @@ func (u *gtkUI) connectionInfoDialog(account *account) {
 	chunks := splitStringEvery(fmt.Sprintf("%X", digests.Sha3_256(certs[0].Raw)), chunkingDefaultGrouping)
 	tlsFingerprint.SetLabel(fmt.Sprintf("%s %s %s %s\n%s %s %s %s", chunks[0], chunks[1], chunks[2], chunks[3], chunks[4], chunks[5], chunks[6], chunks[7]))
 
+	dane.SetLabel(daneDescription(account.session.Conn().DANEResult()))
+
 	if checkPinned(account.session.GetConfig(), certs) {
 		pinCertButton.SetSensitive(false)
 	}
@@ -161,6 +213,24 @@ func (u *gtkUI) connectionInfoDialog(account *account) {
 	dialog.ShowAll()
 }
 
+func daneDescription(r *ourtls.DANEResult) string {
+	switch {
+	case r == nil:
+		return i18n.Local("Not checked")
+	case r.LookupError != nil:
+		return i18n.Localf("Looking up the TLSA records for %s failed: %s", r.Name, r.LookupError.Error())
+	case len(r.Records) == 0:
+		return i18n.Localf("There are no TLSA records for %s", r.Name)
+	case !r.Secure:
+		return i18n.Localf("The TLSA records for %s are not authenticated with DNSSEC", r.Name)
+	case r.Matched == nil:
+		return i18n.Localf("None of the TLSA records for %s match the certificate", r.Name)
+	case r.Matched.Usage == ourtls.DANEUsageDANETA:
+		return i18n.Localf("The certificate was issued by the trust anchor in the TLSA records for %s", r.Name)
+	}
+	return i18n.Localf("The certificate matches the TLSA records for %s", r.Name)
+}
+
 type accountDetails struct {
 	accTxt  string
 	passTxt string
@@ -227,6 +297,16 @@ func renderAccountDetails(account *config.Account, data *accountDetailsData) {
 	}
 
 	data.pinningPolicy.SetActive(findPinningPolicyFor(account.PinningPolicy))
+	data.saslDowngradePolicy.SetActive(findSASLDowngradePolicyFor(account.SASLDowngradePolicy))
+	data.storeSaltedKeys.SetActive(account.StoreSaltedKeys)
+	data.danePolicy.SetActive(findDANEPolicyFor(account.DANEPolicy))
+	data.dnssecResolver.SetText(account.DNSSECResolver)
+	data.dnsResolver.SetActive(findDNSResolverFor(account.DNSResolver))
+	data.dnsResolverAddress.SetText(account.DNSResolverAddress)
+	data.dnsFailClosed.SetActive(account.DNSFailClosed)
+	data.torOnly.SetActive(account.TorOnly)
+	data.torControlPassword.SetText(account.TorControlPassword)
+	data.keepHistory.SetActive(account.KeepHistory)
 }
 
 func addAccount(account *config.Account, accDtails *accountDetails, data *accountDetailsData) {
@@ -259,6 +339,24 @@ func addAccount(account *config.Account, accDtails *accountDetails, data *accoun
 
 	account.Certificates = filterCertificates(account.Certificates, data.pins)
 	account.PinningPolicy = data.pinningPolicy.GetActiveID()
+	account.SASLDowngradePolicy = data.saslDowngradePolicy.GetActiveID()
+
+	account.StoreSaltedKeys = data.storeSaltedKeys.GetActive()
+	if !account.StoreSaltedKeys {
+		account.ClearSaltedKeys()
+	}
+
+	account.DANEPolicy = data.danePolicy.GetActiveID()
+	dnssecResolver, _ := data.dnssecResolver.GetText()
+	account.DNSSECResolver = strings.TrimSpace(dnssecResolver)
+
+	account.DNSResolver = data.dnsResolver.GetActiveID()
+	dnsResolverAddress, _ := data.dnsResolverAddress.GetText()
+	account.DNSResolverAddress = strings.TrimSpace(dnsResolverAddress)
+	account.DNSFailClosed = data.dnsFailClosed.GetActive()
+	account.TorOnly = data.torOnly.GetActive()
+	account.TorControlPassword, _ = data.torControlPassword.GetText()
+	account.KeepHistory = data.keepHistory.GetActive()
 }
 
 func (u *gtkUI) accountDialog(s access.Session, account *config.Account, saveFunction func()) {
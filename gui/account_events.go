@@ -48,6 +48,14 @@ func (u *gtkUI) handleOneAccountEvent(ev interface{}, a *account) {
 		doInUIThread(func() {
 			u.handleMUCEvent(t, a)
 		})
+	case events.Reconnect:
+		doInUIThread(func() {
+			u.handleReconnectEvent(t, a)
+		})
+	case events.Outbox:
+		doInUIThread(func() {
+			u.handleOutboxEvent(t, a)
+		})
 	default:
 		a.log.WithField("event", t).Warn("unsupported event")
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/chadsec1/decoyim/config"
 	"github.com/chadsec1/decoyim/decoylog"
+	ournet "github.com/chadsec1/decoyim/net"
 	"github.com/chadsec1/decoyim/otrclient"
 	rosters "github.com/chadsec1/decoyim/roster"
 	"github.com/chadsec1/decoyim/session/access"
@@ -30,6 +31,8 @@ type accountManager struct {
 	sessionFactory sessions.Factory
 	dialerFactory  interfaces.DialerFactory
 
+	networkMonitor *ournet.NetworkMonitor
+
 	lock sync.RWMutex
 }
 
@@ -93,6 +93,31 @@ func (*AccountSuite) Test_account_removeCurrentNotificationIf_removesTheNotifica
 	c.Assert(one.destroyCalled, Equals, 1)
 }
 
+func (*AccountSuite) Test_account_removeReconnectingNotification_removesItIfItIsStillShown(c *C) {
+	one := &accountInfoBarMock{}
+	ac := &account{currentNotification: one, reconnectingNotification: one}
+	ac.removeReconnectingNotification()
+
+	c.Assert(ac.currentNotification, IsNil)
+	c.Assert(ac.reconnectingNotification, IsNil)
+	c.Assert(one.destroyCalled, Equals, 1)
+}
+
+func (*AccountSuite) Test_account_removeReconnectingNotification_leavesOtherNotificationsAlone(c *C) {
+	one := &accountInfoBarMock{}
+	two := &accountInfoBarMock{}
+	ac := &account{currentNotification: two, reconnectingNotification: one}
+	ac.removeReconnectingNotification()
+
+	c.Assert(ac.currentNotification, Equals, two)
+	c.Assert(ac.reconnectingNotification, IsNil)
+	c.Assert(two.destroyCalled, Equals, 0)
+
+	ac = &account{}
+	ac.removeReconnectingNotification()
+	c.Assert(ac.currentNotification, IsNil)
+}
+
 func (*AccountSuite) Test_account_IsAskingForPassword(c *C) {
 	c.Assert((&account{askingForPassword: true}).IsAskingForPassword(), Equals, true)
 	c.Assert((&account{askingForPassword: false}).IsAskingForPassword(), Equals, false)
@@ -50,12 +50,17 @@ func (u *gtkUI) buildStaticAccountsMenu(submenu gtki.Menu) {
 	_ = importMenu.Connect("activate", u.runImporter)
 	submenu.Append(importMenu)
 
+	importBundleMenu, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("Import Exported Account..."))
+	_ = importBundleMenu.Connect("activate", u.importAccountBundle)
+	submenu.Append(importBundleMenu)
+
 	connectAutomaticallyItem.SetSensitive(false)
 	connectAllMenu.SetSensitive(false)
 	disconnectAllMenu.SetSensitive(false)
 	registerAccMenu.SetSensitive(false)
 	addAccMenu.SetSensitive(false)
 	importMenu.SetSensitive(false)
+	importBundleMenu.SetSensitive(false)
 
 	u.whenHaveConfig(func() {
 		doInUIThread(func() {
@@ -65,6 +70,7 @@ func (u *gtkUI) buildStaticAccountsMenu(submenu gtki.Menu) {
 			registerAccMenu.SetSensitive(true)
 			addAccMenu.SetSensitive(true)
 			importMenu.SetSensitive(true)
+			importBundleMenu.SetSensitive(true)
 		})
 	})
 }
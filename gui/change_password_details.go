@@ -58,8 +58,12 @@ func changePassword(account *account, newPassword string, u *gtkUI, data *change
 
 		config := account.session.GetConfig()
 		saveNewPassword := data.checkboxSavePassword.GetActive()
-		if saveNewPassword {
-			config.Password = newPassword
+		if saveNewPassword || config.HasSaltedKeys() {
+			// The salted keys were derived from the old password
+			config.ClearSaltedKeys()
+			if saveNewPassword && !config.StoreSaltedKeys {
+				config.Password = newPassword
+			}
 			u.SaveConfig()
 		}
 
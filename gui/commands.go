@@ -18,6 +18,7 @@ type connectionInfoCmd struct{ a *account }
 type editAccountCmd struct{ a *account }
 type changePasswordAccountCmd struct{ a *account }
 type removeAccountCmd struct{ a *account }
+type exportAccountCmd struct{ a *account }
 type toggleAutoConnectCmd struct{ a *account }
 type toggleAlwaysEncryptCmd struct{ a *account }
 
@@ -59,6 +60,12 @@ func (c removeAccountCmd) execute(u *gtkUI) {
 	})
 }
 
+func (c exportAccountCmd) execute(u *gtkUI) {
+	doInUIThread(func() {
+		u.exportAccountBundle(c.a)
+	})
+}
+
 func (c toggleAutoConnectCmd) execute(u *gtkUI) {
 	go u.toggleAutoConnectAccount(c.a)
 }
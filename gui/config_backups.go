@@ -0,0 +1,139 @@
+package gui
+
+import (
+	"strings"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+func describeConfigBackup(b *config.ConfigurationBackup) string {
+	when := b.SavedAt.Format("2006-01-02 15:04")
+	switch {
+	case b.Err != nil:
+		return i18n.Localf("%s - can't be opened", when)
+	case len(b.Accounts) == 0:
+		return i18n.Localf("%s - no accounts", when)
+	}
+	return i18n.Localf("%s - %s", when, strings.Join(b.Accounts, ", "))
+}
+
+// restoreConfigBackup restores a backup of the configuration while running. The accounts have already
+// been set up from the configuration as it was, so the application has to be started again.
+func (u *gtkUI) restoreConfigBackup() {
+	u.showRestoreConfigBackup("", func() {
+		builder := newBuilder("SimpleNotification")
+		dlg := builder.getObj("dialog").(gtki.MessageDialog)
+
+		_ = dlg.SetProperty("title", i18n.Local("Configuration restored"))
+		_ = dlg.SetProperty("text", i18n.Local("The backup of the configuration was restored. DecoyIM will close now - "+
+			"start it again to use the restored configuration."))
+		dlg.SetTransientFor(u.window)
+
+		dlg.Run()
+		dlg.Destroy()
+		u.quit()
+	}, func() {})
+}
+
+// offerToRestoreConfigBackup is used when the configuration file couldn't be read at startup, but has backups
+func (u *gtkUI) offerToRestoreConfigBackup() {
+	message := i18n.Local("The configuration file couldn't be read. You can go back to one of the last versions of it " +
+		"that DecoyIM kept, or cancel to set up DecoyIM from the beginning.")
+
+	u.showRestoreConfigBackup(message, func() {
+		go func() {
+			if u.config().UpdateToLatestVersion() {
+				_ = u.saveConfigOnlyInternal()
+			}
+		}()
+	}, u.initialSetupWindow)
+}
+
+func (u *gtkUI) showRestoreConfigBackup(message string, restored, cancelled func()) {
+	builder := newBuilder("RestoreConfigBackup")
+	dialog := builder.getObj("RestoreConfigBackup").(gtki.Dialog)
+	messageObj := builder.getObj("message").(gtki.Label)
+	backupsBox := builder.getObj("backups").(gtki.ComboBoxText)
+	status := builder.getObj("status").(gtki.Label)
+	restoreButton := builder.getObj("btn-restore").(gtki.Button)
+
+	if message != "" {
+		messageObj.SetLabel(message)
+	}
+	dialog.SetTransientFor(u.window)
+
+	var backups []*config.ConfigurationBackup
+	closed, restoring := false, false
+
+	// Opening the backups takes a while, and might ask for the password, so it can't happen in the UI thread
+	go func() {
+		bs := u.config().Backups(u.keySupplier)
+		doInUIThread(func() {
+			if closed {
+				return
+			}
+
+			backups = bs
+			for _, b := range bs {
+				backupsBox.AppendText(describeConfigBackup(b))
+			}
+			backupsBox.SetActive(0)
+			backupsBox.SetSensitive(len(bs) > 0)
+			restoreButton.SetSensitive(len(bs) > 0)
+			if len(bs) == 0 {
+				status.SetLabel(i18n.Local("There are no backups of the configuration."))
+			} else {
+				status.SetLabel("")
+			}
+		})
+	}()
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_restore": func() {
+			ix := backupsBox.GetActive()
+			if restoring || ix < 0 || ix >= len(backups) {
+				return
+			}
+
+			b := backups[ix]
+			if b.Err != nil {
+				status.SetLabel(i18n.Local("This backup can't be opened - it might be damaged, or saved with another password."))
+				return
+			}
+
+			restoring = true
+			restoreButton.SetSensitive(false)
+			status.SetLabel(i18n.Local("Restoring the backup..."))
+
+			go func() {
+				err := u.config().RestoreBackup(b, u.keySupplier)
+				doInUIThread(func() {
+					restoring = false
+					restoreButton.SetSensitive(true)
+
+					if err != nil {
+						u.hasLog.log.WithError(err).Warn("Failed to restore the configuration backup")
+						status.SetLabel(i18n.Local("The backup couldn't be restored."))
+						return
+					}
+
+					closed = true
+					dialog.Destroy()
+					restored()
+				})
+			}()
+		},
+		"on_cancel": func() {
+			if restoring {
+				return
+			}
+			closed = true
+			dialog.Destroy()
+			cancelled()
+		},
+	})
+
+	dialog.ShowAll()
+}
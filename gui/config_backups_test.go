@@ -0,0 +1,23 @@
+package gui
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chadsec1/decoyim/config"
+
+	. "gopkg.in/check.v1"
+)
+
+type ConfigBackupsSuite struct{}
+
+var _ = Suite(&ConfigBackupsSuite{})
+
+func (s *ConfigBackupsSuite) Test_describeConfigBackup(c *C) {
+	t := time.Date(2020, 5, 17, 10, 4, 5, 0, time.Local)
+
+	c.Assert(describeConfigBackup(&config.ConfigurationBackup{SavedAt: t, Accounts: []string{"one@example.org", "two@example.org"}}),
+		Equals, "2020-05-17 10:04 - one@example.org, two@example.org")
+	c.Assert(describeConfigBackup(&config.ConfigurationBackup{SavedAt: t}), Equals, "2020-05-17 10:04 - no accounts")
+	c.Assert(describeConfigBackup(&config.ConfigurationBackup{SavedAt: t, Err: errors.New("broken")}), Equals, "2020-05-17 10:04 - can't be opened")
+}
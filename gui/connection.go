@@ -6,12 +6,15 @@ import (
 
 	"github.com/chadsec1/decoyim/config"
 	"github.com/chadsec1/decoyim/i18n"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/xmpp/errors"
 )
 
 func (u *gtkUI) connectAccount(account *account) {
-	p := account.session.GetConfig().Password
-	if p == "" {
+	conf := account.session.GetConfig()
+	p := conf.Password
+	// With stored salted keys we can try to authenticate without asking for the password
+	if p == "" && !conf.HasSaltedKeys() {
 		u.askForPasswordAndConnect(account, false)
 	} else {
 		go func() {
@@ -36,6 +39,18 @@ func (u *gtkUI) connectionFailureMoreInfoConnectionFailedGeneric() {
 	u.notify(i18n.Local("Connection failure"), i18n.Local("We couldn't connect to the server for some reason - verify that the server address is correct and that you are actually connected to the internet.\n\nWe will try to reconnect."))
 }
 
+func (u *gtkUI) connectionFailureMoreInfoMechanismDowngrade() {
+	u.notify(i18n.Local("Connection failure"), i18n.Local("We didn't authenticate with the server because it only offered weaker authentication mechanisms than it has offered before.\n\nYou can change this behavior with the authentication downgrade policy in the account details."))
+}
+
+func (u *gtkUI) connectionFailureMoreInfoDNSWouldLeak() {
+	u.notify(i18n.Local("Connection failure"), i18n.Local("We didn't connect to the server because that would have made DNS queries outside of your proxies.\n\nYou can change the DNS resolver or the proxies in the account details."))
+}
+
+func (u *gtkUI) connectionFailureMoreInfoTorOnly() {
+	u.notify(i18n.Local("Connection failure"), i18n.Local("We didn't connect to the server because only connections through Tor are allowed, and this account doesn't connect through a Tor proxy on this computer.\n\nYou can change the proxies of the account, or allow connections outside of Tor in the Options menu and the account details."))
+}
+
 func (u *gtkUI) connectionFailureMoreInfoConnectionFailed(ee error) func() {
 	return func() {
 		u.notify(i18n.Local("Connection failure"),
@@ -48,14 +63,23 @@ func (u *gtkUI) connectWithPassword(account *account, password string) error {
 		return nil
 	}
 
+	u.waitForTorBootstrap(account)
+
 	removeNotification := u.showConnectAccountNotification(account)
 	defer removeNotification()
 
-	err := account.session.Connect(password, u.verifierFor(account))
+	err := account.session.Connect(password, u.verifierFor(account), u.mechanismVerifierFor(account))
 	switch err {
+	case nil:
+		if account.session.GetConfig().StoreSaltedKeys {
+			u.SaveConfig()
+		}
+		account.resetTorFailures()
+		go u.checkTorIsolation(account)
 	case config.ErrTorNotRunning:
 		u.notifyTorIsNotRunning(account, u.torIsNotRunning)
 	case errors.ErrTCPBindingFailed:
+		u.connectionFailedOverTor(account)
 		u.notifyConnectionFailure(account, u.connectionFailureMoreInfoTCPBindingFailed)
 	case errors.ErrAuthenticationFailed:
 		account.cachedPassword = ""
@@ -63,11 +87,19 @@ func (u *gtkUI) connectWithPassword(account *account, password string) error {
 	case errors.ErrGoogleAuthenticationFailed:
 		account.cachedPassword = ""
 		u.askForPasswordAndConnect(account, true)
+	case config.ErrTorOnlyNeedsLocalProxy:
+		u.notifyConnectionFailure(account, u.connectionFailureMoreInfoTorOnly)
+	case config.ErrDNSWouldLeak:
+		u.notifyConnectionFailure(account, u.connectionFailureMoreInfoDNSWouldLeak)
+	case sasl.ErrMechanismDowngrade:
+		u.notifyConnectionFailure(account, u.connectionFailureMoreInfoMechanismDowngrade)
 	case errors.ErrConnectionFailed:
+		u.connectionFailedOverTor(account)
 		u.notifyConnectionFailure(account, u.connectionFailureMoreInfoConnectionFailedGeneric)
 	default:
 		ff, ok := err.(*errors.ErrFailedToConnect)
 		if ok {
+			u.connectionFailedOverTor(account)
 			u.notifyConnectionFailure(account, u.connectionFailureMoreInfoConnectionFailed(ff))
 		}
 	}
@@ -96,7 +128,12 @@ func (u *gtkUI) askForPasswordAndConnect(account *account, addGoogleWarning bool
 					return u.connectWithPassword(account, password)
 				},
 				func(password string) {
-					account.session.GetConfig().Password = password
+					conf := account.session.GetConfig()
+					// The salted keys are saved instead, once the password has been used successfully
+					if conf.StoreSaltedKeys {
+						return
+					}
+					conf.Password = password
 					u.SaveConfig()
 				},
 			)
@@ -11,6 +11,8 @@ import (
 	"github.com/chadsec1/decoyim/otrclient"
 	rosters "github.com/chadsec1/decoyim/roster"
 	"github.com/chadsec1/decoyim/session/access"
+	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/history"
 	"github.com/chadsec1/decoyim/xmpp/jid"
 	"github.com/coyim/gotk3adapter/gdki"
 	"github.com/coyim/gotk3adapter/glibi"
@@ -29,6 +31,8 @@ type conversationView interface {
 	appendPendingDelayed()
 	appendStatus(from string, timestamp time.Time, show, showStatus string, gone bool)
 	delayedMessageSent(int)
+	queuedMessageSent(sdata.QueuedMessage)
+	queuedMessageCancelled(string)
 	displayNotification(notification string)
 	displayNotificationVerifiedOrNot(notificationV, notificiationTV, notificationNV string)
 	getTarget() jid.Any
@@ -48,6 +52,7 @@ type conversationView interface {
 	updateFileTransfer(file *fileNotification)
 	updateFileTransferNotificationCounts()
 	updateSecurityWarning()
+	showHistoryContext([]history.Message, int)
 
 	show(userInitiated bool)
 	destroy()
@@ -154,6 +159,8 @@ type conversationPane struct {
 	pending              gtki.TextView       `gtk-widget:"pending"`
 	scrollHistory        gtki.ScrolledWindow `gtk-widget:"historyScroll"`
 	scrollPending        gtki.ScrolledWindow `gtk-widget:"pendingScroll"`
+	cancelQueuedButton   gtki.MenuButton     `gtk-widget:"cancelQueuedButton"`
+	cancelQueuedMenu     gtki.Menu           `gtk-widget:"cancelQueuedMenu"`
 	notificationArea     gtki.Box            `gtk-widget:"notification-area"`
 	fileTransferNotif    *fileTransferNotification
 	securityWarningNotif *securityWarningNotification
@@ -167,6 +174,7 @@ type conversationPane struct {
 	afterNewMessage    func()
 	currentPeer        func() (*rosters.Peer, bool)
 	delayed            map[int]sentMessage
+	queued             map[string]sentMessage
 	pendingDelayed     []int
 	pendingDelayedLock sync.Mutex
 	shownPrivate       bool
@@ -206,6 +214,10 @@ func createTags(cs colorSet, gt gtki.Gtk) *tags {
 	t.addTextTag(gt, "outgoingDelayedUser", cs.conversationOutgoingDelayedUserForeground)
 	t.addTextTag(gt, "outgoingDelayedText", cs.conversationOutgoingDelayedTextForeground)
 
+	searchResult, _ := gt.TextTagNew("searchResult")
+	_ = searchResult.SetProperty("weight", 700)
+	t.table.Add(searchResult)
+
 	return t
 }
 
@@ -456,7 +468,10 @@ type sentMessage struct {
 	isOutgoing      bool
 	isResent        bool
 	trace           int
+	queueID         string
 	coordinates     bufferSlice
+	// cancelItem is the menu item that cancels the message, while it's waiting in the outbox
+	cancelItem gtki.MenuItem
 }
 
 func (sent *sentMessage) hasMePrefix() bool {
@@ -596,12 +611,12 @@ func (conv *conversationPane) sendMessage(message string) error {
 	trace, delayed, err := session.EncryptAndSendTo(conv.currentPeerForSending(), message)
 
 	if err != nil {
-		oerr, isoff := err.(*access.OfflineError)
+		_, isoff := err.(*access.OfflineError)
 		if !isoff {
 			return err
 		}
 
-		conv.displayNotification(oerr.Error())
+		conv.queueMessage(message)
 	} else {
 		//TODO: review whether it should create a conversation
 		//TODO: this should be whether the message was encrypted or not, rather than
@@ -762,8 +777,12 @@ func (conv *conversationPane) appendSentMessage(sent sentMessage, attention bool
 			insertEntry(buff, entry)
 		}
 
-		if sent.isDelayed {
-			sent.coordinates.start, sent.coordinates.end = markInsertion(buff, sent.trace, start)
+		switch {
+		case sent.isDelayed && sent.queueID != "":
+			sent.coordinates.start, sent.coordinates.end = markInsertion(buff, "queued"+sent.queueID, start)
+			conv.storeQueuedMessage(sent)
+		case sent.isDelayed:
+			sent.coordinates.start, sent.coordinates.end = markInsertion(buff, strconv.Itoa(sent.trace), start)
 			conv.storeDelayedMessage(sent.trace, sent)
 		}
 
@@ -773,9 +792,9 @@ func (conv *conversationPane) appendSentMessage(sent sentMessage, attention bool
 	})
 }
 
-func markInsertion(buff gtki.TextBuffer, trace, startOffset int) (start, end gtki.TextMark) {
-	insert := "insert" + strconv.Itoa(trace)
-	selBound := "selection_bound" + strconv.Itoa(trace)
+func markInsertion(buff gtki.TextBuffer, name string, startOffset int) (start, end gtki.TextMark) {
+	insert := "insert" + name
+	selBound := "selection_bound" + name
 	start = buff.CreateMark(insert, buff.GetIterAtOffset(startOffset), false)
 	end = buff.CreateMark(selBound, buff.GetEndIter(), false)
 	return
@@ -0,0 +1,161 @@
+package gui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/chadsec1/decoyim/i18n"
+	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/events"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+// queueMessage puts a message in the outbox of the account, to be sent when we are connected again
+func (conv *conversationPane) queueMessage(message string) {
+	m, err := conv.account.session.QueueMessage(conv.currentPeerForSending(), message)
+	if err != nil {
+		conv.Log().WithError(err).Warn("couldn't save queued message")
+		conv.displayNotification(i18n.Local("You are not connected. The message will be sent when you connect again, but it couldn't be saved, so it will be lost if you close DecoyIM before that."))
+	} else {
+		conv.displayNotification(i18n.Local("You are not connected. The message will be sent when you connect again."))
+	}
+
+	conv.appendQueuedMessage(m)
+}
+
+func (conv *conversationPane) appendQueuedMessage(m sdata.QueuedMessage) {
+	conv.appendMessage(sentMessage{
+		message:     m.Body,
+		from:        conv.account.session.DisplayName(),
+		to:          conv.currentPeerForSending().NoResource(),
+		timestamp:   m.Queued,
+		isEncrypted: m.Encrypted,
+		isDelayed:   true,
+		isOutgoing:  true,
+		queueID:     m.ID,
+	})
+
+	doInUIThread(func() {
+		conv.showDelayedMessagesWindow()
+		conv.cancelQueuedButton.SetVisible(true)
+	})
+}
+
+// showQueuedMessages shows the messages for this peer that are still waiting in the outbox
+func (conv *conversationPane) showQueuedMessages() {
+	for _, m := range conv.account.session.QueuedMessagesFor(conv.target) {
+		conv.appendQueuedMessage(m)
+	}
+}
+
+// queuedMessageMaxLabel is the number of characters of a queued message shown in the menu to cancel it
+const queuedMessageMaxLabel = 40
+
+// describeQueuedMessage returns the label of the menu item that cancels the queued message
+func describeQueuedMessage(queued time.Time, message string) string {
+	text := []rune(strings.Join(strings.Fields(message), " "))
+	if len(text) > queuedMessageMaxLabel {
+		text = append(text[:queuedMessageMaxLabel-1], '…')
+	}
+	return i18n.Localf("[%s] %s", queued.Format(timeDisplay), string(text))
+}
+
+// addCancelQueuedMenuItem adds an item for cancelling only this message to the menu of the cancel button.
+// Expects to be called from the UI thread.
+func (conv *conversationPane) addCancelQueuedMenuItem(sent sentMessage) gtki.MenuItem {
+	item, _ := g.gtk.MenuItemNewWithLabel(describeQueuedMessage(sent.timestamp, sent.message))
+	id := sent.queueID
+	_ = item.Connect("activate", func() {
+		conv.account.session.CancelQueuedMessage(id)
+	})
+
+	item.SetVisible(true)
+	conv.cancelQueuedMenu.Append(item)
+	return item
+}
+
+func (conv *conversationPane) storeQueuedMessage(sent sentMessage) {
+	sent.cancelItem = conv.addCancelQueuedMenuItem(sent)
+
+	conv.pendingDelayedLock.Lock()
+	defer conv.pendingDelayedLock.Unlock()
+
+	conv.queued[sent.queueID] = sent
+}
+
+// removeQueuedMessage removes the message from the pending area, and returns it.
+// Expects to be called from the UI thread.
+func (conv *conversationPane) removeQueuedMessage(id string) (sentMessage, bool) {
+	conv.pendingDelayedLock.Lock()
+	qm, ok := conv.queued[id]
+	delete(conv.queued, id)
+	left := len(conv.queued)
+	conv.pendingDelayedLock.Unlock()
+
+	if !ok {
+		return qm, false
+	}
+
+	conv.Lock()
+	buff, _ := conv.pending.GetBuffer()
+	buff.Delete(buff.GetIterAtMark(qm.coordinates.start), buff.GetIterAtMark(qm.coordinates.end))
+	conv.Unlock()
+
+	if qm.cancelItem != nil {
+		qm.cancelItem.Destroy()
+		qm.cancelItem = nil
+	}
+
+	if left == 0 {
+		conv.cancelQueuedButton.SetVisible(false)
+		if buff.GetCharCount() == 0 {
+			conv.hideDelayedMessagesWindow()
+		}
+	}
+
+	return qm, true
+}
+
+func (conv *conversationPane) queuedMessageSent(m sdata.QueuedMessage) {
+	qm, ok := conv.removeQueuedMessage(m.ID)
+	if !ok {
+		return
+	}
+
+	qm.isEncrypted = m.Encrypted
+	qm.queuedTimestamp = qm.timestamp
+	qm.timestamp = time.Now()
+	qm.isDelayed = false
+	qm.isResent = true
+	qm.queueID = ""
+
+	conv.appendMessage(qm)
+}
+
+func (conv *conversationPane) queuedMessageCancelled(id string) {
+	if _, ok := conv.removeQueuedMessage(id); ok {
+		conv.displayNotification(i18n.Local("A queued message was cancelled and will not be sent."))
+	}
+}
+
+func (conv *conversationPane) onCancelQueuedMessages() {
+	session := conv.account.session
+	for _, m := range session.QueuedMessagesFor(conv.target) {
+		session.CancelQueuedMessage(m.ID)
+	}
+}
+
+func (u *gtkUI) handleOutboxEvent(ev events.Outbox, a *account) {
+	peer := jid.Parse(ev.Message.Peer)
+
+	switch ev.Type {
+	case events.QueuedMessageSent:
+		convWin := u.openConversationView(a, peer, false)
+		convWin.queuedMessageSent(ev.Message)
+	case events.QueuedMessageCancelled:
+		u.NewConversationViewFactory(a, peer, false).IfConversationView(func(cv conversationView) {
+			cv.queuedMessageCancelled(ev.Message.ID)
+		}, func() {})
+	}
+}
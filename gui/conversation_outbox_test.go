@@ -0,0 +1,20 @@
+package gui
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type ConversationOutboxSuite struct{}
+
+var _ = Suite(&ConversationOutboxSuite{})
+
+func (s *ConversationOutboxSuite) Test_describeQueuedMessage(c *C) {
+	t := time.Date(2020, 5, 17, 10, 4, 5, 0, time.Local)
+
+	c.Assert(describeQueuedMessage(t, "are you there?"), Equals, "[10:04:05] are you there?")
+	c.Assert(describeQueuedMessage(t, "first line\n  second line"), Equals, "[10:04:05] first line second line")
+	c.Assert(describeQueuedMessage(t, "this message is much too long to fit in the menu that cancels it"), Equals,
+		"[10:04:05] this message is much too long to fit in…")
+}
@@ -235,6 +235,7 @@ func (cvf *ourConversationViewFactory) createConversationPane(win gtki.Window, p
 		shiftEnterSends:      cvf.ui.settings.GetShiftEnterForSend(),
 		afterNewMessage:      func() {},
 		delayed:              make(map[int]sentMessage),
+		queued:               make(map[string]sentMessage),
 		currentPeer: func() (*rosters.Peer, bool) {
 			p, ok := cvf.ui.getPeer(cvf.account, cvf.peer.NoResource())
 			if !ok {
@@ -261,6 +262,10 @@ func (cvf *ourConversationViewFactory) createConversationPane(win gtki.Window, p
 		"on_send_dir_to_contact": func() {
 			cvf.account.sendDirectoryTo(cp.currentPeerForSending(), cvf.ui, cp)
 		},
+		"on_cancel_queued_messages": cp.onCancelQueuedMessages,
+		"on_export_history": func() {
+			cvf.ui.exportHistoryWindow(cvf.account, cp.currentPeerForSending().NoResource(), false, cp.transientParent)
+		},
 	})
 
 	// This 115 is apparently for the letter "s"
@@ -290,6 +295,8 @@ func (cvf *ourConversationViewFactory) createConversationPane(win gtki.Window, p
 		cp.updateConversationDataFrom(pcp)
 	}
 
+	cp.showQueuedMessages()
+
 	return cp
 }
 
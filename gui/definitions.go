@@ -184,7 +184,7 @@ var _escData = map[string]*_escFile{
 
 	"/definitions/AccountDetails.xml": {
 		local:   "definitions/AccountDetails.xml",
-		size:    39295,
+		size:    50784,
 		modtime: 1489449600,
 		compressed: `
 PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtMaXN0U3RvcmUiIGlkPSJwcm94aWVzLW1vZGVs
@@ -566,282 +566,474 @@ dD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
 IG5hbWU9ImxlZnRfYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
 dHkgbmFtZT0idG9wX2F0dGFjaCI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
 cnR5IG5hbWU9IndpZHRoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
-ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
-ICAgICA8Y2hpbGQgdHlwZT0idGFiIj4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJl
-bCIgaWQ9ImxhYmVsLXRhYjIiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0
-cmFuc2xhdGFibGU9InllcyI+U2VydmVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
-biI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFiLWZpbGwiPkZh
-bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlk
-PSJ2Ym94MSI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luIj41PC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW4tZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjY8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtQ
-YW5lZCIgaWQ9ImhwYW5lZDEiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNp
-YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
-LWZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cG9zaXRpb24iPjE3NTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
-ICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrU2Nyb2xsZWRXaW5kb3ciIGlkPSJzY3JvbGxl
-ZHdpbmRvdzEiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaHNjcm9sbGJh
-ci1wb2xpY3kiPkdUS19QT0xJQ1lfTkVWRVI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0idnNjcm9sbGJhci1wb2xpY3kiPkdUS19QT0xJQ1lfQVVUT01BVElDPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoLXJlcXVl
-c3QiPjE3MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJo
-ZWlnaHQtcmVxdWVzdCI+MjMwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9Im1hcmdpbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImhleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0idmV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJzYXNsRG93bmdyYWRlUG9saWN5TGFiZWwi
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5BdXRoZW50aWNhdGlvbiBkb3duZ3JhZGUgcG9saWN5PC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5HVEtfQUxJR05fRU5EPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFi
+bGU9InllcyI+V2hhdCB0byBkbyB3aGVuIHRoZSBzZXJ2ZXIgb2ZmZXJzIGEgd2Vha2VyIGF1dGhlbnRp
+Y2F0aW9uIG1lY2hhbmlzbSB0aGFuIHRoZSBzdHJvbmdlc3Qgb25lIHdlIGhhdmUgc2VlbiBmcm9tIGl0
+IGJlZm9yZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRh
+Y2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0
+YWNoIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrQ29tYm9Cb3hUZXh0IiBpZD0ic2FzbERvd25ncmFkZVBvbGljeVZhbHVlIj4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgPGl0ZW1zPgogICAgICAgICAgICAgICAgICAgICAgPGl0ZW0gdHJh
+bnNsYXRhYmxlPSJ5ZXMiIGlkPSJub25lIj5Ob25lPC9pdGVtPgogICAgICAgICAgICAgICAgICAgICAg
+PGl0ZW0gdHJhbnNsYXRhYmxlPSJ5ZXMiIGlkPSJhc2siPkFsd2F5cyBhc2s8L2l0ZW0+CiAgICAgICAg
+ICAgICAgICAgICAgICA8aXRlbSB0cmFuc2xhdGFibGU9InllcyIgaWQ9ImRlbnkiPkRlbnk8L2l0ZW0+
+CiAgICAgICAgICAgICAgICAgICAgPC9pdGVtcz4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InRvcC1hdHRhY2giPjU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAg
+ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9InN0b3JlU2FsdGVkS2V5cyI+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
+U3RvcmUgc2FsdGVkIGtleXMgaW5zdGVhZCBvZiB0aGUgcGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkZvciBzZXJ2ZXJzIHN1cHBvcnRpbmcgU0NSQU0sIGtlZXAgb25seSB0aGUg
+a2V5cyBkZXJpdmVkIGZyb20gdGhlIHBhc3N3b3JkLiBUaGUgcGFzc3dvcmQgd2lsbCBzdGlsbCBiZSBh
+c2tlZCBmb3Igd2hlbiB0aGUgc2VydmVyIG9ubHkgc3VwcG9ydHMgd2Vha2VyIGF1dGhlbnRpY2F0aW9u
+IG1lY2hhbmlzbXMuPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+ZHJhd19pbmRpY2F0b3IiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxlZnQtYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idG9wLWF0dGFjaCI+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0iZGFuZVBvbGljeUxhYmVsIj4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
+REFORSBwb2xpY3k8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJo
+YWxpZ24iPkdUS19BTElHTl9FTkQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5XaGV0aGVyIHRvIGFjY2VwdCBz
+ZXJ2ZXIgY2VydGlmaWNhdGVzIHRoYXQgbWF0Y2ggRE5TU0VDIGF1dGhlbnRpY2F0ZWQgVExTQSByZWNv
+cmRzLCBldmVuIGlmIHRoZXkgYXJlIG5vdCBzaWduZWQgYnkgYSBrbm93biBjZXJ0aWZpY2F0ZSBhdXRo
+b3JpdHk8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
+ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNo
+Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFj
+aCI+NzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0NvbWJvQm94VGV4dCIgaWQ9ImRhbmVQb2xpY3lWYWx1ZSI+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxpdGVtcz4KICAgICAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5zbGF0YWJsZT0i
+eWVzIiBpZD0ibm9uZSI+Tm9uZTwvaXRlbT4KICAgICAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5z
+bGF0YWJsZT0ieWVzIiBpZD0ib3Bwb3J0dW5pc3RpYyI+QWNjZXB0IERBTkUgbWF0Y2hlczwvaXRlbT4K
+ICAgICAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5zbGF0YWJsZT0ieWVzIiBpZD0icmVxdWlyZSI+
+UmVxdWlyZSBEQU5FIG1hdGNoZXM8L2l0ZW0+CiAgICAgICAgICAgICAgICAgICAgPC9pdGVtcz4KICAg
+ICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjc8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9
+ImRuc3NlY1Jlc29sdmVyTGFiZWwiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5ETlNTRUMgdmFsaWRhdGluZyByZXNvbHZlcjwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdO
+X0VORDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2gi
+PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNo
+Ij44PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBj
+bGFzcz0iR3RrRW50cnkiIGlkPSJkbnNzZWNSZXNvbHZlciI+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5EZWZhdWx0
+IHJlc29sdmVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9v
+bHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+VGhlIGFkZHJlc3MgYW5kIHBvcnQgb2YgYSBETlMg
+c2VydmVyIG9uIHRoaXMgY29tcHV0ZXIgdGhhdCB2YWxpZGF0ZXMgRE5TU0VDLCBmb3IgZXhhbXBsZSAx
+MjcuMC4wLjE6NTMuIE9ubHkgaXRzIGFuc3dlcnMsIG9yIHRob3NlIG9mIGEgRE5TLW92ZXItSFRUUFMg
+ZW5kcG9pbnQsIGFyZSB0cnVzdGVkIHRvIGJlIGF1dGhlbnRpY2F0ZWQgLSBhIHNlcnZlciBlbHNld2hl
+cmUgaXMgcmVhY2hlZCBvdmVyIHRoZSBwcm94aWVzIG9mIHRoaXMgYWNjb3VudCwgYW5kIGl0cyBhbnN3
+ZXJzIGNvdWxkIGJlIGNoYW5nZWQgb24gdGhlIHdheS48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+ODwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0iZG5zUmVzb2x2ZXJM
+YWJlbCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPkROUyByZXNvbHZlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX0VORDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj45PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ29tYm9Cb3hUZXh0IiBpZD0iZG5z
+UmVzb2x2ZXJWYWx1ZSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxpdGVtcz4KICAgICAgICAgICAg
+ICAgICAgICAgIDxpdGVtIHRyYW5zbGF0YWJsZT0ieWVzIiBpZD0idGNwIj5ETlMgb3ZlciBUQ1AgdGhy
+b3VnaCB0aGUgcHJveGllczwvaXRlbT4KICAgICAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5zbGF0
+YWJsZT0ieWVzIiBpZD0iZG9oIj5ETlMgb3ZlciBIVFRQUyB0aHJvdWdoIHRoZSBwcm94aWVzPC9pdGVt
+PgogICAgICAgICAgICAgICAgICAgICAgPGl0ZW0gdHJhbnNsYXRhYmxlPSJ5ZXMiIGlkPSJ0b3IiPlRv
+cjwvaXRlbT4KICAgICAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5zbGF0YWJsZT0ieWVzIiBpZD0i
+c3lzdGVtIj5TeXN0ZW0gcmVzb2x2ZXI8L2l0ZW0+CiAgICAgICAgICAgICAgICAgICAgPC9pdGVtcz4K
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjk8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIg
+aWQ9ImRuc1Jlc29sdmVyQWRkcmVzc0xhYmVsIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RE5TIHNlcnZlcjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX0VORDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4xMDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0VudHJ5IiBpZD0iZG5zUmVzb2x2ZXJBZGRyZXNzIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0icGxhY2Vob2xkZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkRlZmF1bHQgc2Vy
+dmVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90
+ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+VGhlIGFkZHJlc3MgYW5kIHBvcnQgb2YgdGhlIEROUyBzZXJ2
+ZXIgZm9yIEROUyBvdmVyIFRDUCwgb3IgdGhlIFVSTCBvZiB0aGUgZW5kcG9pbnQgZm9yIEROUyBvdmVy
+IEhUVFBTPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAg
+ICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFj
+aCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRh
+Y2giPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrQ2hlY2tCdXR0b24iIGlkPSJkbnNGYWlsQ2xvc2VkIj4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5OZXZlciBtYWtlIERO
+UyBxdWVyaWVzIG91dHNpZGUgb2YgdGhlIHByb3hpZXM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxl
+PSJ5ZXMiPlJlZnVzZSB0byBjb25uZWN0IGluc3RlYWQgb2YgdXNpbmcgYSByZXNvbHZlciBvciBhIHBy
+b3h5IGFkZHJlc3MgdGhhdCB3b3VsZCBsZWFrIEROUyBxdWVyaWVzIG91dHNpZGUgb2YgdGhlIHByb3hp
+ZXM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJkcmF3X2luZGlj
+YXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1h
+dHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3At
+YXR0YWNoIj4xMTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxv
+YmplY3QgY2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBpZD0idG9yT25seSI+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+T25seSBjb25uZWN0IHRo
+cm91Z2ggVG9yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
+X2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cmVjZWl2ZXNfZGVmYXVsdCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5SZWZ1c2UgdG8gY29ubmVj
+dCB1bmxlc3MgdGhlIGZpcnN0IHByb3h5IGlzIGEgVG9yIHByb3h5IG9uIHRoaXMgY29tcHV0ZXI8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2gi
+PjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNo
+Ij4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBpZD0ia2VlcEhpc3RvcnkiPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPktlZXAgYW4gZW5jcnlwdGVk
+IGhpc3Rvcnkgb2YgY29udmVyc2F0aW9uczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+
+U3RvcmUgdGhlIG1lc3NhZ2VzIG9mIHRoaXMgYWNjb3VudCBvbiB0aGlzIGNvbXB1dGVyLCBlbmNyeXB0
+ZWQgd2l0aCB0aGUgbWFzdGVyIHBhc3N3b3JkLiBUaGlzIG9ubHkgd29ya3Mgd2hlbiB0aGUgY29uZmln
+dXJhdGlvbiBmaWxlIGlzIGVuY3J5cHRlZC48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4xMzwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0idG9yQ29udHJv
+bFBhc3N3b3JkTGFiZWwiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFi
+ZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Ub3IgY29udHJvbCBwYXNzd29yZDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX0VORDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4xNDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Vu
+dHJ5IiBpZD0idG9yQ29udHJvbFBhc3N3b3JkIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJpbGl0eSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5UaGUgcGFzc3dvcmQg
+Zm9yIHRoZSBjb250cm9sIHBvcnQgb2YgVG9yLCBpZiBpdCBhc2tzIGZvciBvbmUuIEl0IGlzIG9ubHkg
+c2F2ZWQgd2hlbiB0aGUgY29uZmlndXJhdGlvbiBmaWxlIGlzIGVuY3J5cHRlZC48L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MTQ8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJ0YWIiPgogICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGFiZWwtdGFiMiI+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5TZXJ2ZXI8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ0YWItZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwv
+cGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9InZib3gxIj4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJtYXJnaW4iPjU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImNhbi1mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ic3BhY2luZyI+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1BhbmVkIiBpZD0iaHBhbmVkMSI+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
 ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW4tZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2hhZG93LXR5cGUiPmluPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxv
-YmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3IiBpZD0icHJveGllcy12aWV3Ij4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RlbCI+cHJveGllcy1tb2RlbDwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuLWZv
-Y3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJoZWFkZXJzLXZpc2libGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzaG93LWV4cGFuZGVycyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlb3JkZXJhYmxlIj5UcnVlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0icm93LWFjdGl2
-YXRlZCIgaGFuZGxlcj0ib25fZWRpdF9hY3RpdmF0ZV9wcm94eSIgLz4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0ic2VsZWN0aW9uIj4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVNlbGVjdGlvbiIgaWQ9InNlbGVjdGlv
-biI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1vZGUiPkdU
-S19TRUxFQ1RJT05fU0lOR0xFPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0
-IGNsYXNzPSJHdGtUcmVlVmlld0NvbHVtbiIgaWQ9InByb3h5LW5hbWUtY29sdW1uIj4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGl0bGUiPnByb3h5LW5hbWU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NlbGxSZW5kZXJlclRleHQiIGlk
-PSJwcm94eS1uYW1lLWNvbHVtbi1yZW5kZXJlZCIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPGF0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRy
-aWJ1dGUgbmFtZT0idGV4dCI+MDwvYXR0cmlidXRlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAg
-ICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
-ICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJyZXNpemUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ic2hyaW5rIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-ICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAg
-ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJ2
-Ym94MyI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4iPjU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW4tZm9j
-dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-PGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIg
-aWQ9ImFkZF9idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+X0FkZC4uLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuLWZvY3VzIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlcy1k
-ZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ1c2VfdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fYWRkX3Byb3h5IiAvPgogICAg
-ICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-YWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
-RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3Bh
-Y2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAg
-ICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0
-b24iIGlkPSJyZW1vdmVfYnV0dG9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPl9SZW1vdmUuLi48L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTc1PC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtTY3JvbGxlZFdpbmRvdyIgaWQ9InNjcm9sbGVkd2luZG93MSI+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJoc2Nyb2xsYmFyLXBvbGljeSI+R1RLX1BPTElDWV9ORVZFUjwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2c2Nyb2xsYmFyLXBv
+bGljeSI+R1RLX1BPTElDWV9BVVRPTUFUSUM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0id2lkdGgtcmVxdWVzdCI+MTcwPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhlaWdodC1yZXF1ZXN0Ij4yMzA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luIj41PC9wcm9wZXJ0eT4K
 ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbi1mb2N1cyI+
-VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cmVjZWl2ZXMtZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX3JlbW92ZV9w
-cm94eSIgLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
-ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBj
-bGFzcz0iR3RrQnV0dG9uIiBpZD0iZWRpdF9idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+X0VkaXQuLi48L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
-bi1mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0icmVjZWl2ZXMtZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlLXVuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9u
-X2VkaXRfcHJveHkiIC8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
-ICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tp
-bmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZXNpemUiPkZhbHNlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNocmluayI+RmFs
-c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
-ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
-ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGls
-ZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-PC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQgdHlwZT0idGFi
-Ij4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxhYmVsLXRhYjMiPgog
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+UHJv
-eGllczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+
-CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRhYi1maWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+
-CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iZW5jcnlwdGlvbk9wdGlvbnNC
-b3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImJvcmRlci13aWR0aCI+MTA8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhvbW9nZW5lb3VzIj5mYWxzZTwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklF
-TlRBVElPTl9WRVJUSUNBTDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0iZmluZ2VycHJpbnRzTWVzc2FnZSI+
-CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imp1c3RpZnkiPkdUS19KVVNUSUZZX0xF
-RlQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPkdU
-S19BTElHTl9TVEFSVDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
-ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtHcmlkIiBpZD0iZW5jcnlwdGlvbkdyaWQiPgogICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luLWJvdHRvbSI+MTA8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4tc3RhcnQiPjEwPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luLWVuZCI+MTA8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyb3ctc3BhY2luZyI+MTI8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjb2x1bW4tc3BhY2luZyI+NjwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9i
-amVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJlbmNyeXB0aW9uSW1wb3J0SW5zdHJ1Y3Rpb25zIj4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
-bnNsYXRhYmxlPSJ5ZXMiPlRoZSBiZWxvdyBidXR0b25zIGFsbG93IHlvdSB0byBpbXBvcnQgcHJpdmF0
-ZSBrZXlzIGFuZCBmaW5nZXJwcmludHMuIEJvdGggb2YgdGhlbSBzaG91bGQgYmUgaW4gdGhlIFBpZGdp
-bi9saWJvdHIgZm9ybWF0LiBJZiB5b3UgaW1wb3J0IHByaXZhdGUga2V5cywgeW91ciBleGlzdGluZyBw
-cml2YXRlIGtleXMgd2lsbCBiZSBkZWxldGVkLCBzaW5jZSBjdXJyZW50bHkgdGhlcmUgaXMgbm8gd2F5
-IHRvIGNob29zZSB3aGljaCBrZXkgdG8gdXNlIGZvciBlbmNyeXB0ZWQgY2hhdC4KClRoZXJlIGFyZSBz
-ZXZlcmFsIGFwcGxpY2F0aW9ucyB0aGF0IHVzZSB0aGUgbGlib3RyIGZvcm1hdCwgc3VjaCBhcyBQaWRn
-aW4sIEFkaXVtIG9yIFRvciBNZXNzZW5nZXIuIERlcGVuZGluZyBvbiB5b3VyIHBsYXRmb3JtLCB0aGVz
-ZSBmaWxlcyBjYW4gYmUgZm91bmQgaW4gc2V2ZXJhbCBkaWZmZXJlbnQgcGxhY2VzLiBSZWZlciB0byB0
-aGUgZG9jdW1lbnRhdGlvbiBmb3IgdGhlIGFwcGxpY2F0aW9uIGluIHF1ZXN0aW9uIHRvIGZpbmQgb3V0
-IHdoZXJlIHRoZSBmaWxlcyBhcmUgbG9jYXRlZCBmb3IgeW91ciBwbGF0Zm9ybS4gVGhlIGZpbGVuYW1l
-cyB0byBsb29rIGZvciBhcmUgIm90ci5maW5nZXJwcmludHMiIGFuZCAib3RyLnByaXZhdGVfa2V5Ii48
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
-dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFw
-Ij50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
-eC13aWR0aC1jaGFycyI+NTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGV4cGFuZCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2ZXhwYW5kIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbi1m
+b2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJzaGFkb3ctdHlwZSI+aW48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXciIGlkPSJw
+cm94aWVzLXZpZXciPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1v
+ZGVsIj5wcm94aWVzLW1vZGVsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW4tZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhlYWRlcnMtdmlzaWJsZSI+RmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNob3ctZXhw
+YW5kZXJzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icmVvcmRlcmFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHNpZ25hbCBuYW1lPSJyb3ctYWN0aXZhdGVkIiBoYW5kbGVyPSJvbl9lZGl0X2FjdGl2YXRl
+X3Byb3h5IiAvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxk
+PSJzZWxlY3Rpb24iPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtUcmVlU2VsZWN0aW9uIiBpZD0ic2VsZWN0aW9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibW9kZSI+R1RLX1NFTEVDVElPTl9TSU5HTEU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0i
+cHJveHktbmFtZS1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ0aXRsZSI+cHJveHktbmFtZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBj
+bGFzcz0iR3RrQ2VsbFJlbmRlcmVyVGV4dCIgaWQ9InByb3h5LW5hbWUtY29sdW1uLXJlbmRlcmVkIi8+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlcz4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ0ZXh0Ij4wPC9hdHRyaWJ1dGU+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlc2l6ZSI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzaHJpbmsiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAg
+ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9InZib3gzIj4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9Im1hcmdpbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbi1mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjY8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYWRkX2J1dHRvbiI+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5fQWRk
+Li4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJjYW4tZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzLWRlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InVzZV91bmRlcmxpbmUiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBo
+YW5kbGVyPSJvbl9hZGRfcHJveHkiIC8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9InJlbW92ZV9idXR0b24iPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9Inll
+cyI+X1JlbW92ZS4uLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuLWZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlcy1kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ1c2VfdW5kZXJsaW5l
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0i
+Y2xpY2tlZCIgaGFuZGxlcj0ib25fcmVtb3ZlX3Byb3h5IiAvPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4x
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJlZGl0X2J1dHRv
+biI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5fRWRpdC4uLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuLWZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlcy1kZWZhdWx0Ij5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ1c2Ut
+dW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWdu
+YWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fZWRpdF9wcm94eSIgLz4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
 CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGgiPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAg
-ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9u
-IiBpZD0iaW1wb3J0X2tleV9idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5JbXBvcnQgUHJpdmF0ZSBfS2V5cy4uLjwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbi1mb2N1
-cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJy
-ZWNlaXZlcy1kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InVzZV91bmRlcmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2ltcG9ydF9rZXkiIC8+CiAg
+b3BlcnR5IG5hbWU9InJlc2l6ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ic2hyaW5rIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3Bh
+Y2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
+Ij4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJ0YWIiPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0xhYmVsIiBpZD0ibGFiZWwtdGFiMyI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Qcm94aWVzPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29i
+amVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFi
+LWZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCb3giIGlkPSJlbmNyeXB0aW9uT3B0aW9uc0JveCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iYm9yZGVyLXdpZHRoIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaG9tb2dlbmVvdXMiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX1ZFUlRJQ0FMPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFi
+ZWwiIGlkPSJmaW5nZXJwcmludHNNZXNzYWdlIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ianVzdGlmeSI+R1RLX0pVU1RJRllfTEVGVDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX1NUQVJUPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0dyaWQiIGlkPSJlbmNy
+eXB0aW9uR3JpZCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4t
+Ym90dG9tIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
+cmdpbi1zdGFydCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJtYXJnaW4tZW5kIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InJvdy1zcGFjaW5nIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImNvbHVtbi1zcGFjaW5nIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImVuY3J5
+cHRpb25JbXBvcnRJbnN0cnVjdGlvbnMiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+VGhlIGJlbG93IGJ1dHRv
+bnMgYWxsb3cgeW91IHRvIGltcG9ydCBwcml2YXRlIGtleXMgYW5kIGZpbmdlcnByaW50cy4gQm90aCBv
+ZiB0aGVtIHNob3VsZCBiZSBpbiB0aGUgUGlkZ2luL2xpYm90ciBmb3JtYXQuIElmIHlvdSBpbXBvcnQg
+cHJpdmF0ZSBrZXlzLCB5b3VyIGV4aXN0aW5nIHByaXZhdGUga2V5cyB3aWxsIGJlIGRlbGV0ZWQsIHNp
+bmNlIGN1cnJlbnRseSB0aGVyZSBpcyBubyB3YXkgdG8gY2hvb3NlIHdoaWNoIGtleSB0byB1c2UgZm9y
+IGVuY3J5cHRlZCBjaGF0LgoKVGhlcmUgYXJlIHNldmVyYWwgYXBwbGljYXRpb25zIHRoYXQgdXNlIHRo
+ZSBsaWJvdHIgZm9ybWF0LCBzdWNoIGFzIFBpZGdpbiwgQWRpdW0gb3IgVG9yIE1lc3Nlbmdlci4gRGVw
+ZW5kaW5nIG9uIHlvdXIgcGxhdGZvcm0sIHRoZXNlIGZpbGVzIGNhbiBiZSBmb3VuZCBpbiBzZXZlcmFs
+IGRpZmZlcmVudCBwbGFjZXMuIFJlZmVyIHRvIHRoZSBkb2N1bWVudGF0aW9uIGZvciB0aGUgYXBwbGlj
+YXRpb24gaW4gcXVlc3Rpb24gdG8gZmluZCBvdXQgd2hlcmUgdGhlIGZpbGVzIGFyZSBsb2NhdGVkIGZv
+ciB5b3VyIHBsYXRmb3JtLiBUaGUgZmlsZW5hbWVzIHRvIGxvb2sgZm9yIGFyZSAib3RyLmZpbmdlcnBy
+aW50cyIgYW5kICJvdHIucHJpdmF0ZV9rZXkiLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWF4LXdpZHRoLWNoYXJzIj41MDwvcHJvcGVydHk+CiAg
 ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5n
 PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjA8L3By
 b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+
-MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAg
+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aCI+
+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAg
 ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
-ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJpbXBvcnRfZnByX2J1dHRvbiI+CiAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJpbXBvcnRfa2V5X2J1dHRvbiI+CiAgICAg
 ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMi
-PkltcG9ydCBfRmluZ2VycHJpbnRzLi4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+PkltcG9ydCBQcml2YXRlIF9LZXlzLi4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
 PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
 ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuLWZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
 ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzLWRlZmF1bHQiPlRydWU8L3Byb3BlcnR5
 PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1
 ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIg
-aGFuZGxlcj0ib25faW1wb3J0X2ZwciIgLz4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
+aGFuZGxlcj0ib25faW1wb3J0X2tleSIgLz4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
 ICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
 IDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
 ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAg
-ICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBp
-ZD0iZW5jcnlwdGlvbkV4cG9ydEluc3RydWN0aW9ucyI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+VGhlIGJlbG93IGJ1
-dHRvbnMgYWxsb3cgeW91IHRvIGV4cG9ydCBwcml2YXRlIGtleXMgYW5kIGZpbmdlcnByaW50cy4gQmUg
-Y2FyZWZ1bCB3aXRoIHRoZSBmaWxlcyB0aGF0IGNvbWUgb3V0IG9mIHRoaXMgcHJvY2VzcyBhcyB0aGV5
-IGNvbnRhaW4gcG90ZW50aWFsbHkgc2Vuc2l0aXZlIGRhdGEuIFRoZSBleHBvcnQgd2lsbCBiZSBpbiB0
-aGUgUGlkZ2luL2xpYm90ciBmb3JtYXQuPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InZpc2libGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXgtd2lkdGgtY2hhcnMiPjUwPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4yPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoIj4yPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAg
-ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
-IDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImV4cG9ydF9rZXlfYnV0dG9uIj4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RXhw
-b3J0IFByaXZhdGUgS2V5cy4uLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImNhbi1mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlcy1kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9leHBv
-cnRfa2V5IiAvPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
-ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQt
-YXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InRvcC1hdHRhY2giPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
-ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
-ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iZXhwb3J0X2Zwcl9i
-dXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
-bGF0YWJsZT0ieWVzIj5FeHBvcnQgRmluZ2VycHJpbnRzLi4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuLWZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzLWRlZmF1bHQiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQi
-IGhhbmRsZXI9Im9uX2V4cG9ydF9mcHIiIC8+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
-ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9w
-YWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
-biI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxk
-PgogICAgICAgICAgICA8Y2hpbGQgdHlwZT0idGFiIj4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtMYWJlbCIgaWQ9ImxhYmVsLXRhYjQiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RW5jcnlwdGlvbjwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-PC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InRhYi1maWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
-ICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9j
-aGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgogICAgICA8Y2xhc3Mg
-bmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+ICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIg
+aWQ9ImltcG9ydF9mcHJfYnV0dG9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SW1wb3J0IF9GaW5nZXJwcmludHMuLi48L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW4tZm9jdXMi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVj
+ZWl2ZXMtZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ1c2VfdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9pbXBvcnRfZnByIiAvPgogICAg
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4xPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjE8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJlbmNyeXB0aW9uRXhwb3J0SW5zdHJ1Y3Rpb25z
+Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRy
+YW5zbGF0YWJsZT0ieWVzIj5UaGUgYmVsb3cgYnV0dG9ucyBhbGxvdyB5b3UgdG8gZXhwb3J0IHByaXZh
+dGUga2V5cyBhbmQgZmluZ2VycHJpbnRzLiBCZSBjYXJlZnVsIHdpdGggdGhlIGZpbGVzIHRoYXQgY29t
+ZSBvdXQgb2YgdGhpcyBwcm9jZXNzIGFzIHRoZXkgY29udGFpbiBwb3RlbnRpYWxseSBzZW5zaXRpdmUg
+ZGF0YS4gVGhlIGV4cG9ydCB3aWxsIGJlIGluIHRoZSBQaWRnaW4vbGlib3RyIGZvcm1hdC48L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+dHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1heC13aWR0
+aC1jaGFycyI+NTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
+ICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxlZnQtYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0id2lkdGgiPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0i
+ZXhwb3J0X2tleV9idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5FeHBvcnQgUHJpdmF0ZSBLZXlzLi4uPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuLWZvY3VzIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVz
+LWRlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5h
+bWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2V4cG9ydF9rZXkiIC8+CiAgICAgICAgICAgICAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MzwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
+ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCdXR0b24iIGlkPSJleHBvcnRfZnByX2J1dHRvbiI+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4cG9ydCBGaW5nZXJwcmlu
+dHMuLi48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
+aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJjYW4tZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icmVjZWl2ZXMtZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fZXhwb3J0X2ZwciIgLz4KICAg
+ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4z
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
+ICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAg
+PHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxk
+PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJ0YWIi
+PgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGFiZWwtdGFiNCI+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5FbmNy
+eXB0aW9uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2lu
+Zz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFiLWZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVj
+dD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAg
+ICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hp
+bGQ+CiAgICA8c3R5bGU+CiAgICAgIDxjbGFzcyBuYW1lPSJkZWNveWltIi8+CiAgICA8L3N0eWxlPgog
+IDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 `,
 	},
 
@@ -1800,6 +1992,72 @@ aW0iLz4KICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
+	"/definitions/ChangeMainPassword.xml": {
+		local:   "definitions/ChangeMainPassword.xml",
+		size:    3472,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJDaGFuZ2VNYWluUGFzc3dv
+cmQiPgogICAgPHByb3BlcnR5IG5hbWU9IndpbmRvdy1wb3NpdGlvbiI+R1RLX1dJTl9QT1NfQ0VOVEVS
+PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJib3JkZXJfd2lkdGgiPjc8L3Byb3BlcnR5Pgog
+ICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+Q2hhbmdlIG1haW4gcGFz
+c3dvcmQ8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImRlZmF1bHQtd2lkdGgiPjMwMDwvcHJv
+cGVydHk+CiAgICA8c2lnbmFsIG5hbWU9ImNsb3NlIiBoYW5kbGVyPSJvbl9jYW5jZWwiIC8+CiAgICA8
+Y2hpbGQgaW50ZXJuYWwtY2hpbGQ9InZib3giPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlk
+PSJWYm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luIj4xMDwvcHJvcGVydHk+CiAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjEwPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaG9tb2dlbmVvdXMiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+b3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9WRVJUSUNBTDwvcHJvcGVydHk+CiAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJwYXNzTWVzc2FnZSIgPgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5QbGVhc2Ug
+ZW50ZXIgdGhlIGN1cnJlbnQgbWFpbiBwYXNzd29yZCwgYW5kIHRoZSBuZXcgb25lIHR3aWNlLjwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8
+L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnkiIGlkPSJjdXJyZW50UGFzc3dvcmQi
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFzLWZvY3VzIj50cnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2liaWxpdHkiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyLXRleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5D
+dXJyZW50IHBhc3N3b3JkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0
+ZSIgaGFuZGxlcj0ib25fc2F2ZSIgLz4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+RW50cnkiIGlkPSJwYXNzd29yZCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmlsaXR5
+Ij5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRlci10
+ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+TmV3IHBhc3N3b3JkPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+PHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25fc2F2ZSIgLz4KICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQi
+PmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5Pgog
+ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnkiIGlkPSJwYXNzd29yZDIiPgogICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0idmlzaWJpbGl0eSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icGxhY2Vob2xkZXItdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlJlcGVhdCB0aGUgbmV3
+IHBhc3N3b3JkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFu
+ZGxlcj0ib25fc2F2ZSIgLz4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
+IDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICAgIDxjaGlsZCBpbnRlcm5hbC1j
+aGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b25Cb3giIGlkPSJi
+dXR0b25fYm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklFTlRB
+VElPTl9IT1JJWk9OVEFMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJjYW5jZWwiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5DYW5jZWw8L3Byb3BlcnR5PgogICAgICAgICAgICA8c2ln
+bmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4KICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrQnV0dG9uIiBpZD0ic2F2ZSI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPk9LPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlj
+a2VkIiBoYW5kbGVyPSJvbl9zYXZlIiAvPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9jaGls
+ZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgogICAgICA8Y2xhc3MgbmFt
+ZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+`,
+	},
+
 	"/definitions/ChangePassword.xml": {
 		local:   "definitions/ChangePassword.xml",
 		size:    11270,
@@ -2126,6 +2384,45 @@ Y3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
+	"/definitions/ConfirmHistoryRemoval.xml": {
+		local:   "definitions/ConfirmHistoryRemoval.xml",
+		size:    698,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtNZXNzYWdlRGlhbG9nIiBpZD0iUmVtb3ZlSGlz
+dG9yeSI+CiAgICA8cHJvcGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5UdXJuIG9m
+ZiBlbmNyeXB0aW9uPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJib3JkZXJfd2lkdGgiPjc8
+L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InRleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5UaGUg
+aGlzdG9yeSBvZiBjb252ZXJzYXRpb25zIGNhbiBvbmx5IGJlIHJlYWQgd2l0aCB0aGUgbWFpbiBwYXNz
+d29yZC4gSWYgeW91IHR1cm4gb2ZmIHRoZSBlbmNyeXB0aW9uIG9mIHRoZSBjb25maWd1cmF0aW9uIGZp
+bGUsIHRoZSBoaXN0b3J5IHdpbGwgYmUgcmVtb3ZlZC4gQXJlIHlvdSBzdXJlIHlvdSB3YW50IHRvIGNv
+bnRpbnVlPzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0id2luZG93LXBvc2l0aW9uIj5HVEtf
+V0lOX1BPU19DRU5URVI8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9Im1vZGFsIj50cnVlPC9w
+cm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJtZXNzYWdlLXR5cGUiPkdUS19NRVNTQUdFX1dBUk5J
+Tkc8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImJ1dHRvbnMiPkdUS19CVVRUT05TX1lFU19O
+TzwvcHJvcGVydHk+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+`,
+	},
+
+	"/definitions/ConfirmProfileRemoval.xml": {
+		local:   "definitions/ConfirmProfileRemoval.xml",
+		size:    623,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtNZXNzYWdlRGlhbG9nIiBpZD0iUmVtb3ZlUHJv
+ZmlsZSI+CiAgICA8cHJvcGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5Db25maXJt
+IHByb2ZpbGUgcmVtb3ZhbDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRo
+Ij43PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+
+QXJlIHlvdSBzdXJlIHlvdSB3YW50IHRvIHJlbW92ZSB0aGlzIHByb2ZpbGU/IEl0cyBhY2NvdW50cywg
+aGlzdG9yeSBhbmQgYWxsIGl0cyBvdGhlciBkYXRhIHdpbGwgYmUgcmVtb3ZlZCB0b28uPC9wcm9wZXJ0
+eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRFUjwv
+cHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ibW9kYWwiPnRydWU8L3Byb3BlcnR5PgogICAgPHBy
+b3BlcnR5IG5hbWU9Im1lc3NhZ2UtdHlwZSI+R1RLX01FU1NBR0VfUVVFU1RJT048L3Byb3BlcnR5Pgog
+ICAgPHByb3BlcnR5IG5hbWU9ImJ1dHRvbnMiPkdUS19CVVRUT05TX1lFU19OTzwvcHJvcGVydHk+CiAg
+PC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+`,
+	},
+
 	"/definitions/ConnectingAccountInfo.xml": {
 		local:   "definitions/ConnectingAccountInfo.xml",
 		size:    873,
@@ -2211,7 +2508,7 @@ PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 
 	"/definitions/ConnectionInformation.xml": {
 		local:   "definitions/ConnectionInformation.xml",
-		size:    6458,
+		size:    7747,
 		modtime: 1489449600,
 		compressed: `
 PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJDb25uZWN0aW9uSW5mb3Jt
@@ -2305,23 +2602,45 @@ CiAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
 ICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRh
 Y2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2gi
 PjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4K
-ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIg
-aWQ9InBpbi1jZXJ0Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
-YXRhYmxlPSJ5ZXMiPlBpbiBjZXJ0aWZpY2F0ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2ln
-bmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX3BpbiIvPgogICAgICAgICAgICAgIDwvb2JqZWN0
-PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxl
-ZnQtYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3At
-YXR0YWNoIj40PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwv
-Y2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4K
-ICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQgaW50ZXJuYWwtY2hpbGQ9ImFjdGlvbl9hcmVhIj4KICAgICAg
-PG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uQm94IiBpZD0iYnV0dG9uX2JveCI+CiAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9Im9yaWVudGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fSE9SSVpPTlRBTDwvcHJvcGVydHk+
-CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iY2xv
-c2UiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5D
-bG9zZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0i
-b25fY2xvc2UiLz4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2Jq
-ZWN0PgogICAgPC9jaGlsZD4KICAgIDxzdHlsZT4KICAgICAgPGNsYXNzIG5hbWU9ImRlY295aW0iLz4K
-ICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBp
+ZD0iZGFuZUxhYmVsIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPkRBTkU6PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ2YWxpZ24iPkdUS19BTElHTl9TVEFSVDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iaGFsaWduIj5HVEtfQUxJR05fU1RBUlQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9Imp1c3RpZnkiPkdUS19KVVNUSUZZX0xFRlQ8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRSVUU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj40PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImRhbmVWYWx1ZSI+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj48L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX1NUQVJUPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJqdXN0aWZ5Ij5HVEtfSlVTVElGWV9MRUZUPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UUlVFPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXhfd2lkdGhfY2hhcnMiPjUwPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+NDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9w
+YWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0icGluLWNlcnQiPgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+UGluIGNlcnRpZmljYXRlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25f
+cGluIi8+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICAgIDxjaGlsZCBpbnRlcm5h
+bC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b25Cb3giIGlk
+PSJidXR0b25fYm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklF
+TlRBVElPTl9IT1JJWk9OVEFMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJjbG9zZSI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNsb3NlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNp
+Z25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9jbG9zZSIvPgogICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgog
+ICAgICA8Y2xhc3MgbmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRl
+cmZhY2U+Cg==
 `,
 	},
 
@@ -2398,7 +2717,7 @@ YXNzIG5hbWU9ImRlY295aW0iLz4KICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 
 	"/definitions/ConversationPane.xml": {
 		local:   "definitions/ConversationPane.xml",
-		size:    15117,
+		size:    16755,
 		modtime: 1489449600,
 		compressed: `
 PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJib3giPgogICAgPHByb3BlcnR5
@@ -2434,225 +2753,253 @@ ZXJNZW51Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+dHJ1ZTwv
 cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
 dGFibGU9InllcyI+VmVyaWZ5IGZpbmdlcnByaW50PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
 ICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl92ZXJpZnlfZnAiIC8+CiAgICAgICAg
-ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8
-L29iamVjdD4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwv
-Y2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0i
-IGlkPSJ0cmFuc2Zlck1lbnUiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+dHJ1
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtMYWJlbCIgaWQ9InRyYW5zZmVyTGFiZWxUYWciPgogICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+VHJhbnNmZXI8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9InVzZS11bmRlcmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQgdHlw
-ZT0ic3VibWVudSI+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudSIgaWQ9InRyYW5z
-ZmVyTWVudVN1Ym1lbnUiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPnRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2Jq
-ZWN0IGNsYXNzPSJHdGtNZW51SXRlbSIgaWQ9InNlbmRGaWxlTWVudUl0ZW0iPgogICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5TZW5kIEZpbGU8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRs
-ZXI9Im9uX3NlbmRfZmlsZV90b19jb250YWN0IiAvPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
-ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0ic2VuZERpck1lbnVJdGVtIj4KICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+dHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
-U2VuZCBEaXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZh
-dGUiIGhhbmRsZXI9Im9uX3NlbmRfZGlyX3RvX2NvbnRhY3QiIC8+CiAgICAgICAgICAgICAgICAgIDwv
-b2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAg
-IDwvb2JqZWN0PgogICAgICA8cGFja2luZz4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5m
-YWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5
-PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrLXR5cGUiPkdUS19QQUNLX1NUQVJUPC9wcm9wZXJ0
-eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICA8L3Bh
-Y2tpbmc+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3gi
-IGlkPSJub3RpZmljYXRpb24tYXJlYSI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
-RmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9W
-RVJUSUNBTDwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0i
-R3RrQm94IiBpZD0ic2VjdXJpdHktd2FybmluZyI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
-aXNpYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
-dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjM3
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
-Ikd0a0ltYWdlIiBpZD0iaW1hZ2Utc2VjdXJpdHktd2FybmluZyI+CiAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
-bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5U
-cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAg
-ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJs
-YWJlbC1zZWN1cml0eS13YXJuaW5nIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNp
-YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
-dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAg
-ICAgICAgICA8YXR0cmlidXRlIG5hbWU9IndlaWdodCIgdmFsdWU9ImJvbGQiIC8+CiAgICAgICAgICAg
-ICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBh
-Y2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgog
-ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ1dHRvbi1zZWN1cml0eS13
-YXJuaW5nIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjEwPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xNDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MTI8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtMYWJlbCIgaWQ9ImJ1dHRvbi1sYWJlbC1zZWN1cml0eS13YXJuaW5nIj4KICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MjM8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjIzPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVz
-Ij5TZWN1cmUgQ2hhdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
-ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFu
-ZGxlcj0ib25fc3RhcnRfb3RyIiAvPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
-IDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+
-CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
-c2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPnN0YXJ0PC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAg
-IDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0JveCIgaWQ9ImZpbGUtdHJhbnNmZXIiPgogICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0idmlzaWJsZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFj
-aW5nIj4zNzwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0
-IGNsYXNzPSJHdGtJbWFnZSIgaWQ9ImltYWdlLWZpbGUtdHJhbnNmZXIiPgogICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmpl
-Y3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
-Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlk
-PSJpbmZvLWZpbGUtdHJhbnNmZXIiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
-bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
-cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90
-b3AiPjE0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlv
-biI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
-ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxhYmVsLWZpbGUtdHJhbnNmZXIiPgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgPGF0
-dHJpYnV0ZSBuYW1lPSJ3ZWlnaHQiIHZhbHVlPSJib2xkIiAvPgogICAgICAgICAgICAgICAgICAgIDwv
-YXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxw
-YWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4K
-ICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
-UHJvZ3Jlc3NCYXIiIGlkPSJiYXItZmlsZS10cmFuc2ZlciI+CiAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InNob3dfdGV4dCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8
-L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0Pgog
-ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
-ZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPkZh
-bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAg
-ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlkPSJl
+eHBvcnRIaXN0b3J5TWVudSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJl
+bCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4cG9ydCBoaXN0b3J5Li4uPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9leHBvcnRfaGlzdG9y
+eSIgLz4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtNZW51SXRlbSIgaWQ9InRyYW5zZmVyTWVudSI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ2aXNpYmxlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0idHJhbnNmZXJMYWJlbFRhZyI+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5UcmFuc2ZlcjwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlLXVuZGVybGluZSI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgIDxjaGlsZCB0eXBlPSJzdWJtZW51Ij4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtN
+ZW51IiBpZD0idHJhbnNmZXJNZW51U3VibWVudSI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idmlzaWJsZSI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0ic2VuZEZpbGVNZW51SXRlbSI+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPnRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5
+ZXMiPlNlbmQgRmlsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJh
+Y3RpdmF0ZSIgaGFuZGxlcj0ib25fc2VuZF9maWxlX3RvX2NvbnRhY3QiIC8+CiAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlkPSJzZW5kRGly
+TWVudUl0ZW0iPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5TZW5kIERpcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25h
+bCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25fc2VuZF9kaXJfdG9fY29udGFjdCIgLz4KICAgICAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+PC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICAgIDxwYWNraW5nPgogICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2stdHlwZSI+R1RLX1BBQ0tf
+U1RBUlQ8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVy
+dHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0JveCIgaWQ9Im5vdGlmaWNhdGlvbi1hcmVhIj4KICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RL
+X09SSUVOVEFUSU9OX1ZFUlRJQ0FMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJzZWN1cml0eS13YXJuaW5nIj4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ic3BhY2luZyI+Mzc8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrSW1hZ2UiIGlkPSJpbWFnZS1zZWN1cml0eS13YXJuaW5nIj4KICAgICAg
 ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
 ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xNDwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9u
-IiBpZD0iYnV0dG9uLWZpbGUtdHJhbnNmZXIiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlk
-PSJidXR0b24tbGFiZWwtZmlsZS10cmFuc2ZlciI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjM3PC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+Mzc8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+NTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj41
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
-ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5k
-bGVyPSJvbl9kZXN0cm95X2ZpbGVfdHJhbnNmZXIiIC8+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0
-PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
-ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFja190eXBlIj5lbmQ8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29i
-amVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAg
-ICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8c3R5bGU+CiAgICAgICAgICA8
-Y2xhc3MgbmFtZT0ibm90aWZpY2F0aW9ucyIgLz4KICAgICAgICA8L3N0eWxlPgogICAgICA8L29iamVj
-dD4KICAgICAgPHBhY2tpbmc+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3By
-b3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5mYWxzZTwvcHJvcGVydHk+CiAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InBhY2stdHlwZSI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwvY2hp
-bGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1Njcm9sbGVkV2luZG93IiBpZD0i
-aGlzdG9yeVNjcm9sbCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPnRydWU8L3Byb3Bl
-cnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2c2Nyb2xsYmFyLXBvbGljeSI+R1RLX1BPTElDWV9B
-VVRPTUFUSUM8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoc2Nyb2xsYmFyLXBvbGlj
-eSI+R1RLX1BPTElDWV9BVVRPTUFUSUM8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAg
-IDxvYmplY3QgY2xhc3M9Ikd0a1RleHRWaWV3IiBpZD0iaGlzdG9yeSI+CiAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9IndyYXAtbW9kZSI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJlZGl0
-YWJsZSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY3Vyc29yLXZp
-c2libGUiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBpeGVscy1i
-ZWxvdy1saW5lcyI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LW1h
-cmdpbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyaWdodC1tYXJnaW4i
-PjU8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9v
-YmplY3Q+CiAgICAgIDxwYWNraW5nPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPnRydWU8
-L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0icGFjay10eXBlIj5HVEtfUEFDS19FTkQ8L3Byb3BlcnR5PgogICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgIDwvcGFja2luZz4K
-ICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1Njcm9sbGVkV2lu
-ZG93IiBpZD0icGVuZGluZ1Njcm9sbCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZzY3JvbGxiYXIt
-cG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImhzY3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9Im1pbi1jb250ZW50LWhlaWdodCI+NjA8L3Byb3BlcnR5PgogICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJzaGFkb3ctdHlwZSI+aW48L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RleHRWaWV3IiBpZD0icGVuZGluZyI+CiAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9IndyYXAtbW9kZSI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJlZGl0YWJsZSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-Y3Vyc29yLXZpc2libGUiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InBpeGVscy1iZWxvdy1saW5lcyI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJsZWZ0LW1hcmdpbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyaWdo
-dC1tYXJnaW4iPjU8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9jaGlsZD4K
-ICAgICAgPC9vYmplY3Q+CiAgICAgIDxwYWNraW5nPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
-bmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJv
-cGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2stdHlwZSI+R1RLX1BBQ0tfRU5EPC9wcm9w
-ZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICA8
-L3BhY2tpbmc+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtT
-Y3JvbGxlZFdpbmRvdyIgaWQ9Im1lc3NhZ2VTY3JvbGwiPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
-aXNpYmxlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idnNjcm9sbGJhci1w
-b2xpY3kiPkdUS19QT0xJQ1lfQVVUT01BVElDPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iaHNjcm9sbGJhci1wb2xpY3kiPkdUS19QT0xJQ1lfQVVUT01BVElDPC9wcm9wZXJ0eT4KICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ic2hhZG93LXR5cGUiPmluPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+
-CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUZXh0VmlldyIgaWQ9Im1lc3NhZ2UiPgogICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJoYXMtZm9jdXMiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0id3JhcC1tb2RlIj5HVEtfV1JBUF9XT1JEX0NIQVI8L3Byb3BlcnR5PgogICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZWRpdGFibGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ibGVmdC1tYXJnaW4iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0icmlnaHQtbWFyZ2luIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
-IDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgICA8cGFja2luZz4KICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
-PnRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrLXR5cGUiPkdUS19QQUNL
-X0VORDwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0
-eT4KICAgICAgPC9wYWNraW5nPgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtMYWJlbCIgaWQ9ImxhYmVsLXNlY3VyaXR5LXdhcm5pbmciPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGF0dHJpYnV0
+ZXM+CiAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0id2VpZ2h0IiB2YWx1ZT0iYm9sZCIg
+Lz4KICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQi
+PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnV0
+dG9uLXNlY3VyaXR5LXdhcm5pbmciPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9y
+aWdodCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90
+b3AiPjE0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90
+dG9tIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0iYnV0dG9uLWxhYmVsLXNlY3VyaXR5LXdhcm5pbmci
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMzwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+
+MjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPlNlY3VyZSBDaGF0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1l
+PSJjbGlja2VkIiBoYW5kbGVyPSJvbl9zdGFydF9vdHIiIC8+CiAgICAgICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFja190eXBlIj5l
+bmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+c3Rh
+cnQ8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgog
+ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iZmlsZS10cmFuc2ZlciI+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InNwYWNpbmciPjM3PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0ltYWdlIiBpZD0iaW1hZ2UtZmlsZS10cmFuc2ZlciI+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0JveCIgaWQ9ImluZm8tZmlsZS10cmFuc2ZlciI+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ibWFyZ2luX3RvcCI+MTQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGFiZWwtZmlsZS10
+cmFuc2ZlciI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5z
+dGFydDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAgICAgICAgICAg
+ICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9IndlaWdodCIgdmFsdWU9ImJvbGQiIC8+CiAgICAgICAg
+ICAgICAgICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
+cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJm
+aWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9z
+aXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+ICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtQcm9ncmVzc0JhciIgaWQ9ImJhci1maWxlLXRyYW5zZmVyIj4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj42PC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2hvd190ZXh0Ij5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjE0PC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
+YXNzPSJHdGtCdXR0b24iIGlkPSJidXR0b24tZmlsZS10cmFuc2ZlciI+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+NzwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtMYWJlbCIgaWQ9ImJ1dHRvbi1sYWJlbC1maWxlLXRyYW5zZmVyIj4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+Mzc8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4z
+NzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5f
+dG9wIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
+cmdpbl9ib3R0b20iPjU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9
+ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2Rlc3Ryb3lfZmlsZV90cmFuc2ZlciIgLz4KICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5
+cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24i
+PjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlw
+ZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9w
+cm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxzdHls
+ZT4KICAgICAgICAgIDxjbGFzcyBuYW1lPSJub3RpZmljYXRpb25zIiAvPgogICAgICAgIDwvc3R5bGU+
+CiAgICAgIDwvb2JqZWN0PgogICAgICA8cGFja2luZz4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPmZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFjay10eXBlIj5zdGFydDwvcHJvcGVydHk+
+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgPC9wYWNr
+aW5nPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrU2Nyb2xs
+ZWRXaW5kb3ciIGlkPSJoaXN0b3J5U2Nyb2xsIj4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
+ZSI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZzY3JvbGxiYXItcG9saWN5
+Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Imhz
+Y3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVGV4dFZpZXciIGlkPSJoaXN0b3J5Ij4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0id3JhcC1tb2RlIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImVkaXRhYmxlIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJjdXJzb3ItdmlzaWJsZSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icGl4ZWxzLWJlbG93LWxpbmVzIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxlZnQtbWFyZ2luIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InJpZ2h0LW1hcmdpbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2No
+aWxkPgogICAgICA8L29iamVjdD4KICAgICAgPHBhY2tpbmc+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8
+L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrLXR5cGUiPkdUS19QQUNLX0VORDwv
+cHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAg
+ICAgPC9wYWNraW5nPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrU2Nyb2xsZWRXaW5kb3ciIGlkPSJwZW5kaW5nU2Nyb2xsIj4KICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idnNjcm9sbGJhci1wb2xpY3kiPkdUS19QT0xJQ1lfQVVUT01BVElDPC9wcm9wZXJ0eT4KICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iaHNjcm9sbGJhci1wb2xpY3kiPkdUS19QT0xJQ1lfQVVUT01BVElDPC9w
+cm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWluLWNvbnRlbnQtaGVpZ2h0Ij42MDwvcHJv
+cGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InNoYWRvdy10eXBlIj5pbjwvcHJvcGVydHk+CiAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVGV4dFZpZXciIGlkPSJwZW5k
+aW5nIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPnRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcC1tb2RlIj4yPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImVkaXRhYmxlIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjdXJzb3ItdmlzaWJsZSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icGl4ZWxzLWJlbG93LWxpbmVzIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImxlZnQtbWFyZ2luIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InJpZ2h0LW1hcmdpbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgICAgPHBhY2tpbmc+CiAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJm
+aWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFjay10eXBlIj5HVEtf
+UEFDS19FTkQ8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJv
+cGVydHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a01lbnVCdXR0b24iIGlkPSJjYW5jZWxRdWV1ZWRCdXR0b24iPgogICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNhbmNlbCBxdWV1ZWQgbWVzc2Fn
+ZXM8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPkdUS19BTElHTl9FTkQ8
+L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwLXRleHQiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5DaG9vc2UgdGhlIG1lc3NhZ2VzIHdhaXRpbmcgdW50aWwgeW91IGFyZSBjb25uZWN0ZWQg
+YWdhaW4gdGhhdCBzaG91bGRuJ3QgYmUgc2VudDwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBvcHVwIj5jYW5jZWxRdWV1ZWRNZW51PC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idXNlX3BvcG92ZXIiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgPC9vYmplY3Q+CiAgICAgIDxwYWNr
+aW5nPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJwYWNrLXR5cGUiPkdUS19QQUNLX0VORDwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgPC9wYWNraW5nPgogICAgPC9jaGlsZD4KICAg
+IDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrU2Nyb2xsZWRXaW5kb3ciIGlkPSJtZXNzYWdl
+U2Nyb2xsIj4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+dHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZzY3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJ
+QzwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhzY3JvbGxiYXItcG9saWN5Ij5HVEtf
+UE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InNoYWRvdy10
+eXBlIj5pbjwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrVGV4dFZpZXciIGlkPSJtZXNzYWdlIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFzLWZvY3VzIj50
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAtbW9kZSI+R1RLX1dS
+QVBfV09SRF9DSEFSPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImVkaXRhYmxl
+Ij50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtbWFyZ2luIj4z
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJpZ2h0LW1hcmdpbiI+MzwvcHJv
+cGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4K
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icGFjay10eXBlIj5HVEtfUEFDS19FTkQ8L3Byb3BlcnR5PgogICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwv
+Y2hpbGQ+CiAgPC9vYmplY3Q+CiAgPG9iamVjdCBjbGFzcz0iR3RrTWVudSIgaWQ9ImNhbmNlbFF1ZXVl
+ZE1lbnUiPgogICAgPGNoaWxkPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51SXRlbSIgaWQ9ImNh
+bmNlbEFsbFF1ZXVlZE1lbnUiPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj50cnVlPC9w
+cm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5B
+bGwgcXVldWVkIG1lc3NhZ2VzPC9wcm9wZXJ0eT4KICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRl
+IiBoYW5kbGVyPSJvbl9jYW5jZWxfcXVldWVkX21lc3NhZ2VzIiAvPgogICAgICA8L29iamVjdD4KICAg
+IDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1NlcGFyYXRvck1lbnVJ
+dGVtIiBpZD0iY2FuY2VsUXVldWVkU2VwYXJhdG9yIj4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
+aWJsZSI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVj
+dD4KPC9pbnRlcmZhY2U+
 `,
 	},
 
@@ -2792,6 +3139,292 @@ L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 `,
 	},
 
+	"/definitions/ExportAccountBundle.xml": {
+		local:   "definitions/ExportAccountBundle.xml",
+		size:    3876,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJFeHBvcnRBY2NvdW50QnVu
+ZGxlIj4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRF
+UjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4cG9ydCBhY2NvdW50
+PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWZhdWx0LXdpZHRoIj4zMDA8L3Byb3BlcnR5
+PgogICAgPHNpZ25hbCBuYW1lPSJjbG9zZSIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgPGNoaWxk
+IGludGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iVmJv
+eCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbiI+MTA8L3Byb3BlcnR5PgogICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMDwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImhvbW9nZW5lb3VzIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVu
+dGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fVkVSVElDQUw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibWVzc2FnZSIgPgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5UaGUgYWNjb3VudCB3aWxs
+IGJlIHdyaXR0ZW4gdG8gYSBmaWxlLCBlbmNyeXB0ZWQgd2l0aCBhIHBhc3NwaHJhc2Ugb2YgaXRzIG93
+bi4gUGxlYXNlIGVudGVyIHRoZSBwYXNzcGhyYXNlIHR3aWNlLjwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9i
+amVjdCBjbGFzcz0iR3RrRW50cnkiIGlkPSJwYXNzd29yZCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJoYXMtZm9jdXMiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dmlzaWJpbGl0eSI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxh
+Y2Vob2xkZXItdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBhc3NwaHJhc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9leHBvcnQiIC8+CiAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9w
+cm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGls
+ZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0icGFzc3dvcmQyIj4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2liaWxpdHkiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyLXRleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5SZXBl
+YXQgdGhlIHBhc3NwaHJhc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2
+YXRlIiBoYW5kbGVyPSJvbl9leHBvcnQiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxw
+YWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0NoZWNrQnV0dG9uIiBpZD0iaW5jbHVkZVJvc3RlciI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkluY2x1ZGUgdGhlIGNvbnRhY3QgbGlzdDwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJhY3RpdmUiPnRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+ZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8
+Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9ImluY2x1ZGVI
+aXN0b3J5Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9Inll
+cyI+SW5jbHVkZSB0aGUgaGlzdG9yeSBvZiBjb252ZXJzYXRpb25zPC9wcm9wZXJ0eT4KICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
+eHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjQ8L3Byb3Bl
+cnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0Pgog
+ICAgPC9jaGlsZD4KICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtCdXR0b25Cb3giIGlkPSJidXR0b25fYm94Ij4KICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9IT1JJWk9OVEFMPC9wcm9wZXJ0eT4K
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJjYW5j
+ZWwiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5D
+YW5jZWw8L3Byb3BlcnR5PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9
+Im9uX2NhbmNlbCIgLz4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iZXhwb3J0Ij4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RXhwb3J0Li4u
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9l
+eHBvcnQiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVj
+dD4KICAgIDwvY2hpbGQ+CiAgICA8c3R5bGU+CiAgICAgIDxjbGFzcyBuYW1lPSJkZWNveWltIi8+CiAg
+ICA8L3N0eWxlPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+`,
+	},
+
+	"/definitions/ExportHistory.xml": {
+		local:   "definitions/ExportHistory.xml",
+		size:    8002,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJFeHBvcnRIaXN0b3J5Ij4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRFUjwvcHJv
+cGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj42PC9wcm9wZXJ0eT4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4cG9ydCBoaXN0b3J5PC9wcm9w
+ZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJyZXNpemFibGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJkZWZhdWx0LXdpZHRoIj40NTA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5h
+bWU9ImRlc3Ryb3ktd2l0aC1wYXJlbnQiPnRydWU8L3Byb3BlcnR5PgogICAgPGNoaWxkIGludGVybmFs
+LWNoaWxkPSJ2Ym94Ij4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iVmJveCI+CiAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImhvbW9nZW5lb3VzIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fVkVSVElDQUw8L3Byb3BlcnR5
+PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj42PC9wcm9wZXJ0eT4KICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtHcmlkIiBpZD0iZ3JpZCI+CiAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4tdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJtYXJnaW4tYm90dG9tIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJtYXJnaW4tc3RhcnQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbi1lbmQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJv
+dy1zcGFjaW5nIj44PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNvbHVtbi1z
+cGFjaW5nIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0iY29udmVyc2F0aW9uIj4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJoYWxpZ24iPkdUS19BTElHTl9TVEFSVDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGVmdC1hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRv
+cC1hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRo
+Ij4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIg
+aWQ9ImZvcm1hdExhYmVsIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkZvcm1hdDo8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX0VORDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGVmdC1hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRv
+cC1hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0NvbWJvQm94VGV4dCIgaWQ9ImZvcm1hdCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+aGV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYWN0
+aXZlLWlkIj5odG1sPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxpdGVtcz4KICAgICAgICAgICAg
+ICAgICAgPGl0ZW0gdHJhbnNsYXRhYmxlPSJ5ZXMiIGlkPSJodG1sIj5XZWIgcGFnZSAoSFRNTCk8L2l0
+ZW0+CiAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5zbGF0YWJsZT0ieWVzIiBpZD0ianNvbiI+TWFj
+aGluZSByZWFkYWJsZSAoSlNPTik8L2l0ZW0+CiAgICAgICAgICAgICAgICAgIDxpdGVtIHRyYW5zbGF0
+YWJsZT0ieWVzIiBpZD0idHh0Ij5QbGFpbiB0ZXh0PC9pdGVtPgogICAgICAgICAgICAgICAgPC9pdGVt
+cz4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJzaW5jZUxhYmVsIj4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkZyb206PC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPkdUS19BTElHTl9FTkQ8L3By
+b3BlcnR5PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9InNpbmNlIj4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRlci10ZXh0Ij5ZWVlZLU1NLUREPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwLXRleHQiIHRyYW5zbGF0YWJsZT0i
+eWVzIj5Pbmx5IGV4cG9ydCBtZXNzYWdlcyBzZW50IG9uIHRoaXMgZGF5IG9yIGxhdGVyPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJ1bnRpbExhYmVsIj4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRvOjwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5HVEtfQUxJR05fRU5EPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MzwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAg
+ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnkiIGlkPSJ1bnRpbCI+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0icGxhY2Vob2xkZXItdGV4dCI+WVlZWS1NTS1ERDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcC10ZXh0IiB0cmFuc2xhdGFibGU9Inll
+cyI+T25seSBleHBvcnQgbWVzc2FnZXMgc2VudCBvbiB0aGlzIGRheSBvciBlYXJsaWVyPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tCdXR0b24iIGlkPSJtYW5pZmVzdCI+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5BbHNvIHdyaXRl
+IGEgU0hBLTI1NiBtYW5pZmVzdCBmb3IgY2hlY2tpbmcgdGhlIGZpbGU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRyYXdfaW5kaWNhdG9yIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idG9wLWF0dGFjaCI+NDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJ3YXJuaW5nIj4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRoZSBleHBvcnRlZCBmaWxlIHdpbGwg
+Y29udGFpbiB0aGUgbWVzc2FnZXMgd2l0aG91dCBhbnkgZW5jcnlwdGlvbi4gQW55b25lIHdobyBjYW4g
+cmVhZCB0aGUgZmlsZSB3aWxsIGJlIGFibGUgdG8gcmVhZCB0aGUgY29udmVyc2F0aW9uLCBldmVuIGlm
+IGl0IHdhcyBlbmNyeXB0ZWQgd2hlbiBpdCBoYXBwZW5lZC48L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+R1RLX0FMSUdOX1NUQVJUPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXgtd2lkdGgtY2hhcnMiPjYwPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJsZWZ0LWF0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idG9wLWF0dGFjaCI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0id2lkdGgiPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0NoZWNrQnV0dG9uIiBpZD0iYWNrbm93bGVkZ2UiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SSB1bmRlcnN0YW5kIHRoYXQgdGhlIGV4cG9y
+dGVkIGZpbGUgd2lsbCBub3QgYmUgZW5jcnlwdGVkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX2Fja25vd2xlZGdlX3RvZ2dsZWQiIHN3
+YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2giPjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjY8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InN0YXR1cyI+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5HVEtfQUxJR05fU1RBUlQ8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxlZnQtYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ0b3AtYXR0YWNoIj43PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ3aWR0aCI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgog
+ICAgICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrQnV0dG9uQm94IiBpZD0iYnV0dG9uX2JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX0hPUklaT05UQUw8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBp
+ZD0iYnV0dG9uX2NhbmNlbCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRy
+YW5zbGF0YWJsZT0ieWVzIj5fQ2FuY2VsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ1c2UtdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWdu
+YWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnV0dG9uX2V4cG9ydCI+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5fRXhwb3J0Li4uPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ1c2UtdW5kZXJsaW5lIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZW5zaXRpdmUiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25f
+ZXhwb3J0IiAvPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxk
+PgogICAgPHN0eWxlPgogICAgICA8Y2xhc3MgbmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8
+L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+`,
+	},
+
+	"/definitions/ExportOTRData.xml": {
+		local:   "definitions/ExportOTRData.xml",
+		size:    3727,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJFeHBvcnRPVFJEYXRhIj4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRFUjwvcHJv
+cGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0eT4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJkZWZhdWx0LXdpZHRoIj4zNjA8L3Byb3BlcnR5PgogICAgPHNpZ25hbCBuYW1l
+PSJjbG9zZSIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgPHNpZ25hbCBuYW1lPSJkZWxldGUtZXZl
+bnQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4KICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0idmJveCI+
+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9IlZib3giPgogICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJtYXJnaW4iPjEwPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+
+MTA8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJob21vZ2VuZW91cyI+ZmFsc2U8L3By
+b3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9O
+X1ZFUlRJQ0FMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtMYWJlbCIgaWQ9Im1lc3NhZ2UiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+
+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJjbGllbnRCb3gi
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+NjwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX0hPUklaT05UQUw8
+L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrTGFiZWwiIGlkPSJjbGllbnRMYWJlbCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5FeHBvcnQgZm9yOjwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDb21ib0JveFRleHQiIGlkPSJjbGllbnRzIj4KICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnki
+IGlkPSJwYXNzd29yZCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYXMtZm9jdXMiPnRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJpbGl0eSI+ZmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibm8tc2hvdy1hbGwiPnRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxhY2Vob2xkZXItdGV4dCIgdHJhbnNsYXRh
+YmxlPSJ5ZXMiPk1haW4gcGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9
+ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9leHBvcnQiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwv
+cGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0xhYmVsIiBpZD0ic3RhdHVzIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Indy
+YXAiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkIGludGVybmFs
+LWNoaWxkPSJhY3Rpb25fYXJlYSI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbkJveCIgaWQ9
+ImJ1dHRvbl9ib3giPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVO
+VEFUSU9OX0hPUklaT05UQUw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bi1jYW5jZWwiPgogICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5DYW5jZWw8L3Byb3BlcnR5PgogICAgICAgICAg
+ICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4KICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBj
+bGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuLWV4cG9ydCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4cG9ydDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW4tZGVmYXVsdCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxzaWdu
+YWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fZXhwb3J0IiAvPgogICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgog
+ICAgICA8Y2xhc3MgbmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRl
+cmZhY2U+Cg==
+`,
+	},
+
 	"/definitions/Feedback.xml": {
 		local:   "definitions/Feedback.xml",
 		size:    2891,
@@ -3496,93 +4129,259 @@ PgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 `,
 	},
 
+	"/definitions/ImportAccountBundle.xml": {
+		local:   "definitions/ImportAccountBundle.xml",
+		size:    4398,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJJbXBvcnRBY2NvdW50QnVu
+ZGxlIj4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRF
+UjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkltcG9ydCBhY2NvdW50
+PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWZhdWx0LXdpZHRoIj4zMDA8L3Byb3BlcnR5
+PgogICAgPHNpZ25hbCBuYW1lPSJjbG9zZSIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgPGNoaWxk
+IGludGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iVmJv
+eCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbiI+MTA8L3Byb3BlcnR5PgogICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMDwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImhvbW9nZW5lb3VzIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVu
+dGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fVkVSVElDQUw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibWVzc2FnZSIgPgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5QbGVhc2UgZW50ZXIgdGhl
+IHBhc3NwaHJhc2UgdGhlIGFjY291bnQgd2FzIGV4cG9ydGVkIHdpdGguPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAg
+ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9InBhc3N3b3JkIj4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9Imhhcy1mb2N1cyI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2aXNpYmlsaXR5Ij5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJwbGFjZWhvbGRlci10ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+UGFzc3BocmFzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRsZXI9Im9uX2ltcG9ydCIgLz4K
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24i
+PjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJtZXJnZU1lc3NhZ2Ui
+ID4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SWYg
+dGhlIGFjY291bnQgaXMgYWxyZWFkeSBoZXJlLCBhbmQgdGhlIGZpbGUgZGlzYWdyZWVzIHdpdGggaXQg
+YWJvdXQga2V5cywgZmluZ2VycHJpbnRzIG9yIHNldHRpbmdzOjwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9i
+amVjdCBjbGFzcz0iR3RrUmFkaW9CdXR0b24iIGlkPSJrZWVwRXhpc3RpbmciPgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5LZWVwIHdoYXQgaXMgYWxyZWFk
+eSBoZXJlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRyYXdfaW5kaWNhdG9y
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrUmFkaW9C
+dXR0b24iIGlkPSJwcmVmZXJCdW5kbGUiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwi
+IHRyYW5zbGF0YWJsZT0ieWVzIj5Vc2Ugd2hhdCBpcyBpbiB0aGUgZmlsZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJncm91cCI+a2VlcEV4aXN0aW5nPC9wcm9wZXJ0eT4KICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
+PnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjQ8L3By
+b3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tCdXR0b24iIGlkPSJpbXBvcnRIaXN0b3J5
+Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SW1w
+b3J0IHRoZSBoaXN0b3J5IG9mIGNvbnZlcnNhdGlvbnM8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iYWN0aXZlIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjU8L3Byb3BlcnR5PgogICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICAg
+IDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCdXR0b25Cb3giIGlkPSJidXR0b25fYm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50
+YXRpb24iPkdUS19PUklFTlRBVElPTl9IT1JJWk9OVEFMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJjYW5jZWwiPgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5DYW5jZWw8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4K
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iaW1wb3J0Ij4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SW1wb3J0PC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9pbXBvcnQiIC8+CiAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAg
+ICA8c3R5bGU+CiAgICAgIDxjbGFzcyBuYW1lPSJkZWNveWltIi8+CiAgICA8L3N0eWxlPgogIDwvb2Jq
+ZWN0Pgo8L2ludGVyZmFjZT4K
+`,
+	},
+
+	"/definitions/ImportServerData.xml": {
+		local:   "definitions/ImportServerData.xml",
+		size:    2893,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJJbXBvcnRTZXJ2ZXJEYXRh
+Ij4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRFUjwv
+cHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0eT4KICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkltcG9ydCBzZXJ2ZXIgZGF0
+YTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVmYXVsdC13aWR0aCI+NDAwPC9wcm9wZXJ0
+eT4KICAgIDxzaWduYWwgbmFtZT0iY2xvc2UiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4KICAgIDxjaGls
+ZCBpbnRlcm5hbC1jaGlsZD0idmJveCI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9IlZi
+b3giPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4iPjEwPC9wcm9wZXJ0eT4KICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+MTA8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJob21vZ2VuZW91cyI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmll
+bnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX1ZFUlRJQ0FMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImRlc2NyaXB0aW9uIiA+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRoZSBjb250YWN0
+cywgdGhlIHZDYXJkIGFuZCB0aGUgYm9va21hcmtzIHdpbGwgYmUgYWRkZWQgdG8gdGhpcyBhY2NvdW50
+LiBZb3VyIGNvbnRhY3RzIHdpbGwgYmUgYXNrZWQgYWdhaW4gdG8gbGV0IHlvdSBzZWUgdGhlaXIgcHJl
+c2VuY2UsIHdpdGggdGhpcyBtZXNzYWdlOjwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBh
+Y2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5n
+PgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrRW50cnkiIGlkPSJzdWJzY3JpcHRpb25NZXNzYWdlIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Imhhcy1mb2N1cyI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0
+ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+SSBoYXZlIG1vdmVkIHRvIGEgbmV3IGFjY291bnQuIFBsZWFz
+ZSBsZXQgbWUgc2VlIHlvdXIgcHJlc2VuY2UgYWdhaW4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNp
+Z25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25faW1wb3J0IiAvPgogICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAg
+ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InN0YXR1cyIgPgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0id3JhcCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8Y2hp
+bGQgaW50ZXJuYWwtY2hpbGQ9ImFjdGlvbl9hcmVhIj4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0
+dG9uQm94IiBpZD0iYnV0dG9uX2JveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9u
+Ij5HVEtfT1JJRU5UQVRJT05fSE9SSVpPTlRBTDwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iY2FuY2VsIj4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Q2FuY2VsPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9jYW5jZWwiIC8+CiAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxv
+YmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImltcG9ydCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkltcG9ydDwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25faW1wb3J0IiAvPgogICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0
+eWxlPgogICAgICA8Y2xhc3MgbmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4K
+PC9pbnRlcmZhY2U+Cg==
+`,
+	},
+
 	"/definitions/Importer.xml": {
 		local:   "definitions/Importer.xml",
-		size:    4913,
+		size:    6527,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0idXRmLTgiPz4KPGludGVyZmFjZT4KICA8b2JqZWN0
 IGNsYXNzPSJHdGtMaXN0U3RvcmUiIGlkPSJpbXBvcnRBY2NvdW50c1N0b3JlIj4KICAgIDxjb2x1bW5z
-PgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4KICAgICAgPGNvbHVtbiB0eXBlPSJnY2hh
-cmFycmF5Ii8+CiAgICAgIDxjb2x1bW4gdHlwZT0iZ2Jvb2xlYW4iLz4KICAgIDwvY29sdW1ucz4KICA8
-L29iamVjdD4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJpbXBvcnRlcldpbmRvdyI+CiAg
-ICA8cHJvcGVydHkgbmFtZT0id2luZG93LXBvc2l0aW9uIj5HVEtfV0lOX1BPU19DRU5URVI8L3Byb3Bl
-cnR5PgogICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+SW1wb3J0IEFj
-Y291bnRzPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij40NTA8L3By
-b3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImhlaWdodF9yZXF1ZXN0Ij42MDA8L3Byb3BlcnR5Pgog
-ICAgPHByb3BlcnR5IG5hbWU9ImJvcmRlcl93aWR0aCI+MTA8L3Byb3BlcnR5PgogICAgPGNoaWxkIGlu
-dGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iYm94Ij4K
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iaG9tb2dlbmVvdXMiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9WRVJUSUNBTDwvcHJv
-cGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlk
-PSJsYWJlbCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5
-ZXMiPkNob29zZSB0aGUgYWNjb3VudHMgeW91IHdvdWxkIGxpa2UgdG8gaW1wb3J0IGFuZCBzdGFydCB1
-c2luZyBmcm9tIENveUlNLjwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFw
-Ij50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAtbW9kZSI+UEFO
-R09fV1JBUF9XT1JEX0NIQVI8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8
-cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tp
-bmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtTY3JvbGxlZFdpbmRvdyIgaWQ9ImltcG9ydGVyU2Nyb2xsIj4KICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InZzY3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+
-CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoc2Nyb2xsYmFyLXBvbGljeSI+R1RLX1BPTElDWV9B
-VVRPTUFUSUM8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVj
-dCBjbGFzcz0iR3RrVHJlZVZpZXciIGlkPSJpbXBvcnRlclRyZWVWaWV3Ij4KICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJtb2RlbCI+aW1wb3J0QWNjb3VudHNTdG9yZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVW
-aWV3Q29sdW1uIiBpZD0iaW1wb3J0LXRoaXMtYWNjb3VudC1jb2x1bW4iPgogICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkltcG9ydCB0aGlzPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic29ydF9jb2x1bW5faWQi
-PjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAg
-ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NlbGxSZW5kZXJlclRvZ2dsZSIgaWQ9ImltcG9ydC10aGlzLWFj
-Y291bnQtcmVuZGVyZXIiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYWN0
-aXZhdGFibGUiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
-ICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgICAgICAgPGF0
-dHJpYnV0ZSBuYW1lPSJhY3RpdmUiPjI8L2F0dHJpYnV0ZT4KICAgICAgICAgICAgICAgICAgICAgIDwv
-YXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8
-L29iamVjdD4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
-ICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iZnJvbS1h
-cHBsaWNhdGlvbi1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRs
-ZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkZyb20gYXBwbGljYXRpb248L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzb3J0X2NvbHVtbl9pZCI+MDwvcHJvcGVydHk+CiAgICAg
+PgogICAgICA8IS0tIGFwcGxpY2F0aW9uIC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXki
+Lz4KICAgICAgPCEtLSBhY2NvdW50IC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4K
+ICAgICAgPCEtLSBpbXBvcnQgdGhlIGFjY291bnQgLS0+CiAgICAgIDxjb2x1bW4gdHlwZT0iZ2Jvb2xl
+YW4iLz4KICAgICAgPCEtLSBpbXBvcnQgdGhlIG1lc3NhZ2UgbG9ncyAtLT4KICAgICAgPGNvbHVtbiB0
+eXBlPSJnYm9vbGVhbiIvPgogICAgICA8IS0tIHRoZSBhY2NvdW50IGNhbiBiZSBpbXBvcnRlZCAtLT4K
+ICAgICAgPGNvbHVtbiB0eXBlPSJnYm9vbGVhbiIvPgogICAgICA8IS0tIHRoZXJlIGFyZSBtZXNzYWdl
+IGxvZ3MgdG8gaW1wb3J0IC0tPgogICAgICA8Y29sdW1uIHR5cGU9Imdib29sZWFuIi8+CiAgICAgIDwh
+LS0gbnVtYmVyIG9mIG1lc3NhZ2VzIGluIHRoZSBsb2dzIC0tPgogICAgICA8Y29sdW1uIHR5cGU9Imdj
+aGFyYXJyYXkiLz4KICAgIDwvY29sdW1ucz4KICA8L29iamVjdD4KICA8b2JqZWN0IGNsYXNzPSJHdGtE
+aWFsb2ciIGlkPSJpbXBvcnRlcldpbmRvdyI+CiAgICA8cHJvcGVydHkgbmFtZT0id2luZG93LXBvc2l0
+aW9uIj5HVEtfV0lOX1BPU19DRU5URVI8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InRpdGxl
+IiB0cmFuc2xhdGFibGU9InllcyI+SW1wb3J0IEFjY291bnRzPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij40NTA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9Imhl
+aWdodF9yZXF1ZXN0Ij42MDA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImJvcmRlcl93aWR0
+aCI+MTA8L3Byb3BlcnR5PgogICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAgICAgPG9i
+amVjdCBjbGFzcz0iR3RrQm94IiBpZD0iYm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iaG9tb2dl
+bmVvdXMiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24i
+PkdUS19PUklFTlRBVElPTl9WRVJUSUNBTDwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYWJlbCI+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNob29zZSB0aGUgYWNjb3VudHMgeW91IHdv
+dWxkIGxpa2UgdG8gaW1wb3J0IGFuZCBzdGFydCB1c2luZyBmcm9tIENveUlNLiBZb3UgY2FuIGFsc28g
+aW1wb3J0IHRoZSBtZXNzYWdlIGxvZ3Mgb2YgdGhvc2UgYWNjb3VudHMgaW50byB0aGUgaGlzdG9yeSAt
+IHRoZXkgd2lsbCBiZSBrZXB0IGVuY3J5cHRlZCB3aXRoIHlvdXIgbWFzdGVyIHBhc3N3b3JkLjwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAtbW9kZSI+UEFOR09fV1JBUF9XT1JEX0NIQVI8L3By
+b3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtTY3JvbGxlZFdpbmRvdyIg
+aWQ9ImltcG9ydGVyU2Nyb2xsIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZzY3JvbGxiYXIt
+cG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJoc2Nyb2xsYmFyLXBvbGljeSI+R1RLX1BPTElDWV9BVVRPTUFUSUM8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXci
+IGlkPSJpbXBvcnRlclRyZWVWaWV3Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2Rl
+bCI+aW1wb3J0QWNjb3VudHNTdG9yZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iaW1wb3J0
+LXRoaXMtYWNjb3VudC1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0
+aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkltcG9ydCB0aGlzPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ic29ydF9jb2x1bW5faWQiPjI8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0NlbGxSZW5kZXJlclRvZ2dsZSIgaWQ9ImltcG9ydC10aGlzLWFjY291bnQtcmVuZGVyZXIiLz4KICAg
+ICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8YXR0
+cmlidXRlIG5hbWU9ImFjdGl2ZSI+MjwvYXR0cmlidXRlPgogICAgICAgICAgICAgICAgICAgICAgICA8
+YXR0cmlidXRlIG5hbWU9ImFjdGl2YXRhYmxlIj40PC9hdHRyaWJ1dGU+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0idmlzaWJsZSI+NDwvYXR0cmlidXRlPgogICAgICAgICAgICAg
+ICAgICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXdDb2x1bW4i
+IGlkPSJpbXBvcnQtbG9ncy1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkltcG9ydCBtZXNzYWdlIGxvZ3M8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzb3J0X2NvbHVtbl9pZCI+MzwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrQ2VsbFJlbmRlcmVyVG9nZ2xlIiBpZD0iaW1wb3J0LWxvZ3MtcmVuZGVyZXIiLz4K
+ICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8
+YXR0cmlidXRlIG5hbWU9ImFjdGl2ZSI+MzwvYXR0cmlidXRlPgogICAgICAgICAgICAgICAgICAgICAg
+ICA8YXR0cmlidXRlIG5hbWU9ImFjdGl2YXRhYmxlIj41PC9hdHRyaWJ1dGU+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0idmlzaWJsZSI+NTwvYXR0cmlidXRlPgogICAgICAgICAg
+ICAgICAgICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
 ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
-R3RrQ2VsbFJlbmRlcmVyVGV4dCIgaWQ9ImZyb20tYXBwbGljYXRpb24tcmVuZGVyZXIiLz4KICAgICAg
-ICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmli
-dXRlIG5hbWU9InRleHQiPjA8L2F0dHJpYnV0ZT4KICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmli
+R3RrQ2VsbFJlbmRlcmVyVGV4dCIgaWQ9ImxvZy1tZXNzYWdlcy1yZW5kZXJlciIvPgogICAgICAgICAg
+ICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUg
+bmFtZT0idGV4dCI+NjwvYXR0cmlidXRlPgogICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRl
+IG5hbWU9InZpc2libGUiPjU8L2F0dHJpYnV0ZT4KICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmli
 dXRlcz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVj
 dD4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iYWNjb3VudC1uYW1l
-LWNvbHVtbiI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xh
-dGFibGU9InllcyI+QWNjb3VudCBuYW1lPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ic29ydF9jb2x1bW5faWQiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NlbGxSZW5kZXJl
-clRleHQiIGlkPSJhY2NvdW50LW5hbWUtcmVuZGVyZXIiLz4KICAgICAgICAgICAgICAgICAgICAgIDxh
-dHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9InRleHQiPjE8
-L2F0dHJpYnV0ZT4KICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAg
-ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
-IDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
-ICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iZXhwYW5kIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
-PnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
-b3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxk
-IGludGVybmFsLWNoaWxkPSJhY3Rpb25fYXJlYSI+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
-dXR0b25Cb3giIGlkPSJidXR0b25fYm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVu
-dGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fSE9SSVpPTlRBTDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJtYXJnaW4tdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidXR0b25fY2FuY2VsIj4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNh
-bmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24i
-IGlkPSJidXR0b25fb2siPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
-c2xhdGFibGU9InllcyI+SW1wb3J0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW4tZGVmYXVsdCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
-ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAg
-ICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8YWN0aW9uLXdpZGdldHM+CiAgICAgIDxhY3Rpb24t
-d2lkZ2V0IHJlc3BvbnNlPSJjYW5jZWwiPmJ1dHRvbl9jYW5jZWw8L2FjdGlvbi13aWRnZXQ+CiAgICAg
-IDxhY3Rpb24td2lkZ2V0IHJlc3BvbnNlPSJvayIgZGVmYXVsdD0idHJ1ZSI+YnV0dG9uX29rPC9hY3Rp
-b24td2lkZ2V0PgogICAgPC9hY3Rpb24td2lkZ2V0cz4KICAgIDxzdHlsZT4KICAgICAgPGNsYXNzIG5h
-bWU9ImRlY295aW0iLz4KICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iZnJvbS1hcHBsaWNh
+dGlvbi1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkZyb20gYXBwbGljYXRpb248L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJzb3J0X2NvbHVtbl9pZCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2Vs
+bFJlbmRlcmVyVGV4dCIgaWQ9ImZyb20tYXBwbGljYXRpb24tcmVuZGVyZXIiLz4KICAgICAgICAgICAg
+ICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5h
+bWU9InRleHQiPjA8L2F0dHJpYnV0ZT4KICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4K
+ICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iYWNjb3VudC1uYW1lLWNvbHVt
+biI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9
+InllcyI+QWNjb3VudCBuYW1lPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ic29ydF9jb2x1bW5faWQiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NlbGxSZW5kZXJlclRleHQi
+IGlkPSJhY2NvdW50LW5hbWUtcmVuZGVyZXIiLz4KICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1
+dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9InRleHQiPjE8L2F0dHJp
+YnV0ZT4KICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAg
+ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8
+L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5
+PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkIGludGVy
+bmFsLWNoaWxkPSJhY3Rpb25fYXJlYSI+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b25C
+b3giIGlkPSJidXR0b25fYm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9u
+Ij5HVEtfT1JJRU5UQVRJT05fSE9SSVpPTlRBTDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJtYXJnaW4tdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidXR0b25fY2FuY2VsIj4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNhbmNlbDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJi
+dXR0b25fb2siPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
+bGU9InllcyI+SW1wb3J0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW4tZGVmYXVsdCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29i
+amVjdD4KICAgIDwvY2hpbGQ+CiAgICA8YWN0aW9uLXdpZGdldHM+CiAgICAgIDxhY3Rpb24td2lkZ2V0
+IHJlc3BvbnNlPSJjYW5jZWwiPmJ1dHRvbl9jYW5jZWw8L2FjdGlvbi13aWRnZXQ+CiAgICAgIDxhY3Rp
+b24td2lkZ2V0IHJlc3BvbnNlPSJvayIgZGVmYXVsdD0idHJ1ZSI+YnV0dG9uX29rPC9hY3Rpb24td2lk
+Z2V0PgogICAgPC9hY3Rpb24td2lkZ2V0cz4KICAgIDxzdHlsZT4KICAgICAgPGNsYXNzIG5hbWU9ImRl
+Y295aW0iLz4KICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
@@ -9151,7 +9950,7 @@ ZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 
 	"/definitions/MUCRoomToolbar.xml": {
 		local:   "definitions/MUCRoomToolbar.xml",
-		size:    21431,
+		size:    21740,
 		modtime: 1489449600,
 		compressed: `
 PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51IiBpZD0icm9vbS1tZW51Ij4KICAgIDxj
@@ -9173,345 +9972,350 @@ dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxh
 YmVsIiB0cmFuc2xhdGFibGU9InllcyI+TW9kaWZ5IHBvc2l0aW9uczwvcHJvcGVydHk+CiAgICAgICAg
 PHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25fbW9kaWZ5X3Bvc2l0aW9uX2xpc3RzIiBz
 d2FwcGVkPSJubyIvPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAg
-IDxvYmplY3QgY2xhc3M9Ikd0a1NlcGFyYXRvck1lbnVJdGVtIiBpZD0iYWRtaW4tYWN0aW9uLXNlcGFy
-YXRvciI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
-a01lbnVJdGVtIiBpZD0iZGVzdHJveS1yb29tLW1lbnUtaXRlbSI+CiAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIg
-dHJhbnNsYXRhYmxlPSJ5ZXMiPkRlc3Ryb3kgcm9vbTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5
+IDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0iZXhwb3J0LWhpc3RvcnktbWVudS1pdGVtIj4K
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RXhwb3J0IGhpc3RvcnkuLi48L3By
+b3BlcnR5PgogICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRsZXI9Im9uX2V4cG9ydF9o
+aXN0b3J5IiBzd2FwcGVkPSJubyIvPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8Y2hp
+bGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1NlcGFyYXRvck1lbnVJdGVtIiBpZD0iYWRtaW4tYWN0
+aW9uLXNlcGFyYXRvciI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0iZGVzdHJveS1yb29tLW1lbnUtaXRlbSI+CiAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkRlc3Ryb3kgcm9vbTwvcHJvcGVydHk+CiAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InVzZV91bmRlcmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxzaWdu
+YWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRsZXI9Im9uX2Rlc3Ryb3lfcm9vbSIgc3dhcHBlZD0ibm8iLz4K
+ICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkPgogICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtNZW51SXRlbSIgaWQ9ImxlYXZlLXJvb20tbWVudS1pdGVtIj4KICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVs
+IiB0cmFuc2xhdGFibGU9InllcyI+TGVhdmUgcm9vbTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5
 IG5hbWU9InVzZV91bmRlcmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxzaWduYWwgbmFtZT0i
-YWN0aXZhdGUiIGhhbmRsZXI9Im9uX2Rlc3Ryb3lfcm9vbSIgc3dhcHBlZD0ibm8iLz4KICAgICAgPC9v
-YmplY3Q+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51
-SXRlbSIgaWQ9ImxlYXZlLXJvb20tbWVudS1pdGVtIj4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
-aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
-dGFibGU9InllcyI+TGVhdmUgcm9vbTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InVz
-ZV91bmRlcmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUi
-IGhhbmRsZXI9Im9uX2xlYXZlX3Jvb20iIHN3YXBwZWQ9Im5vIi8+CiAgICAgIDwvb2JqZWN0PgogICAg
-PC9jaGlsZD4KICA8L29iamVjdD4KICA8b2JqZWN0IGNsYXNzPSJHdGtUZXh0QnVmZmVyIiBpZD0icm9v
-bS1zdWJqZWN0LXRleHR2aWV3LWJ1ZmZlciI+CiAgICA8c2lnbmFsIG5hbWU9ImNoYW5nZWQiIGhhbmRs
-ZXI9Im9uX3N1YmplY3RfY2hhbmdlZCIgc3dhcHBlZD0ibm8iLz4KICA8L29iamVjdD4KICA8b2JqZWN0
-IGNsYXNzPSJHdGtCb3giIGlkPSJyb29tLXZpZXctdG9vbGJhciI+CiAgICA8cHJvcGVydHkgbmFtZT0i
-dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iaGV4cGFuZCI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0
-eT4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0icm9vbS12aWV3LXRv
-b2xiYXItY29udGVudCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
-cnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5
-PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9InRvb2xi
-YXIiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGVpZ2h0X3JlcXVlc3QiPjYwPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5zdGFydDwvcHJvcGVydHk+CiAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJib3JkZXJfd2lkdGgiPjEyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNo
-aWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9InRvb2xiYXItdG9wIj4K
+YWN0aXZhdGUiIGhhbmRsZXI9Im9uX2xlYXZlX3Jvb20iIHN3YXBwZWQ9Im5vIi8+CiAgICAgIDwvb2Jq
+ZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KICA8b2JqZWN0IGNsYXNzPSJHdGtUZXh0QnVmZmVy
+IiBpZD0icm9vbS1zdWJqZWN0LXRleHR2aWV3LWJ1ZmZlciI+CiAgICA8c2lnbmFsIG5hbWU9ImNoYW5n
+ZWQiIGhhbmRsZXI9Im9uX3N1YmplY3RfY2hhbmdlZCIgc3dhcHBlZD0ibm8iLz4KICA8L29iamVjdD4K
+ICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJyb29tLXZpZXctdG9vbGJhciI+CiAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iaGV4cGFu
+ZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2Fs
+PC9wcm9wZXJ0eT4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0icm9v
+bS12aWV3LXRvb2xiYXItY29udGVudCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8
+L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIg
+aWQ9InRvb2xiYXIiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGVpZ2h0X3JlcXVlc3QiPjYw
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5zdGFydDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJib3JkZXJfd2lkdGgiPjEyPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9InRvb2xi
+YXItdG9wIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWdu
+Ij5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFj
+aW5nIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
+ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0icm9vbS1pbmZvLWJveCI+CiAgICAgICAg
 ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
-dGtCb3giPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMjwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
-PG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0icm9vbS1pbmZvLWJveCI+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjEyPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrSW1hZ2UiIGlkPSJyb29tLXN0YXR1cy1pY29uIj4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2Vu
+dGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iaWNvbl9uYW1lIj5hcHBsaWNhdGlvbnMtY2hhdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fc2l6ZSI+NjwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
+YXNzPSJHdGtCb3giIGlkPSJyb29tLWRldGFpbHMtYm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+MTI8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InJvb20tbmFtZS1sYWJlbCI+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxp
+Z24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZWxsaXBzaXplIj5lbmQ8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0cmFja192
+aXNpdGVkX2xpbmtzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9InJvb20tc3ViamVjdC1idXR0b24iPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Zm9jdXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFs
+aWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJyZWxpZWYiPm5vbmU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5TaG93
+IHJvb20gc3ViamVjdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNp
+Z25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl90b2dnbGVfcm9vbV9zdWJqZWN0IiBzd2FwcGVk
+PSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0ltYWdlIiBpZD0icm9vbS1zdWJq
+ZWN0LWJ1dHRvbi1pbWFnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
 ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjEyPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fbmFtZSI+Z28t
+ZG93bi1zeW1ib2xpYzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
+cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxk
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
+cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
+YXNzPSJHdGtCb3giIGlkPSJyb29tLW1lbnUtYm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAg
 ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBj
-bGFzcz0iR3RrSW1hZ2UiIGlkPSJyb29tLXN0YXR1cy1pY29uIj4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaWNvbl9u
-YW1lIj5hcHBsaWNhdGlvbnMtY2hhdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fc2l6ZSI+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFj
-a2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+Y3QgY2xhc3M9Ikd0a01lbnVCdXR0b24iIGlkPSJyb29tLW1lbnUtYnV0dG9uIj4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3B1cCI+cm9vbS1tZW51PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ1c2VfcG9wb3ZlciI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtJbWFnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJpY29uX25hbWUiPm9wZW4tbWVudS1zeW1ib2xpYzwvcHJvcGVydHk+CiAgICAg
 ICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
-ZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
-L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
-b3giIGlkPSJyb29tLWRldGFpbHMtYm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+MTI8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InJvb20tbmFtZS1sYWJlbCI+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPnN0YXJ0
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-dmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZWxsaXBzaXplIj5lbmQ8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0cmFja192aXNpdGVkX2xp
-bmtzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxv
-YmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9InJvb20tc3ViamVjdC1idXR0b24iPgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNfb25f
-Y2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50
-ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJyZWxpZWYiPm5vbmU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5TaG93IHJvb20gc3Vi
-amVjdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1l
-PSJjbGlja2VkIiBoYW5kbGVyPSJvbl90b2dnbGVfcm9vbV9zdWJqZWN0IiBzd2FwcGVkPSJubyIvPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0ltYWdlIiBpZD0icm9vbS1zdWJqZWN0LWJ1dHRv
-bi1pbWFnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fbmFtZSI+Z28tZG93bi1zeW1i
-b2xpYzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
-c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
-ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNr
-aW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2lu
-Zz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwv
-b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxw
+YWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
 ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
-ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
-b3giIGlkPSJyb29tLW1lbnUtYm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
-Ikd0a01lbnVCdXR0b24iIGlkPSJyb29tLW1lbnUtYnV0dG9uIj4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3B1cCI+cm9v
-bS1tZW51PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ1c2VfcG9wb3ZlciI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtJbWFn
-ZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJpY29uX25hbWUiPm9wZW4tbWVudS1zeW1ib2xpYzwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGls
-ZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
-eHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAg
-ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGls
-ZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
-ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
-IDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxk
-PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1NlcGFyYXRvciI+CiAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-IDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
-eHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3Bl
-cnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0Pgog
-ICAgICA8cGFja2luZz4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
-dHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwv
-Y2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1JldmVhbGVyIiBpZD0icm9v
-bS1zdWJqZWN0LXJldmVhbGVyIj4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4K
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAg
+ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
+ICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1NlcGFy
+YXRvciI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24i
+PjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwv
+b2JqZWN0PgogICAgICA8cGFja2luZz4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgIDwvcGFja2lu
+Zz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1JldmVhbGVy
+IiBpZD0icm9vbS1zdWJqZWN0LXJldmVhbGVyIj4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
+ZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj4xMjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Jv
+eCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjEyPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxj
+aGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2Vu
+dGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJz
+cGFjaW5nIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0icm9v
+bS1zdWJqZWN0LWxhYmVsIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwLW1vZGUiPmNoYXI8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dHJhY2stdmlzaXRlZC1saW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9j
+aGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2YWxpZ24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
+dXR0b24iIGlkPSJyb29tLWVkaXQtc3ViamVjdC1idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2Rl
+ZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJyZWxpZWYiPm5vbmU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkVk
+aXQgc3ViamVjdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5h
+bWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2VkaXRfcm9vbV9zdWJqZWN0IiBzd2FwcGVkPSJubyIvPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtJbWFnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
 ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjEyPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
-VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxp
-Z24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJzcGFjaW5nIj4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4x
-MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0icm9vbS1zdWJqZWN0
-LWxhYmVsIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
-aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwLW1vZGUiPmNoYXI8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idHJhY2stdmlz
-aXRlZC1saW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBh
-Y2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
-ZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
-ZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
-cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAg
-ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fbmFtZSI+ZG9j
+dW1lbnQtZWRpdC1zeW1ib2xpYzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrU2Nyb2xsZWRXaW5kb3ciIGlkPSJyb29t
+LXN1YmplY3QtZWRpdGFibGUtY29udGVudCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InZzY3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhzY3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZ
+X0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNo
+YWRvd190eXBlIj5pbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+Im1pbl9jb250ZW50X2hlaWdodCI+NTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RleHRWaWV3IiBpZD0icm9v
+bS1zdWJqZWN0LXRleHR2aWV3Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaGV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ3cmFwLW1vZGUiPkdUS19XUkFQX0NIQVI8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9tYXJnaW4iPjY8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmlnaHRfbWFyZ2luIj42PC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9tYXJnaW4iPjY8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYm90dG9tX21hcmdpbiI+
+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJidWZmZXIi
+PnJvb20tc3ViamVjdC10ZXh0dmlldy1idWZmZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0icm9vbS1lZGl0LXN1YmplY3QtYnV0
+dG9ucy1jb250YWluZXIiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWdu
+Ij5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5n
+Ij4xMjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0icm9vbS1lZGl0LXN1YmplY3QtY2FuY2Vs
+LWJ1dHRvbiI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbF9y
+b29tX3N1YmplY3RfZWRpdCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0
+b24iIGlkPSJyb29tLWVkaXQtc3ViamVjdC1hcHBseS1idXR0b24iPgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5BcHBseTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVj
+ZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzaWdu
+YWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fYXBwbHlfcm9vbV9zdWJqZWN0X2VkaXQiIHN3YXBw
+ZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAg
+ICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
+ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAg
 ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
-ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
-VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxp
-Z24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxj
-aGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlk
-PSJyb29tLWVkaXQtc3ViamVjdC1idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InZpc2libGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZh
-bGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJyZWxpZWYiPm5vbmU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkVkaXQgc3ViamVj
-dDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNr
-ZWQiIGhhbmRsZXI9Im9uX2VkaXRfcm9vbV9zdWJqZWN0IiBzd2FwcGVkPSJubyIvPgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2Jq
-ZWN0IGNsYXNzPSJHdGtJbWFnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fbmFtZSI+ZG9jdW1lbnQtZWRp
-dC1zeW1ib2xpYzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0
-PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
-MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
-ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
-ICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8
-L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9v
-YmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
-PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAg
-ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrU2Nyb2xsZWRXaW5kb3ciIGlkPSJyb29tLXN1YmplY3Qt
-ZWRpdGFibGUtY29udGVudCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZzY3Jv
-bGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJQzwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImhzY3JvbGxiYXItcG9saWN5Ij5HVEtfUE9MSUNZX0FVVE9NQVRJ
-QzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNoYWRvd190eXBl
-Ij5pbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1pbl9jb250
-ZW50X2hlaWdodCI+NTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
-ICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RleHRWaWV3IiBpZD0icm9vbS1zdWJqZWN0
-LXRleHR2aWV3Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGV4
-cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ3cmFwLW1vZGUiPkdUS19XUkFQX0NIQVI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ibGVmdF9tYXJnaW4iPjY8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0icmlnaHRfbWFyZ2luIj42PC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9tYXJnaW4iPjY8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYm90dG9tX21hcmdpbiI+NjwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJidWZmZXIiPnJvb20tc3Vi
-amVjdC10ZXh0dmlldy1idWZmZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmpl
-Y3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
-CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAg
-ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
-ICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0icm9vbS1lZGl0LXN1YmplY3QtYnV0dG9ucy1jb250
-YWluZXIiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5lbmQ8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMjwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9i
-amVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0icm9vbS1lZGl0LXN1YmplY3QtY2FuY2VsLWJ1dHRvbiI+
-CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxl
-PSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbF9yb29tX3N1Ympl
-Y3RfZWRpdCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
-ICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8
-L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8
-Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJy
-b29tLWVkaXQtc3ViamVjdC1hcHBseS1idXR0b24iPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5BcHBseTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVm
-YXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0i
-Y2xpY2tlZCIgaGFuZGxlcj0ib25fYXBwbHlfcm9vbV9zdWJqZWN0X2VkaXQiIHN3YXBwZWQ9Im5vIi8+
-CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNr
-aW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24i
-PjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
-ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
-ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+Mjwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hp
-bGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2lu
-Zz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxv
-YmplY3QgY2xhc3M9Ikd0a1NlcGFyYXRvciI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
-ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2Jq
-ZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgICA8cGFja2luZz4KICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
-biI+MTwvcHJvcGVydHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwvY2hpbGQ+CiAgPC9vYmplY3Q+Cjwv
-aW50ZXJmYWNlPgo=
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1NlcGFyYXRvciI+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
+b3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgICA8cGFja2lu
+Zz4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgIDwvcGFja2luZz4KICAgIDwvY2hpbGQ+CiAgPC9v
+YmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
@@ -9909,7 +10713,7 @@ ICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 
 	"/definitions/Main.xml": {
 		local:   "definitions/Main.xml",
-		size:    12840,
+		size:    15375,
 		modtime: 1489449600,
 		compressed: `
 PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtBcHBsaWNhdGlvbldpbmRvdyIgaWQ9Im1haW5X
@@ -9947,185 +10751,228 @@ cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIg
 dHJhbnNsYXRhYmxlPSJ5ZXMiPk5ldyBDb252ZXJzYXRpb24uLi48L3Byb3BlcnR5PgogICAgICAgICAg
 ICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25fbmV3X2Nv
 bnZlcnNhdGlvbiIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVj
-dD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwv
-b2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2Jq
-ZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
-ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlkPSJBY2NvdW50c01lbnUiPgog
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0i
-eWVzIj5fQWNjb3VudHM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ1c2VfdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
-CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
-ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51SXRlbSIgaWQ9IkNoYXRSb29tc01lbnUiPgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVz
-Ij5DaGF0IF9Sb29tczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InVzZV91bmRlcmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZCB0
-eXBlPSJzdWJtZW51Ij4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnUi
-IGlkPSJDaGF0Um9vbXNTdWJtZW51Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hp
-bGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlk
-PSJtdWMtY3JlYXRlLWNoYXQtcm9vbS1tZW51Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNyZWF0ZSBS
-b29tPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ1
-c2VfdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxz
-aWduYWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRsZXI9Im9uX2NyZWF0ZV9jaGF0X3Jvb20iIHN3YXBwZWQ9
-Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51SXRlbSIgaWQ9InNob3dQdWJsaWNSb29t
-c01lbnVJdGVtIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
-Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNob3cgcHVibGljIHJvb21zLi4uPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUiIGhh
-bmRsZXI9Im9uX211Y19zaG93X3B1YmxpY19yb29tcyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0iam9pblJvb21NZW51SXRlbSI+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJs
-ZT0ieWVzIj5Kb2luIGEgUm9vbTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9tdWNfc2hvd19qb2luX3Jvb20iIHN3YXBw
-ZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
-ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0iVmlld01lbnUiPgogICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5fVmlldzwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InVzZV91bmRlcmxpbmUiPlRydWU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJzdWJtZW51Ij4KICAgICAgICAg
-ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnUiIGlkPSJtZW51MiI+CiAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0NoZWNrTWVudUl0ZW0iIGlkPSJDaGVja0l0ZW1NZXJnZSI+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0
-YWJsZT0ieWVzIj5NZXJnZSBBY2NvdW50czwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX3RvZ2dsZWRfY2hlY2tfSXRlbV9N
-ZXJnZSIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxk
-PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NoZWNrTWVudUl0ZW0i
-IGlkPSJDaGVja0l0ZW1TaG93T2ZmbGluZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5TaG93IE9mZmxp
-bmUgQ29udGFjdHM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBu
-YW1lPSJ0b2dnbGVkIiBoYW5kbGVyPSJvbl90b2dnbGVkX2NoZWNrX0l0ZW1fU2hvd19PZmZsaW5lIiBz
-d2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
-ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tNZW51SXRlbSIgaWQ9IkNo
-ZWNrSXRlbVNob3dXYWl0aW5nIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNob3cgV2FpdGluZyBDb250
-YWN0czwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRv
-Z2dsZWQiIGhhbmRsZXI9Im9uX3RvZ2dsZWRfY2hlY2tfSXRlbV9TaG93X1dhaXRpbmciIHN3YXBwZWQ9
-Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDaGVja01lbnVJdGVtIiBpZD0iQ2hlY2tJdGVt
-U29ydFN0YXR1cyI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
-X2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Tb3J0IEJ5IFN0YXR1czwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9
-Im9uX3RvZ2dsZWRfY2hlY2tfSXRlbV9Tb3J0X0J5X1N0YXR1cyIgc3dhcHBlZD0ibm8iLz4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGls
-ZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hp
-bGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
-ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVu
-dUl0ZW0iIGlkPSJPcHRpb25zTWVudSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPl9PcHRpb25zPC9wcm9wZXJ0eT4KICAgICAgICAg
+dD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVt
+IiBpZD0ic2VhcmNoSGlzdG9yeU1lbnUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+U2VhcmNoIEhpc3Rv
+cnkuLi48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJh
+Y3RpdmF0ZSIgaGFuZGxlcj0ib25fc2VhcmNoX2hpc3RvcnkiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxk
+PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJ
+dGVtIiBpZD0iQWNjb3VudHNNZW51Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+X0FjY291bnRzPC9wcm9wZXJ0eT4KICAgICAgICAg
 ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPGNoaWxkIHR5cGU9InN1Ym1lbnUiPgogICAgICAgICAgICAgICAgICAg
-ICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudSIgaWQ9Im9wdGlvbnNfc3VibWVudSI+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0i
+IGlkPSJDaGF0Um9vbXNNZW51Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
+X2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Q2hhdCBfUm9vbXM8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ1c2VfdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8Y2hpbGQgdHlwZT0ic3VibWVudSI+CiAgICAgICAgICAgICAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51IiBpZD0iQ2hhdFJvb21zU3VibWVudSI+CiAgICAgICAgICAg
 ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
 ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0NoZWNrTWVudUl0ZW0iIGlkPSJFbmNyeXB0Q29uZmlndXJhdGlvbkZpbGVDaGVj
-a01lbnVJdGVtIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
-Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkVuY3J5cHQgY29uZmlndXJhdGlvbiBmaWxl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0idG9nZ2xl
-ZCIgaGFuZGxlcj0ib25fdG9nZ2xlZF9lbmNyeXB0X2NvbmZpZ3VyYXRpb25fZmlsZSIgc3dhcHBlZD0i
-bm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAg
-ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0icHJlZmVyZW5jZXNNZW51
-SXRlbSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5QcmVmZXJlbmNlcy4uLjwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRl
-IiBoYW5kbGVyPSJvbl9wcmVmZXJlbmNlcyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
-ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlkPSJI
-ZWxwTWVudSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFs
-c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
-bnNsYXRhYmxlPSJ5ZXMiPl9IZWxwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-PGNoaWxkIHR5cGU9InN1Ym1lbnUiPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
-R3RrTWVudSIgaWQ9Im1lbnUzIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlkPSJm
-ZWVkYmFja01lbnUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
-bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RmVlZGJhY2s8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25f
-ZmVlZGJhY2tfZGlhbG9nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
+Y3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0ibXVjLWNyZWF0ZS1jaGF0LXJvb20tbWVudSI+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRy
+YW5zbGF0YWJsZT0ieWVzIj5DcmVhdGUgUm9vbTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9j
+cmVhdGVfY2hhdF9yb29tIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
 b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
 ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVu
-dUl0ZW0iIGlkPSJhYm91dE1lbnUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+QWJvdXQ8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxl
-cj0ib25fYWJvdXRfZGlhbG9nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAg
+dUl0ZW0iIGlkPSJzaG93UHVibGljUm9vbXNNZW51SXRlbSI+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5T
+aG93IHB1YmxpYyByb29tcy4uLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9tdWNfc2hvd19wdWJsaWNfcm9vbXMiIHN3
+YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51SXRlbSIgaWQ9ImpvaW5Sb29t
+TWVudUl0ZW0iPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Sm9pbiBhIFJvb208L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25f
+bXVjX3Nob3dfam9pbl9yb29tIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAg
 IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAg
 ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
-ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0
-PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
-cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4w
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAg
-ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJz
-ZWFyY2gtYm94Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVy
-dGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtTZWFyY2hCYXIiIGlkPSJzZWFyY2gtYXJlYSI+CiAgICAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51SXRlbSIgaWQ9IlZpZXdNZW51
+Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
+bGU9InllcyI+X1ZpZXc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ1c2VfdW5kZXJsaW5lIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQg
+dHlwZT0ic3VibWVudSI+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51
+IiBpZD0ibWVudTIiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
+Y3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDaGVja01lbnVJdGVtIiBpZD0iQ2hl
+Y2tJdGVtTWVyZ2UiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+TWVyZ2UgQWNjb3VudHM8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJ0b2dnbGVkIiBoYW5kbGVy
+PSJvbl90b2dnbGVkX2NoZWNrX0l0ZW1fTWVyZ2UiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtDaGVja01lbnVJdGVtIiBpZD0iQ2hlY2tJdGVtU2hvd09mZmxpbmUiPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
+c2xhdGFibGU9InllcyI+U2hvdyBPZmZsaW5lIENvbnRhY3RzPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xlZF9j
+aGVja19JdGVtX1Nob3dfT2ZmbGluZSIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0NoZWNrTWVudUl0ZW0iIGlkPSJDaGVja0l0ZW1TaG93V2FpdGluZyI+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5TaG93IFdhaXRpbmcgQ29udGFjdHM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHNpZ25hbCBuYW1lPSJ0b2dnbGVkIiBoYW5kbGVyPSJvbl90b2dnbGVkX2NoZWNrX0l0
+ZW1fU2hvd19XYWl0aW5nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hl
+Y2tNZW51SXRlbSIgaWQ9IkNoZWNrSXRlbVNvcnRTdGF0dXMiPgogICAgICAgICAgICAgICAgICAgICAg
 ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Vu
-dHJ5IiBpZD0ic2VhcmNoLWVudHJ5Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJzaGFkb3dfdHlwZSI+ZXRjaGVkLWluPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlY29uZGFyeV9pY29uX25hbWUiPmVkaXQtZmlu
-ZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhv
-bGRlcl90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+U2VhcmNoLi4uPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmpl
-Y3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFja190eXBl
-Ij5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4x
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAg
-ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJu
-b3RpZmljYXRpb24tYXJlYSI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRp
-b24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAg
-ICAgICAgPGNsYXNzIG5hbWU9Im5vdGlmaWNhdGlvbnMiLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+
-CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgog
-ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4K
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
-ICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8c3R5bGU+CiAgICAgIDxj
-bGFzcyBuYW1lPSJkZWNveWltIi8+CiAgICA8L3N0eWxlPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
+U29ydCBCeSBTdGF0dXM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25h
+bCBuYW1lPSJ0b2dnbGVkIiBoYW5kbGVyPSJvbl90b2dnbGVkX2NoZWNrX0l0ZW1fU29ydF9CeV9TdGF0
+dXMiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0iT3B0aW9uc01lbnUiPgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5fT3B0
+aW9uczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InVzZV91bmRl
+cmxpbmUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJzdWJt
+ZW51Ij4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnUiIGlkPSJvcHRp
+b25zX3N1Ym1lbnUiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
+Y3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDaGVja01lbnVJdGVtIiBpZD0iRW5j
+cnlwdENvbmZpZ3VyYXRpb25GaWxlQ2hlY2tNZW51SXRlbSI+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5F
+bmNyeXB0IGNvbmZpZ3VyYXRpb24gZmlsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX3RvZ2dsZWRfZW5jcnlwdF9jb25m
+aWd1cmF0aW9uX2ZpbGUiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtNZW51
+SXRlbSIgaWQ9IkNoYW5nZU1haW5QYXNzd29yZE1lbnVJdGVtIj4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZW5zaXRpdmUiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPkNoYW5nZSBtYWluIHBhc3N3b3JkLi4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRsZXI9Im9uX2NoYW5nZV9t
+YWluX3Bhc3N3b3JkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVudUl0
+ZW0iIGlkPSJTZXRVcER1cmVzc1Bhc3N3b3JkTWVudUl0ZW0iPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
+dGFibGU9InllcyI+U2V0IHVwIGR1cmVzcyBwYXNzd29yZC4uLjwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9zZXRfdXBf
+ZHVyZXNzX3Bhc3N3b3JkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTWVu
+dUl0ZW0iIGlkPSJSZXN0b3JlQ29uZmlnQmFja3VwTWVudUl0ZW0iPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
+c2xhdGFibGU9InllcyI+UmVzdG9yZSBjb25maWd1cmF0aW9uIGJhY2t1cC4uLjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJv
+bl9yZXN0b3JlX2NvbmZpZ19iYWNrdXAiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtDaGVja01lbnVJdGVtIiBpZD0iVG9yT25seUNoZWNrTWVudUl0ZW0iPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
+bGU9InllcyI+T25seSBjb25uZWN0IHRocm91Z2ggVG9yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xlZF90b3Jf
+b25seSIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a01lbnVJdGVtIiBpZD0i
+cHJlZmVyZW5jZXNNZW51SXRlbSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5QcmVmZXJlbmNlcy4uLjwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlX3Vu
+ZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFs
+IG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9wcmVmZXJlbmNlcyIgc3dhcHBlZD0ibm8iLz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9j
+aGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwv
+Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+TWVudUl0ZW0iIGlkPSJIZWxwTWVudSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPl9IZWxwPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idXNlX3VuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPGNoaWxkIHR5cGU9InN1Ym1lbnUiPgogICAgICAgICAgICAgICAgICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrTWVudSIgaWQ9Im1lbnUzIj4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+TWVudUl0ZW0iIGlkPSJmZWVkYmFja01lbnUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RmVlZGJhY2s8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0
+ZSIgaGFuZGxlcj0ib25fZmVlZGJhY2tfZGlhbG9nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrTWVudUl0ZW0iIGlkPSJhYm91dE1lbnUiPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
+QWJvdXQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJh
+Y3RpdmF0ZSIgaGFuZGxlcj0ib25fYWJvdXRfZGlhbG9nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCb3giIGlkPSJzZWFyY2gtYm94Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJv
+cmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtTZWFyY2hCYXIiIGlkPSJzZWFyY2gtYXJlYSI+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0ic2VhcmNoLWVudHJ5Ij4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzaGFkb3dfdHlwZSI+ZXRjaGVkLWluPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlY29uZGFyeV9pY29u
+X25hbWUiPmVkaXQtZmluZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJwbGFjZWhvbGRlcl90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+U2VhcmNoLi4uPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwv
+Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icGFja190eXBlIj5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCb3giIGlkPSJub3RpZmljYXRpb24tYXJlYSI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzdHls
+ZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9Im5vdGlmaWNhdGlvbnMiLz4KICAgICAgICAg
+ICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwv
+cGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8
+c3R5bGU+CiAgICAgIDxjbGFzcyBuYW1lPSJkZWNveWltIi8+CiAgICA8L3N0eWxlPgogIDwvb2JqZWN0
+Pgo8L2ludGVyZmFjZT4K
 `,
 	},
 
@@ -10177,6 +11024,22 @@ ICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
+	"/definitions/MechanismDowngradeDialog.xml": {
+		local:   "definitions/MechanismDowngradeDialog.xml",
+		size:    480,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtNZXNzYWdlRGlhbG9nIiBpZD0iTWVjaGFuaXNt
+RG93bmdyYWRlRGlhbG9nIj4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19X
+SU5fUE9TX0NFTlRFUl9BTFdBWVM8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9Im1vZGFsIj50
+cnVlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJib3JkZXJfd2lkdGgiPjc8L3Byb3BlcnR5
+PgogICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+V2Vha2VyIGF1dGhl
+bnRpY2F0aW9uIG9mZmVyZWQ8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InNlY29uZGFyeV90
+ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJidXR0
+b25zIj5HVEtfQlVUVE9OU19ZRVNfTk88L3Byb3BlcnR5PgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+`,
+	},
+
 	"/definitions/NewCustomConversation.xml": {
 		local:   "definitions/NewCustomConversation.xml",
 		size:    4762,
@@ -10551,6 +11414,117 @@ PC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
+	"/definitions/ProfileChooser.xml": {
+		local:   "definitions/ProfileChooser.xml",
+		size:    3355,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJQcm9maWxlQ2hvb3NlciI+
+CiAgICA8cHJvcGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5DaG9vc2UgYSBwcm9m
+aWxlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5f
+UE9TX0NFTlRFUjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9w
+cm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWZhdWx0LXdpZHRoIj4zNTA8L3Byb3BlcnR5Pgog
+ICAgPHNpZ25hbCBuYW1lPSJjbG9zZSIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgPHNpZ25hbCBu
+YW1lPSJkZWxldGUtZXZlbnQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4KICAgIDxjaGlsZCBpbnRlcm5h
+bC1jaGlsZD0idmJveCI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9IlZib3giPgogICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4iPjEwPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ic3BhY2luZyI+MTA8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibWVzc2FnZSI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV2ZXJ5IHByb2ZpbGUgaGFzIGl0cyBvd24gYWNjb3Vu
+dHMsIGhpc3RvcnkgYW5kIHNldHRpbmdzLCBhbmQgcnVucyBvbiBpdHMgb3duLjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
+bmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ29tYm9Cb3hUZXh0IiBpZD0icHJvZmlsZXMi
+PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNoYW5nZWQiIGhhbmRsZXI9Im9uX3Byb2ZpbGVfY2hh
+bmdlZCIgLz4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uQm94IiBpZD0icHJvZmlsZUJ1dHRvbnMi
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9I
+T1JJWk9OVEFMPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxheW91dC1zdHls
+ZSI+R1RLX0JVVFRPTkJPWF9TVEFSVDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJzcGFjaW5nIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxv
+YmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bi1uZXciPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+TmV3Li4uPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fbmV3IiAvPgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuLXJlbmFtZSI+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5SZW5hbWUu
+Li48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVy
+PSJvbl9yZW5hbWUiIC8+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24i
+IGlkPSJidG4tZGVsZXRlIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkRlbGV0ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2lnbmFsIG5h
+bWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2RlbGV0ZSIgLz4KICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJhY3Rpb25fYXJlYSI+CiAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCdXR0b25Cb3giIGlkPSJiYm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+Im9yaWVudGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fSE9SSVpPTlRBTDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG4t
+Y2FuY2VsIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxl
+PSJ5ZXMiPlF1aXQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2Vk
+IiBoYW5kbGVyPSJvbl9jYW5jZWwiIC8+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCdXR0b24iIGlkPSJidG4tb3BlbiI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFi
+ZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5PcGVuPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW4tZGVmYXVsdCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2ln
+bmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX29wZW4iIC8+CiAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxk
+PgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8YWN0aW9uLXdpZGdldHM+CiAgICAgIDxh
+Y3Rpb24td2lkZ2V0IHJlc3BvbnNlPSJjYW5jZWwiPmJ0bi1jYW5jZWw8L2FjdGlvbi13aWRnZXQ+CiAg
+ICAgIDxhY3Rpb24td2lkZ2V0IHJlc3BvbnNlPSJvayIgZGVmYXVsdD0idHJ1ZSI+YnRuLW9wZW48L2Fj
+dGlvbi13aWRnZXQ+CiAgICA8L2FjdGlvbi13aWRnZXRzPgogICAgPHN0eWxlPgogICAgICA8Y2xhc3Mg
+bmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+`,
+	},
+
+	"/definitions/ProfileName.xml": {
+		local:   "definitions/ProfileName.xml",
+		size:    2319,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJQcm9maWxlTmFtZSI+CiAg
+ICA8cHJvcGVydHkgbmFtZT0id2luZG93LXBvc2l0aW9uIj5HVEtfV0lOX1BPU19DRU5URVI8L3Byb3Bl
+cnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImJvcmRlcl93aWR0aCI+NzwvcHJvcGVydHk+CiAgICA8cHJv
+cGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5Qcm9maWxlIG5hbWU8L3Byb3BlcnR5
+PgogICAgPHByb3BlcnR5IG5hbWU9ImRlZmF1bHQtd2lkdGgiPjMwMDwvcHJvcGVydHk+CiAgICA8cHJv
+cGVydHkgbmFtZT0ibW9kYWwiPnRydWU8L3Byb3BlcnR5PgogICAgPHNpZ25hbCBuYW1lPSJjbG9zZSIg
+aGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iVmJveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9
+Im1hcmdpbiI+MTA8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMDwv
+cHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhvbW9nZW5lb3VzIj5mYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fVkVS
+VElDQUw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0xhYmVsIiBpZD0ibWVzc2FnZSI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPk5hbWVzIGNhbiBoYXZlIGxldHRlcnMsIGRpZ2l0cywgZG90cywgZGFzaGVz
+IGFuZCB1bmRlcnNjb3Jlcy48L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3Jh
+cCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
+ICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5
+IiBpZD0ibmFtZSI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYXMtZm9jdXMiPnRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2YXRlIiBoYW5kbGVyPSJvbl9zYXZl
+IiAvPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAg
+ICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJhY3Rpb25f
+YXJlYSI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbkJveCIgaWQ9ImJ1dHRvbl9ib3giPgog
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX0hPUklaT05U
+QUw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1
+dHRvbiIgaWQ9ImNhbmNlbCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xp
+Y2tlZCIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9j
+aGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlk
+PSJzYXZlIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9Inll
+cyI+T0s8L3Byb3BlcnR5PgogICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9
+Im9uX3NhdmUiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29i
+amVjdD4KICAgIDwvY2hpbGQ+CiAgICA8c3R5bGU+CiAgICAgIDxjbGFzcyBuYW1lPSJkZWNveWltIi8+
+CiAgICA8L3N0eWxlPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+`,
+	},
+
 	"/definitions/RegistrationForm.xml": {
 		local:   "definitions/RegistrationForm.xml",
 		size:    2230,
@@ -10597,6 +11571,65 @@ bnRlcmZhY2U+Cg==
 `,
 	},
 
+	"/definitions/RestoreConfigBackup.xml": {
+		local:   "definitions/RestoreConfigBackup.xml",
+		size:    3025,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJSZXN0b3JlQ29uZmlnQmFj
+a3VwIj4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRF
+UjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlJlc3RvcmUgY29uZmln
+dXJhdGlvbiBiYWNrdXA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImRlZmF1bHQtd2lkdGgi
+PjQyMDwvcHJvcGVydHk+CiAgICA8c2lnbmFsIG5hbWU9ImNsb3NlIiBoYW5kbGVyPSJvbl9jYW5jZWwi
+IC8+CiAgICA8c2lnbmFsIG5hbWU9ImRlbGV0ZS1ldmVudCIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgog
+ICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94
+IiBpZD0iVmJveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbiI+MTA8L3Byb3BlcnR5Pgog
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMDwvcHJvcGVydHk+CiAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImhvbW9nZW5lb3VzIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im9yaWVudGF0aW9uIj5HVEtfT1JJRU5UQVRJT05fVkVSVElDQUw8L3Byb3BlcnR5PgogICAgICAg
+IDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibWVzc2FnZSI+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkRlY295SU0g
+a2VlcHMgdGhlIGxhc3QgZmV3IHZlcnNpb25zIG9mIHRoZSBjb25maWd1cmF0aW9uLiBDaG9vc2UgdGhl
+IG9uZSB5b3Ugd291bGQgbGlrZSB0byBnbyBiYWNrIHRvLiBUaGUgY29uZmlndXJhdGlvbiBhcyBpdCBp
+cyBub3cgaXMga2VwdCBhcyBhIGJhY2t1cCBhcyB3ZWxsLjwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0NvbWJvQm94VGV4dCIgaWQ9ImJhY2t1cHMiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2Vu
+c2l0aXZlIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0xhYmVsIiBpZD0ic3RhdHVzIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
+c2xhdGFibGU9InllcyI+UmVhZGluZyB0aGUgYmFja3Vwcy4uLjwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4K
+ICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCdXR0b25Cb3giIGlkPSJidXR0b25fYm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3Jp
+ZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9IT1JJWk9OVEFMPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG4tY2FuY2VsIj4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Q2FuY2VsPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9jYW5j
+ZWwiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bi1yZXN0b3JlIj4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+UmVzdG9yZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZW5zaXRpdmUiPmZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbi1kZWZhdWx0Ij50cnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9yZXN0b3JlIiAvPgog
+ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2No
+aWxkPgogICAgPHN0eWxlPgogICAgICA8Y2xhc3MgbmFtZT0iZGVjb3lpbSIvPgogICAgPC9zdHlsZT4K
+ICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+`,
+	},
+
 	"/definitions/Roster.xml": {
 		local:   "definitions/Roster.xml",
 		size:    2006,
@@ -10639,6 +11672,291 @@ CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
+	"/definitions/SearchHistory.xml": {
+		local:   "definitions/SearchHistory.xml",
+		size:    12769,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtMaXN0U3RvcmUiIGlkPSJhY2NvdW50cy1tb2Rl
+bCI+CiAgICA8Y29sdW1ucz4KICAgICAgPCEtLSBhY2NvdW50IG5hbWUgLS0+CiAgICAgIDxjb2x1bW4g
+dHlwZT0iZ2NoYXJhcnJheSIvPgogICAgICA8IS0tIGFjY291bnQgaWQgLS0+CiAgICAgIDxjb2x1bW4g
+dHlwZT0iZ2NoYXJhcnJheSIvPgogICAgPC9jb2x1bW5zPgogIDwvb2JqZWN0PgogIDxvYmplY3QgY2xh
+c3M9Ikd0a0xpc3RTdG9yZSIgaWQ9InJlc3VsdHMtbW9kZWwiPgogICAgPGNvbHVtbnM+CiAgICAgIDwh
+LS0gdGltZSAtLT4KICAgICAgPGNvbHVtbiB0eXBlPSJnY2hhcmFycmF5Ii8+CiAgICAgIDwhLS0gY29u
+dmVyc2F0aW9uIC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4KICAgICAgPCEtLSBm
+cm9tIC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4KICAgICAgPCEtLSBtZXNzYWdl
+IC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4KICAgICAgPCEtLSBpbmRleCBvZiB0
+aGUgcmVzdWx0IC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdpbnQiLz4KICAgIDwvY29sdW1ucz4KICA8
+L29iamVjdD4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJTZWFyY2hIaXN0b3J5Ij4KICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRFUjwvcHJvcGVy
+dHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj42PC9wcm9wZXJ0eT4KICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNlYXJjaCBoaXN0b3J5PC9wcm9wZXJ0
+eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJyZXNpemFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgPHByb3Bl
+cnR5IG5hbWU9ImRlZmF1bHQtaGVpZ2h0Ij41MDA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9
+ImRlZmF1bHQtd2lkdGgiPjcwMDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVzdHJveS13
+aXRoLXBhcmVudCI+dHJ1ZTwvcHJvcGVydHk+CiAgICA8Y2hpbGQgaW50ZXJuYWwtY2hpbGQ9InZib3gi
+PgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJWYm94Ij4KICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaG9tb2dlbmVvdXMiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+b3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9WRVJUSUNBTDwvcHJvcGVydHk+CiAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InNwYWNpbmciPjY8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0dyaWQiIGlkPSJncmlkIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbi10b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
+cmdpbi1zdGFydCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2lu
+LWVuZCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icm93LXNwYWNpbmci
+PjY8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY29sdW1uLXNwYWNpbmciPjY8
+L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrU2VhcmNoRW50cnkiIGlkPSJzZWFyY2gtZW50cnkiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9Imhhcy1mb2N1cyI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaGV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icGxhY2Vob2xkZXItdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPldvcmRzIHRvIHNlYXJjaCBmb3I8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJzZWFyY2gtY2hhbmdlZCIgaGFu
+ZGxlcj0ib25fc2VhcmNoIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0
+YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNo
+Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aCI+NDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJhY2Nv
+dW50c0xhYmVsIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRh
+YmxlPSJ5ZXMiPkFjY291bnQ6PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJoYWxpZ24iPkdUS19BTElHTl9FTkQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQt
+YXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0
+YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDb21i
+b0JveCIgaWQ9ImFjY291bnRzIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RlbCI+
+YWNjb3VudHMtbW9kZWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imhl
+eHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjaGFuZ2Vk
+IiBoYW5kbGVyPSJvbl9zZWFyY2giIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NlbGxSZW5kZXJlclRleHQiIGlkPSJh
+Y2NvdW50LW5hbWUtcmVuZGVyZWQiLz4KICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAgICAg
+ICAgICAgICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ0ZXh0Ij4wPC9hdHRyaWJ1dGU+CiAgICAgICAg
+ICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibGVmdC1hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InRvcC1hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0xhYmVsIiBpZD0icGVlckxhYmVsIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPldpdGg6PC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPkdUS19BTElHTl9FTkQ8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9InBlZXIiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImhleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InBsYWNlaG9sZGVyLXRleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5BbnkgY29udGFjdCBvciByb29tPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2hhbmdlZCIgaGFuZGxlcj0ib25f
+c2VhcmNoIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0YWNoIj4zPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNoIj4xPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InNpbmNl
+TGFiZWwiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9
+InllcyI+RnJvbTo8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGln
+biI+R1RLX0FMSUdOX0VORDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdC1hdHRhY2gi
+PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcC1hdHRhY2giPjI8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0i
+c2luY2UiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyLXRleHQiPllZ
+WVktTU0tREQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXAt
+dGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPk9ubHkgc2hvdyBtZXNzYWdlcyBzZW50IG9uIHRoaXMgZGF5
+IG9yIGxhdGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2hhbmdlZCIg
+aGFuZGxlcj0ib25fc2VhcmNoIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQt
+YXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0
+YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJl
+bCIgaWQ9InVudGlsTGFiZWwiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0
+cmFuc2xhdGFibGU9InllcyI+VG86PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJoYWxpZ24iPkdUS19BTElHTl9FTkQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxl
+ZnQtYXR0YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3At
+YXR0YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwv
+Y2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtF
+bnRyeSIgaWQ9InVudGlsIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRl
+ci10ZXh0Ij5ZWVlZLU1NLUREPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ0b29sdGlwLXRleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5Pbmx5IHNob3cgbWVzc2FnZXMgc2VudCBv
+biB0aGlzIGRheSBvciBlYXJsaWVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFt
+ZT0iY2hhbmdlZCIgaGFuZGxlcj0ib25fc2VhcmNoIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxlZnQtYXR0YWNoIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ0b3AtYXR0YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNs
+YXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9ImVuY3J5cHRlZE9ubHkiPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+T25seSBlbmNyeXB0ZWQgbWVzc2Fn
+ZXM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRyYXdfaW5kaWNhdG9y
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0idG9nZ2xlZCIgaGFu
+ZGxlcj0ib25fc2VhcmNoIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnQtYXR0
+YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3AtYXR0YWNo
+Ij4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aCI+MzwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJv
+cGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtQYW5lZCIgaWQ9InJlc3VsdHMtcGFuZWQiPgogICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPkdUS19PUklFTlRBVElPTl9WRVJUSUNB
+TDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjUwPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbi1zdGFydCI+MTA8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luLWVuZCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrU2Nyb2xsZWRXaW5k
+b3ciIGlkPSJyZXN1bHRzLXNjcm9sbCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2hh
+ZG93LXR5cGUiPmluPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2ZXhw
+YW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXciIGlkPSJyZXN1bHRzLXZpZXciPgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RlbCI+cmVzdWx0cy1tb2RlbDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhlYWRlcnMtdmlzaWJsZSI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXAtdGV4dCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkRvdWJsZSBjbGljayBhIG1lc3NhZ2UgdG8gc2hvdyBpdCBpbiB0aGUgY29u
+dmVyc2F0aW9uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InJvdy1h
+Y3RpdmF0ZWQiIGhhbmRsZXI9Im9uX3Jlc3VsdF9hY3RpdmF0ZWQiIHN3YXBwZWQ9Im5vIi8+CiAgICAg
+ICAgICAgICAgICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJzZWxlY3Rpb24iPgogICAgICAgICAg
+ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVNlbGVjdGlvbiIgaWQ9InJlc3VsdHMtc2Vs
+ZWN0aW9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjaGFuZ2VkIiBoYW5k
+bGVyPSJvbl9yZXN1bHRfc2VsZWN0ZWQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29s
+dW1uIiBpZD0idGltZS1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idGl0bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5UaW1lPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0NlbGxSZW5kZXJlclRleHQiIGlkPSJ0aW1lLWNvbHVtbi1yZW5kZXJlZCIvPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJp
+YnV0ZSBuYW1lPSJ0ZXh0Ij4wPC9hdHRyaWJ1dGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9h
+dHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUcmVlVmll
+d0NvbHVtbiIgaWQ9ImNvbnZlcnNhdGlvbi1jb2x1bW4iPgogICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5Db252ZXJzYXRpb248L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrQ2VsbFJlbmRlcmVyVGV4dCIgaWQ9ImNvbnZlcnNhdGlvbi1jb2x1
+bW4tcmVuZGVyZWQiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlcz4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0idGV4dCI+MTwvYXR0cmlidXRlPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXdDb2x1bW4iIGlkPSJmcm9tLWNvbHVtbiI+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMi
+PkZyb208L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2VsbFJlbmRlcmVyVGV4dCIgaWQ9ImZyb20t
+Y29sdW1uLXJlbmRlcmVkIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9InRleHQiPjI8L2F0dHJpYnV0
+ZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0ibWVzc2FnZS1jb2x1bW4i
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5NZXNzYWdlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NlbGxSZW5kZXJlclRleHQi
+IGlkPSJtZXNzYWdlLWNvbHVtbi1yZW5kZXJlZCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZWxsaXBzaXplIj5QQU5HT19FTExJUFNJWkVfRU5EPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
+YXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0idGV4
+dCI+MzwvYXR0cmlidXRlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a1Njcm9sbGVkV2luZG93IiBpZD0iY29udGV4dC1zY3JvbGwiPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InNoYWRvdy10eXBlIj5pbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RleHRWaWV3IiBpZD0iY29udGV4dCI+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImVkaXRhYmxlIj5GYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImN1cnNvci12aXNpYmxlIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAtbW9kZSI+
+R1RLX1dSQVBfV09SRF9DSEFSPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ibGVmdC1tYXJnaW4iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJyaWdodC1tYXJnaW4iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L29iamVj
+dD4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJz
+dGF0dXMiPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luLXN0YXJ0Ij4xMDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPkdUS19BTElHTl9TVEFSVDwvcHJv
+cGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgog
+ICAgICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrQnV0dG9uQm94IiBpZD0iYnV0dG9uX2JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX0hPUklaT05UQUw8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBp
+ZD0iYnV0dG9uX2Nsb3NlIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPl9DbG9zZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idXNlLXVuZGVybGluZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2lnbmFs
+IG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2Nsb3NlIiAvPgogICAgICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgPC9jaGlsZD4K
+ICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgogICAgICA8Y2xhc3MgbmFtZT0i
+ZGVjb3lpbSIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+`,
+	},
+
+	"/definitions/SetUpDuressPassword.xml": {
+		local:   "definitions/SetUpDuressPassword.xml",
+		size:    3360,
+		modtime: 1489449600,
+		compressed: `
+PGludGVyZmFjZT4KICA8b2JqZWN0IGNsYXNzPSJHdGtEaWFsb2ciIGlkPSJTZXRVcER1cmVzc1Bhc3N3
+b3JkIj4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3ctcG9zaXRpb24iPkdUS19XSU5fUE9TX0NFTlRF
+UjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNldCB1cCBhIGR1cmVz
+cyBwYXNzd29yZDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVmYXVsdC13aWR0aCI+MzAw
+PC9wcm9wZXJ0eT4KICAgIDxzaWduYWwgbmFtZT0iY2xvc2UiIGhhbmRsZXI9Im9uX2NhbmNlbCIgLz4K
+ICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0idmJveCI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Jv
+eCIgaWQ9IlZib3giPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW4iPjEwPC9wcm9wZXJ0eT4K
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+MTA8L3Byb3BlcnR5PgogICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJob21vZ2VuZW91cyI+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJvcmllbnRhdGlvbiI+R1RLX09SSUVOVEFUSU9OX1ZFUlRJQ0FMPC9wcm9wZXJ0eT4KICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InBhc3NNZXNzYWdl
+IiA+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPldo
+ZW4gdGhlIGR1cmVzcyBwYXNzd29yZCBpcyBlbnRlcmVkIGluc3RlYWQgb2YgdGhlIG1haW4gcGFzc3dv
+cmQsIGFuIGVtcHR5IGRlY295IHByb2ZpbGUgaXMgb3BlbmVkLCB3aXRoIGFjY291bnRzIGFuZCBoaXN0
+b3J5IG9mIGl0cyBvd24uIFBsZWFzZSBlbnRlciB0aGUgZHVyZXNzIHBhc3N3b3JkIHR3aWNlLiBBbnkg
+ZWFybGllciBkdXJlc3MgcGFzc3dvcmQgd2lsbCBzdG9wIHdvcmtpbmcuPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
+ZmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAg
+ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9InBhc3N3b3JkIj4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9Imhhcy1mb2N1cyI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2aXNpYmlsaXR5Ij5mYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxzaWduYWwgbmFtZT0i
+YWN0aXZhdGUiIGhhbmRsZXI9Im9uX3NhdmUiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5mYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj50cnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0VudHJ5IiBpZD0icGFzc3dvcmQyIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2liaWxpdHkiPmZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0
+ZSIgaGFuZGxlcj0ib25fc2F2ZSIgLz4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPmZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPnRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+Q2hlY2tCdXR0b24iIGlkPSJkZXN0cm95Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVs
+IiB0cmFuc2xhdGFibGU9InllcyI+RGVzdHJveSB0aGlzIHByb2ZpbGUgd2hlbiB0aGUgZHVyZXNzIHBh
+c3N3b3JkIGlzIHVzZWQ8L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFj
+a2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+ZmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+dHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkIGlu
+dGVybmFsLWNoaWxkPSJhY3Rpb25fYXJlYSI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbkJv
+eCIgaWQ9ImJ1dHRvbl9ib3giPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+R1RL
+X09SSUVOVEFUSU9OX0hPUklaT05UQUw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImNhbmNlbCI+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fY2FuY2VsIiAvPgogICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJzYXZlIj4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxh
+YmVsIiB0cmFuc2xhdGFibGU9InllcyI+T0s8L3Byb3BlcnR5PgogICAgICAgICAgICA8c2lnbmFsIG5h
+bWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX3NhdmUiIC8+CiAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8c3R5bGU+CiAgICAgIDxj
+bGFzcyBuYW1lPSJkZWNveWltIi8+CiAgICA8L3N0eWxlPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+`,
+	},
+
 	"/definitions/SimpleNotification.xml": {
 		local:   "definitions/SimpleNotification.xml",
 		size:    359,
@@ -0,0 +1,146 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/chadsec1/decoyim/session/history"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+type historyExportView struct {
+	u       *gtkUI
+	account *account
+	peer    jid.WithoutResource
+	room    bool
+
+	dialog       gtki.Dialog       `gtk-widget:"ExportHistory"`
+	conversation gtki.Label        `gtk-widget:"conversation"`
+	format       gtki.ComboBoxText `gtk-widget:"format"`
+	since        gtki.Entry        `gtk-widget:"since"`
+	until        gtki.Entry        `gtk-widget:"until"`
+	manifest     gtki.CheckButton  `gtk-widget:"manifest"`
+	acknowledge  gtki.CheckButton  `gtk-widget:"acknowledge"`
+	exportButton gtki.Button       `gtk-widget:"button_export"`
+	status       gtki.Label        `gtk-widget:"status"`
+}
+
+func (v *roomView) onExportHistory() {
+	v.u.exportHistoryWindow(v.account, v.roomID(), true, v.mainWindow())
+}
+
+// exportHistoryWindow lets the user write the stored history of a conversation or room to a file
+func (u *gtkUI) exportHistoryWindow(a *account, peer jid.WithoutResource, room bool, parent gtki.Window) {
+	if !a.session.GetConfig().KeepHistory {
+		u.notify(i18n.Local("No history to export"), i18n.Local("This account doesn't keep a history of conversations. You can turn it on in the settings of the account."))
+		return
+	}
+
+	v := &historyExportView{u: u, account: a, peer: peer, room: room}
+
+	builder := newBuilder("ExportHistory")
+	panicOnDevError(builder.bindObjects(v))
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_cancel":              v.dialog.Destroy,
+		"on_export":              v.export,
+		"on_acknowledge_toggled": v.onAcknowledgeToggled,
+	})
+
+	text := i18n.Localf("Export the history of your conversation with %s.", peer)
+	if room {
+		text = i18n.Localf("Export the history of the room %s.", peer)
+	}
+	v.conversation.SetText(text)
+
+	v.dialog.SetTransientFor(parent)
+	v.dialog.ShowAll()
+}
+
+func (v *historyExportView) onAcknowledgeToggled() {
+	v.exportButton.SetSensitive(v.acknowledge.GetActive())
+}
+
+func (v *historyExportView) period() (time.Time, time.Time, bool) {
+	since, ok1 := parseHistoryDate(v.since)
+	until, ok2 := parseHistoryDate(v.until)
+	if !until.IsZero() {
+		until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return since, until, ok1 && ok2
+}
+
+// suggestedExportName returns the file name offered when choosing where to save the export
+func suggestedExportName(peer jid.WithoutResource, f history.Format) string {
+	name := strings.NewReplacer("@", "_at_", "/", "_").Replace(peer.String())
+	return fmt.Sprintf("%s-%s%s", name, time.Now().Format(historyDateFormat), f.Extension())
+}
+
+func (v *historyExportView) export() {
+	if !v.acknowledge.GetActive() {
+		return
+	}
+
+	since, until, ok := v.period()
+	if !ok {
+		v.status.SetText(i18n.Local("Dates have to be written as YYYY-MM-DD."))
+		return
+	}
+
+	format := history.Format(v.format.GetActiveID())
+	e, err := v.account.session.ExportHistory(v.peer, v.room, since, until)
+	if err != nil {
+		v.u.hasLog.log.WithError(err).Warn("couldn't read the history to export")
+		v.status.SetText(i18n.Local("The history couldn't be read. It might have been encrypted with a different password."))
+		return
+	}
+
+	if len(e.Messages) == 0 {
+		v.status.SetText(i18n.Local("There are no messages to export in this period."))
+		return
+	}
+
+	path, ok := v.chooseFile(format)
+	if !ok {
+		return
+	}
+
+	withManifest := v.manifest.GetActive()
+	if err := e.WriteFile(path, format, withManifest); err != nil {
+		v.u.hasLog.log.WithError(err).WithField("file", path).Warn("couldn't export the history")
+		v.status.SetText(i18n.Local("The history couldn't be written to the file."))
+		return
+	}
+
+	v.dialog.Destroy()
+
+	msg := i18n.Localf("%d messages were written to %s.", len(e.Messages), path)
+	if withManifest {
+		msg = i18n.Localf("%d messages were written to %s. Its SHA-256 digest was written to %s.", len(e.Messages), path, path+history.ManifestExtension)
+	}
+	v.u.notify(i18n.Local("History exported"), msg)
+}
+
+func (v *historyExportView) chooseFile(f history.Format) (string, bool) {
+	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
+		i18n.Local("Choose where to save the history"),
+		v.dialog,
+		gtki.FILE_CHOOSER_ACTION_SAVE,
+		i18n.Local("_Cancel"),
+		gtki.RESPONSE_CANCEL,
+		i18n.Local("_Save"),
+		gtki.RESPONSE_OK,
+	)
+	defer dialog.Destroy()
+
+	dialog.SetCurrentName(suggestedExportName(v.peer, f))
+	dialog.SetDoOverwriteConfirmation(true)
+
+	if gtki.ResponseType(dialog.Run()) != gtki.RESPONSE_OK {
+		return "", false
+	}
+	return dialog.GetFilename(), true
+}
@@ -0,0 +1,391 @@
+package gui
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/chadsec1/decoyim/session/history"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+const (
+	historySearchLimit = 200
+	// historyContextSize is the number of messages shown before and after a search result
+	historyContextSize = 5
+	historyDateFormat  = "2006-01-02"
+)
+
+type historySearchResult struct {
+	account *account
+	match   history.Match
+}
+
+type historySearchView struct {
+	u *gtkUI
+
+	results    []historySearchResult
+	generation int
+	sync.Mutex
+
+	dialog        gtki.Dialog      `gtk-widget:"SearchHistory"`
+	accountsModel gtki.ListStore   `gtk-widget:"accounts-model"`
+	accounts      gtki.ComboBox    `gtk-widget:"accounts"`
+	searchEntry   gtki.SearchEntry `gtk-widget:"search-entry"`
+	peer          gtki.Entry       `gtk-widget:"peer"`
+	since         gtki.Entry       `gtk-widget:"since"`
+	until         gtki.Entry       `gtk-widget:"until"`
+	encryptedOnly gtki.CheckButton `gtk-widget:"encryptedOnly"`
+	resultsModel  gtki.ListStore   `gtk-widget:"results-model"`
+	resultsView   gtki.TreeView    `gtk-widget:"results-view"`
+	context       gtki.TextView    `gtk-widget:"context"`
+	status        gtki.Label       `gtk-widget:"status"`
+}
+
+func (u *gtkUI) accountsKeepingHistory() []*account {
+	var result []*account
+	for _, a := range u.getAllAccounts() {
+		if a.session.GetConfig().KeepHistory {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+func (u *gtkUI) searchHistoryWindow() {
+	accounts := u.accountsKeepingHistory()
+	if len(accounts) == 0 {
+		u.notify(i18n.Local("No history to search"), i18n.Local("None of your accounts keep a history of conversations. You can turn it on in the settings of each account."))
+		return
+	}
+
+	v := &historySearchView{u: u}
+
+	builder := newBuilder("SearchHistory")
+	panicOnDevError(builder.bindObjects(v))
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_close":            v.dialog.Destroy,
+		"on_search":           v.search,
+		"on_result_selected":  v.onResultSelected,
+		"on_result_activated": v.onResultActivated,
+	})
+
+	iter := v.accountsModel.Append()
+	_ = v.accountsModel.SetValue(iter, 0, i18n.Local("All accounts"))
+	_ = v.accountsModel.SetValue(iter, 1, "")
+	for _, a := range accounts {
+		iter := v.accountsModel.Append()
+		_ = v.accountsModel.SetValue(iter, 0, a.Account())
+		_ = v.accountsModel.SetValue(iter, 1, a.ID())
+	}
+	v.accounts.SetActive(0)
+
+	v.context.SetBuffer(u.getTags().createTextBuffer())
+
+	v.dialog.SetTransientFor(u.window)
+	v.dialog.ShowAll()
+}
+
+func (v *historySearchView) selectedAccounts() []*account {
+	all := v.u.accountsKeepingHistory()
+
+	iter, err := v.accounts.GetActiveIter()
+	if err != nil {
+		return all
+	}
+	val, _ := v.accountsModel.GetValue(iter, 1)
+	id, _ := val.GetString()
+	if id == "" {
+		return all
+	}
+
+	for _, a := range all {
+		if a.ID() == id {
+			return []*account{a}
+		}
+	}
+	return nil
+}
+
+func parseHistoryDate(e gtki.Entry) (time.Time, bool) {
+	text, _ := e.GetText()
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return time.Time{}, true
+	}
+
+	t, err := time.ParseInLocation(historyDateFormat, text, time.Local)
+	return t, err == nil
+}
+
+func (v *historySearchView) query() (history.Query, bool) {
+	text, _ := v.searchEntry.GetText()
+	with, _ := v.peer.GetText()
+
+	since, ok1 := parseHistoryDate(v.since)
+	until, ok2 := parseHistoryDate(v.until)
+	if !until.IsZero() {
+		until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	return history.Query{
+		Text:          text,
+		Peer:          strings.TrimSpace(with),
+		Since:         since,
+		Until:         until,
+		EncryptedOnly: v.encryptedOnly.GetActive(),
+		Limit:         historySearchLimit,
+	}, ok1 && ok2
+}
+
+func (v *historySearchView) search() {
+	q, ok := v.query()
+	if !ok {
+		v.status.SetText(i18n.Local("Dates have to be written as YYYY-MM-DD."))
+		return
+	}
+
+	accounts := v.selectedAccounts()
+
+	v.Lock()
+	v.generation++
+	generation := v.generation
+	v.Unlock()
+
+	go func() {
+		results, err := searchHistoryOf(accounts, q)
+
+		doInUIThread(func() {
+			v.Lock()
+			current := generation == v.generation
+			if current {
+				v.results = results
+			}
+			v.Unlock()
+
+			if current {
+				v.showResults(results, err)
+			}
+		})
+	}()
+}
+
+// searchHistoryOf searches in the history of all given accounts. The text in the query
+// can be the address of either a contact or a room.
+func searchHistoryOf(accounts []*account, q history.Query) ([]historySearchResult, error) {
+	var results []historySearchResult
+	var lastErr error
+
+	queries := []history.Query{q}
+	if q.Peer != "" {
+		rq := q
+		rq.Peer, rq.Room = "", q.Peer
+		queries = append(queries, rq)
+	}
+
+	for _, a := range accounts {
+		for _, qq := range queries {
+			ms, err := a.session.SearchHistory(qq)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			for _, m := range ms {
+				results = append(results, historySearchResult{account: a, match: m})
+			}
+		}
+	}
+
+	sortHistorySearchResults(results)
+	if len(results) > historySearchLimit {
+		results = results[:historySearchLimit]
+	}
+
+	return results, lastErr
+}
+
+func sortHistorySearchResults(rs []historySearchResult) {
+	sort.SliceStable(rs, func(i, j int) bool {
+		return rs[i].match.Message.Time.After(rs[j].match.Message.Time)
+	})
+}
+
+// showResults expects to be called from the UI thread
+func (v *historySearchView) showResults(results []historySearchResult, err error) {
+	v.resultsModel.Clear()
+	clearTextView(v.context)
+
+	for i, r := range results {
+		iter := v.resultsModel.Append()
+		_ = v.resultsModel.SetValue(iter, 0, r.match.Message.Time.Format("2006-01-02 15:04"))
+		_ = v.resultsModel.SetValue(iter, 1, r.match.Conversation.Peer)
+		_ = v.resultsModel.SetValue(iter, 2, r.match.Message.From)
+		_ = v.resultsModel.SetValue(iter, 3, firstLineOf(r.match.Message.Body))
+		_ = v.resultsModel.SetValue(iter, 4, i)
+	}
+
+	switch {
+	case err != nil:
+		v.u.hasLog.log.WithError(err).Warn("couldn't search the history")
+		v.status.SetText(i18n.Local("The history couldn't be searched. It might have been encrypted with a different password."))
+	case len(results) == historySearchLimit:
+		v.status.SetText(i18n.Localf("Showing the newest %d messages found.", historySearchLimit))
+	default:
+		v.status.SetText(i18n.Localf("%d messages found.", len(results)))
+	}
+}
+
+func firstLineOf(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + "…"
+	}
+	return s
+}
+
+func clearTextView(tv gtki.TextView) {
+	b, _ := tv.GetBuffer()
+	b.Delete(b.GetStartIter(), b.GetEndIter())
+}
+
+func (v *historySearchView) resultAt(iter gtki.TreeIter) (historySearchResult, bool) {
+	val, err := v.resultsModel.GetValue(iter, 4)
+	if err != nil {
+		return historySearchResult{}, false
+	}
+	gv, _ := val.GoValue()
+	i, ok := gv.(int)
+
+	v.Lock()
+	defer v.Unlock()
+	if !ok || i < 0 || i >= len(v.results) {
+		return historySearchResult{}, false
+	}
+	return v.results[i], true
+}
+
+func (v *historySearchView) selectedResult() (historySearchResult, bool) {
+	selection, err := v.resultsView.GetSelection()
+	if err != nil {
+		return historySearchResult{}, false
+	}
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		return historySearchResult{}, false
+	}
+	return v.resultAt(iter)
+}
+
+// contextOf returns the messages around the result, and the position of the result among them
+func contextOf(r historySearchResult) ([]history.Message, int, error) {
+	ms, err := r.account.session.HistoryWith(jid.Parse(r.match.Conversation.Peer), r.match.Conversation.Room)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The history might have changed since the search, because of the retention limits
+	p := r.match.Position
+	if p >= len(ms) || !sameHistoryMessage(ms[p], r.match.Message) {
+		p = -1
+		for i, m := range ms {
+			if sameHistoryMessage(m, r.match.Message) {
+				p = i
+				break
+			}
+		}
+		if p == -1 {
+			return nil, 0, nil
+		}
+	}
+
+	start, end := p-historyContextSize, p+historyContextSize+1
+	if start < 0 {
+		start = 0
+	}
+	if end > len(ms) {
+		end = len(ms)
+	}
+	return ms[start:end], p - start, nil
+}
+
+func sameHistoryMessage(m1, m2 history.Message) bool {
+	return m1.Time.Equal(m2.Time) && m1.From == m2.From && m1.Body == m2.Body
+}
+
+func (v *historySearchView) onResultSelected() {
+	r, ok := v.selectedResult()
+	if !ok {
+		return
+	}
+
+	ms, position, err := contextOf(r)
+	clearTextView(v.context)
+	if err != nil {
+		v.u.hasLog.log.WithError(err).Warn("couldn't read the history")
+		return
+	}
+
+	buff, _ := v.context.GetBuffer()
+	for i, m := range ms {
+		if buff.GetCharCount() != 0 {
+			insertAtEnd(buff, "\n")
+		}
+		for _, e := range historyEntries(m, i == position) {
+			insertEntry(buff, e)
+		}
+	}
+}
+
+func (v *historySearchView) onResultActivated(_ gtki.TreeView, path gtki.TreePath) {
+	iter, err := v.resultsModel.GetIter(path)
+	if err != nil {
+		return
+	}
+
+	r, ok := v.resultAt(iter)
+	if !ok || r.match.Conversation.Room {
+		return
+	}
+
+	ms, position, err := contextOf(r)
+	if err != nil || len(ms) == 0 {
+		return
+	}
+
+	cv := v.u.openConversationView(r.account, jid.Parse(r.match.Conversation.Peer), true)
+	cv.showHistoryContext(ms, position)
+}
+
+// historyEntries returns the text to show for a message from the history. The message found
+// by a search is shown with its own tag, so it stands out from the messages around it.
+func historyEntries(m history.Message, isResult bool) []*taggableText {
+	sent := sentMessage{
+		message:     m.Body,
+		from:        m.From,
+		timestamp:   m.Time,
+		isEncrypted: m.Encrypted,
+		isOutgoing:  m.Outgoing,
+	}
+
+	entries, _ := sent.tagged()
+	entries = append([]*taggableText{{"timestamp", "[" + m.Time.Format("2006-01-02 "+timeDisplay) + "] "}}, entries...)
+	if isResult {
+		entries[len(entries)-1].tag = "searchResult"
+	}
+	return entries
+}
+
+// showHistoryContext shows messages from the stored history at the end of the conversation
+func (conv *conversationPane) showHistoryContext(ms []history.Message, position int) {
+	conv.displayNotification(i18n.Localf("Messages from the history, around %s:", ms[position].Time.Format("2006-01-02 "+timeDisplay)))
+
+	for i, m := range ms {
+		conv.appendSentMessage(sentMessage{timestamp: m.Time}, false, historyEntries(m, i == position)[1:]...)
+	}
+
+	conv.displayNotification(i18n.Local("End of the messages from the history."))
+}
@@ -1,9 +1,7 @@
 package gui
 
 import (
-	"bufio"
 	"fmt"
-	"os"
 	"sort"
 
 	log "github.com/sirupsen/logrus"
@@ -11,6 +9,7 @@ import (
 	"github.com/chadsec1/decoyim/config"
 	"github.com/chadsec1/decoyim/config/importer"
 	"github.com/chadsec1/decoyim/i18n"
+	"github.com/chadsec1/decoyim/session"
 	"github.com/coyim/gotk3adapter/gtki"
 	"github.com/coyim/otr3"
 )
@@ -80,9 +79,51 @@ func (u *gtkUI) doActualImportOf(choices map[applicationAndAccount]bool, potenti
 	}
 }
 
+// importerRow is one row in the importer: an account in another application, with
+// its configuration, its message logs, or both
+type importerRow struct {
+	canImport bool
+	messages  int
+}
+
+func importerRowsFrom(allImports map[string][]*config.ApplicationConfig, allLogs map[string]importer.Logs) map[applicationAndAccount]*importerRow {
+	rows := make(map[applicationAndAccount]*importerRow)
+	rowFor := func(app, acc string) *importerRow {
+		k := applicationAndAccount{app, acc}
+		if rows[k] == nil {
+			rows[k] = &importerRow{}
+		}
+		return rows[k]
+	}
+
+	for appName, v := range allImports {
+		for _, vv := range v {
+			for _, a := range vv.Accounts {
+				rowFor(appName, a.Account).canImport = true
+			}
+		}
+	}
+
+	for appName, logs := range allLogs {
+		for _, acc := range logs.Accounts() {
+			if acc != "" {
+				rowFor(appName, acc)
+			}
+		}
+	}
+
+	for k, r := range rows {
+		r.messages = allLogs[k.app].ForAccount(k.acc).Count()
+	}
+
+	return rows
+}
+
 func (u *gtkUI) runImporter() {
 	importSettings := make(map[applicationAndAccount]bool)
+	logSettings := make(map[applicationAndAccount]bool)
 	allImports := importer.TryImportAll()
+	allLogs := importer.TryImportAllLogs()
 
 	builder := newBuilder("Importer")
 
@@ -92,34 +133,44 @@ func (u *gtkUI) runImporter() {
 	store := builder.getObj("importAccountsStore")
 	s := store.(gtki.ListStore)
 
-	for appName, v := range allImports {
-		for _, vv := range v {
-			for _, a := range vv.Accounts {
-				it := s.Append()
-				_ = s.SetValue(it, 0, appName)
-				_ = s.SetValue(it, 1, a.Account)
-				_ = s.SetValue(it, 2, false)
-			}
+	for k, r := range importerRowsFrom(allImports, allLogs) {
+		it := s.Append()
+		_ = s.SetValue(it, 0, k.app)
+		_ = s.SetValue(it, 1, k.acc)
+		_ = s.SetValue(it, 2, false)
+		_ = s.SetValue(it, 3, false)
+		_ = s.SetValue(it, 4, r.canImport)
+		_ = s.SetValue(it, 5, r.messages > 0)
+		_ = s.SetValue(it, 6, i18n.Localf("%d messages", r.messages))
+	}
+
+	toggleColumn := func(col int, settings map[applicationAndAccount]bool) func(interface{}, string) {
+		return func(_ interface{}, path string) {
+			iter, _ := s.GetIterFromString(path)
+			current, _ := valAt(s, iter, col).(bool)
+			app, _ := valAt(s, iter, 0).(string)
+			acc, _ := valAt(s, iter, 1).(string)
+
+			settings[applicationAndAccount{app, acc}] = !current
+
+			_ = s.SetValue(iter, col, !current)
 		}
 	}
 
 	rend := builder.getObj("import-this-account-renderer")
 	rr := rend.(gtki.CellRendererToggle)
+	_ = rr.Connect("toggled", toggleColumn(2, importSettings))
 
-	_ = rr.Connect("toggled", func(_ interface{}, path string) {
-		iter, _ := s.GetIterFromString(path)
-		current, _ := valAt(s, iter, 2).(bool)
-		app, _ := valAt(s, iter, 0).(string)
-		acc, _ := valAt(s, iter, 1).(string)
-
-		importSettings[applicationAndAccount{app, acc}] = !current
-
-		_ = s.SetValue(iter, 2, !current)
-	})
+	logsRend := builder.getObj("import-logs-renderer")
+	lr := logsRend.(gtki.CellRendererToggle)
+	_ = lr.Connect("toggled", toggleColumn(3, logSettings))
 
 	_ = w.Connect("response", func(_ interface{}, rid int) {
 		if gtki.ResponseType(rid) == gtki.RESPONSE_OK {
 			u.doActualImportOf(importSettings, allImports)
+			if u.keepHistoryForImportedLogs(logSettings) {
+				go u.doActualLogImportOf(logSettings, allLogs)
+			}
 		}
 		w.Destroy()
 	})
@@ -131,6 +182,85 @@ func (u *gtkUI) runImporter() {
 	})
 }
 
+// keepHistoryForImportedLogs turns on the history for the accounts whose logs will be imported, since
+// importing them shows the user wants to keep them. It returns false if the logs can't be imported at all.
+func (u *gtkUI) keepHistoryForImportedLogs(choices map[applicationAndAccount]bool) bool {
+	var accounts []string
+	for k, v := range choices {
+		if v {
+			accounts = append(accounts, k.acc)
+		}
+	}
+
+	if len(accounts) == 0 {
+		return false
+	}
+
+	if !u.config().HasEncryptedStorage() {
+		u.notify(i18n.Local("Unable to Import Message Logs"), i18n.Local("The history of conversations is encrypted with your master password, "+
+			"so message logs can only be imported when the configuration file is encrypted."))
+		return false
+	}
+
+	changed := false
+	for _, acc := range accounts {
+		if a, ok := u.config().GetAccount(acc); ok && !a.KeepHistory {
+			a.KeepHistory = true
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := u.saveConfigInternal(); err != nil {
+			u.hasLog.log.WithError(err).Warn("Failed to save config")
+		}
+	}
+
+	return true
+}
+
+// doActualLogImportOf adds the chosen message logs to the history. Messages that are already
+// in the history are skipped, so importing the same logs again doesn't do any harm.
+func (u *gtkUI) doActualLogImportOf(choices map[applicationAndAccount]bool, allLogs map[string]importer.Logs) {
+	store, err := session.OpenHistory(u.config())
+	if err != nil {
+		u.hasLog.log.WithError(err).Warn("Can't open the history to import message logs")
+		u.notify(i18n.Local("Unable to Import Message Logs"), i18n.Local("The history of conversations couldn't be opened."))
+		return
+	}
+
+	added, failed := 0, 0
+	for k, v := range choices {
+		if !v {
+			continue
+		}
+
+		for c, ms := range allLogs[k.app].ForAccount(k.acc) {
+			n, err := store.Import(c, ms)
+			if err != nil {
+				u.hasLog.log.WithFields(log.Fields{
+					"feature":       "import",
+					"importAccount": k.acc,
+					"importApp":     k.app,
+				}).WithError(err).Warn("Failed to import message logs")
+				failed++
+				continue
+			}
+			added += n
+		}
+	}
+
+	if err := store.SaveIndex(); err != nil {
+		u.hasLog.log.WithError(err).Warn("Failed to save the search index")
+	}
+
+	if failed > 0 {
+		u.notify(i18n.Local("Message logs imported"), i18n.Localf("%d messages were added to the history. The logs of %d conversations couldn't be imported.", added, failed))
+		return
+	}
+	u.notify(i18n.Local("Message logs imported"), i18n.Localf("%d messages were added to the history.", added))
+}
+
 func (u *gtkUI) importFingerprintsFor(account *config.Account, file string) (int, bool) {
 	fprs, ok := importer.ImportFingerprintsFromPidginStyle(file, func(string) bool { return true })
 	if !ok {
@@ -247,50 +377,6 @@ func (u *gtkUI) importKeysFor(account *config.Account, file string) (int, bool)
 	}
 }
 
-func (u *gtkUI) exportFingerprintsFor(account *config.Account, file string) bool {
-	f, err := os.Create(file)
-	if err != nil {
-		return false
-	}
-	defer func() {
-		_ = f.Close()
-	}()
-	bw := bufio.NewWriter(f)
-
-	for _, p := range account.Peers {
-		for _, fpr := range p.Fingerprints {
-			trusted := ""
-			if fpr.Trusted {
-				trusted = "\tverified"
-			}
-			_, _ = bw.WriteString(fmt.Sprintf("%s\t%s/\tprpl-jabber\t%x%s\n", p.UserID, account.Account, fpr.Fingerprint, trusted))
-		}
-	}
-
-	_ = bw.Flush()
-	return true
-}
-
-func (u *gtkUI) exportKeysFor(account *config.Account, file string) bool {
-	var result []*otr3.Account
-
-	allKeys := account.AllPrivateKeys()
-
-	for _, pp := range allKeys {
-		_, ok, parsedKey := otr3.ParsePrivateKey(pp)
-		if ok {
-			result = append(result, &otr3.Account{
-				Name:     account.Account,
-				Protocol: "prpl-jabber",
-				Key:      parsedKey,
-			})
-		}
-	}
-
-	err := otr3.ExportKeysToFile(result, file)
-	return err == nil
-}
-
 func (u *gtkUI) importKeysForDialog(account *config.Account, w gtki.Dialog) {
 	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
 		i18n.Local("Import private keys"),
@@ -316,30 +402,6 @@ func (u *gtkUI) importKeysForDialog(account *config.Account, w gtki.Dialog) {
 	dialog.Destroy()
 }
 
-func (u *gtkUI) exportKeysForDialog(account *config.Account, w gtki.Dialog) {
-	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
-		i18n.Local("Export private keys"),
-		w,
-		gtki.FILE_CHOOSER_ACTION_SAVE,
-		i18n.Local("_Cancel"),
-		gtki.RESPONSE_CANCEL,
-		i18n.Local("_Export"),
-		gtki.RESPONSE_OK,
-	)
-
-	dialog.SetCurrentName("otr.private_key")
-
-	if gtki.ResponseType(dialog.Run()) == gtki.RESPONSE_OK {
-		ok := u.exportKeysFor(account, dialog.GetFilename())
-		if ok {
-			u.notify(i18n.Local("Keys exported"), i18n.Local("Keys were exported correctly."))
-		} else {
-			u.notify(i18n.Local("Failure exporting keys"), i18n.Localf("Couldn't export keys to %s.", dialog.GetFilename()))
-		}
-	}
-	dialog.Destroy()
-}
-
 func (u *gtkUI) importFingerprintsForDialog(account *config.Account, w gtki.Dialog) {
 	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
 		i18n.Local("Import fingerprints"),
@@ -361,27 +423,3 @@ func (u *gtkUI) importFingerprintsForDialog(account *config.Account, w gtki.Dial
 	}
 	dialog.Destroy()
 }
-
-func (u *gtkUI) exportFingerprintsForDialog(account *config.Account, w gtki.Dialog) {
-	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
-		i18n.Local("Export fingerprints"),
-		w,
-		gtki.FILE_CHOOSER_ACTION_SAVE,
-		i18n.Local("_Cancel"),
-		gtki.RESPONSE_CANCEL,
-		i18n.Local("_Export"),
-		gtki.RESPONSE_OK,
-	)
-
-	dialog.SetCurrentName("otr.fingerprints")
-
-	if gtki.ResponseType(dialog.Run()) == gtki.RESPONSE_OK {
-		ok := u.exportFingerprintsFor(account, dialog.GetFilename())
-		if ok {
-			u.notify(i18n.Local("Fingerprints exported"), i18n.Local("Fingerprints were exported correctly."))
-		} else {
-			u.notify(i18n.Local("Failure exporting fingerprints"), i18n.Localf("Couldn't export fingerprints to %s.", dialog.GetFilename()))
-		}
-	}
-	dialog.Destroy()
-}
@@ -53,6 +53,179 @@ func (u *gtkUI) captureInitialMainPassword(k func(), onCancel func()) {
 	})
 }
 
+func (u *gtkUI) changeMainPassword() {
+	if u.config() == nil || !u.config().HasEncryptedStorage() {
+		return
+	}
+
+	dialogID := "ChangeMainPassword"
+	builder := newBuilder(dialogID)
+	dialogOb := builder.getObj(dialogID)
+	pwdDialog := dialogOb.(gtki.Dialog)
+
+	currentObj := builder.getObj("currentPassword")
+	currentPassword := currentObj.(gtki.Entry)
+
+	passObj := builder.getObj("password")
+	password := passObj.(gtki.Entry)
+
+	pass2Obj := builder.getObj("password2")
+	password2 := pass2Obj.(gtki.Entry)
+
+	msgObj := builder.getObj("passMessage")
+	messageObj := msgObj.(gtki.Label)
+	messageObj.SetSelectable(true)
+
+	saveObj := builder.getObj("save")
+	saveButton := saveObj.(gtki.Button)
+
+	changing := false
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_save": func() {
+			if changing {
+				return
+			}
+
+			currentText, _ := currentPassword.GetText()
+			passText1, _ := password.GetText()
+			passText2, _ := password2.GetText()
+			if len(passText1) == 0 {
+				messageObj.SetMarkup(i18n.Local("<b>Password can not be empty</b> - please try again"))
+				password.GrabFocus()
+				return
+			}
+			if passText1 != passText2 {
+				messageObj.SetLabel(i18n.Local("Passwords have to be the same - please try again"))
+				password.GrabFocus()
+				return
+			}
+
+			changing = true
+			saveButton.SetSensitive(false)
+			messageObj.SetLabel(i18n.Local("Changing the password..."))
+
+			// Generating the keys takes a while, so it can't happen in the UI thread
+			go func() {
+				err := u.config().ChangePassword(u.passwordKeySupplier(), currentText, passText1)
+				doInUIThread(func() {
+					changing = false
+					saveButton.SetSensitive(true)
+
+					switch err {
+					case nil:
+						pwdDialog.Destroy()
+					case config.ErrWrongPassword:
+						messageObj.SetLabel(i18n.Local("Incorrect password entered, please try again."))
+						currentPassword.GrabFocus()
+					default:
+						u.hasLog.log.WithError(err).Warn("Failed to change the main password")
+						messageObj.SetLabel(i18n.Local("The password couldn't be changed. The old password still works."))
+					}
+				})
+			}()
+		},
+		"on_cancel": func() {
+			if !changing {
+				pwdDialog.Destroy()
+			}
+		},
+	})
+
+	pwdDialog.SetTransientFor(u.window)
+	pwdDialog.ShowAll()
+}
+
+func (u *gtkUI) setUpDuressPassword() {
+	if u.config() == nil || !u.config().HasEncryptedStorage() {
+		return
+	}
+
+	dialogID := "SetUpDuressPassword"
+	builder := newBuilder(dialogID)
+	dialogOb := builder.getObj(dialogID)
+	pwdDialog := dialogOb.(gtki.Dialog)
+
+	passObj := builder.getObj("password")
+	password := passObj.(gtki.Entry)
+
+	pass2Obj := builder.getObj("password2")
+	password2 := pass2Obj.(gtki.Entry)
+
+	destroyObj := builder.getObj("destroy")
+	destroy := destroyObj.(gtki.CheckButton)
+
+	msgObj := builder.getObj("passMessage")
+	messageObj := msgObj.(gtki.Label)
+	messageObj.SetSelectable(true)
+
+	saveObj := builder.getObj("save")
+	saveButton := saveObj.(gtki.Button)
+
+	settingUp := false
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_save": func() {
+			if settingUp {
+				return
+			}
+
+			passText1, _ := password.GetText()
+			passText2, _ := password2.GetText()
+			if len(passText1) == 0 {
+				messageObj.SetMarkup(i18n.Local("<b>Password can not be empty</b> - please try again"))
+				password.GrabFocus()
+				return
+			}
+			if passText1 != passText2 {
+				messageObj.SetLabel(i18n.Local("Passwords have to be the same - please try again"))
+				password.GrabFocus()
+				return
+			}
+
+			settingUp = true
+			saveButton.SetSensitive(false)
+			destroyThis := destroy.GetActive()
+
+			go func() {
+				err := u.config().SetUpDecoy(u.passwordKeySupplier(), passText1, destroyThis)
+				doInUIThread(func() {
+					settingUp = false
+					saveButton.SetSensitive(true)
+
+					switch err {
+					case nil:
+						pwdDialog.Destroy()
+					case config.ErrDuressPasswordIsMainPassword:
+						messageObj.SetLabel(i18n.Local("The duress password can't be the same as the main password."))
+						password.GrabFocus()
+					default:
+						u.hasLog.log.WithError(err).Warn("Failed to set up the duress password")
+						messageObj.SetLabel(i18n.Local("The duress password couldn't be set up."))
+					}
+				})
+			}()
+		},
+		"on_cancel": func() {
+			if !settingUp {
+				pwdDialog.Destroy()
+			}
+		},
+	})
+
+	pwdDialog.SetTransientFor(u.window)
+	pwdDialog.ShowAll()
+}
+
+// passwordKeySupplier returns the key supplier that should remember the keys for a new password. After the
+// main password has been configured, the password given then has already been used, so it doesn't matter anymore.
+func (u *gtkUI) passwordKeySupplier() config.KeySupplier {
+	if o, ok := u.keySupplier.(*onetimeSavedPassword); ok && o.savedPassword == "" {
+		u.keySupplier = o.realF
+	}
+	return u.keySupplier
+}
+
 func (u *gtkUI) wouldYouLikeToEncryptYourFile(k func(bool)) {
 	dialogID := "AskToEncrypt"
 	builder := newBuilder(dialogID)
@@ -92,7 +265,7 @@ func (o *onetimeSavedPassword) GenerateKey(params config.EncryptionParameters) (
 	return o.realF.GenerateKey(params)
 }
 
-func (u *gtkUI) getMainPassword(params config.EncryptionParameters, lastAttemptFailed bool) ([]byte, []byte, bool) {
+func (u *gtkUI) getMainPassword(lastAttemptFailed bool) (string, bool) {
 	dialogID := "MainPassword"
 	pwdResultChan := make(chan string)
 	var cleanup func()
@@ -146,10 +319,9 @@ func (u *gtkUI) getMainPassword(params config.EncryptionParameters, lastAttemptF
 
 	if !ok {
 		doInUIThread(cleanup)
-		return nil, nil, false
+		return "", false
 	}
 
-	l, r := config.GenerateKeys(pwd, params)
 	doInUIThread(cleanup)
-	return l, r, true
+	return pwd, true
 }
@@ -79,6 +79,7 @@ func (t *roomViewToolbar) initBuilder() {
 		"on_modify_position_lists":    t.roomView.onRoomPositionsView,
 		"on_destroy_room":             t.roomView.onDestroyRoom,
 		"on_leave_room":               t.roomView.onLeaveRoom,
+		"on_export_history":           t.roomView.onExportHistory,
 	})
 }
 
@@ -3,6 +3,8 @@ package gui
 import (
 	"time"
 
+	ournet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/session/events"
 	"github.com/chadsec1/decoyim/xmpp/jid"
 	"github.com/coyim/gotk3adapter/gtki"
 )
@@ -52,6 +54,21 @@ func (u *gtkUI) showConnectAccountNotification(account *account) func() {
 	}
 }
 
+func (u *gtkUI) notifyTorBootstrapping(account *account, st *ournet.TorBootstrapStatus) {
+	doInUIThread(func() {
+		notification := account.buildTorBootstrappingNotification(st)
+		account.setCurrentNotification(notification, u.notificationArea)
+	})
+}
+
+func (u *gtkUI) notifyReconnecting(account *account, ev events.Reconnect) {
+	doInUIThread(func() {
+		notification := account.buildReconnectingNotification(ev)
+		account.setCurrentNotification(notification, u.notificationArea)
+		account.reconnectingNotification = notification
+	})
+}
+
 func (u *gtkUI) notifyTorIsNotRunning(account *account, moreInfo func()) {
 	doInUIThread(func() {
 		notification := account.buildTorNotRunningNotification(moreInfo)
@@ -59,6 +76,13 @@ func (u *gtkUI) notifyTorIsNotRunning(account *account, moreInfo func()) {
 	})
 }
 
+func (u *gtkUI) notifyTorIsolationUnconfirmed(account *account) {
+	doInUIThread(func() {
+		notification := account.buildTorIsolationUnconfirmedNotification(u.torIsolationUnconfirmedMoreInfo)
+		account.setCurrentNotification(notification, u.notificationArea)
+	})
+}
+
 func (u *gtkUI) notifyConnectionFailure(account *account, moreInfo func()) {
 	doInUIThread(func() {
 		notification := account.buildConnectionFailureNotification(moreInfo)
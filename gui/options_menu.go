@@ -2,26 +2,80 @@ package gui
 
 import (
 	"github.com/chadsec1/decoyim/config"
+	ournet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/session"
 	"github.com/coyim/gotk3adapter/gtki"
 )
 
 type optionsMenu struct {
-	encryptConfig gtki.CheckMenuItem
+	encryptConfig      gtki.CheckMenuItem
+	changeMainPassword gtki.MenuItem
+	setUpDuress        gtki.MenuItem
+	restoreBackup      gtki.MenuItem
+	torOnly            gtki.CheckMenuItem
 }
 
 func (v *optionsMenu) setFromConfig(c *config.ApplicationConfig) {
 	doInUIThread(func() {
 		v.encryptConfig.SetActive(c.HasEncryptedStorage())
+		v.setPasswordItemsSensitive(c.HasEncryptedStorage())
+		v.restoreBackup.SetSensitive(true)
+		v.torOnly.SetActive(c.TorOnly)
 	})
 }
 
+// setPasswordItemsSensitive enables the items that only make sense when there is a main password
+func (v *optionsMenu) setPasswordItemsSensitive(val bool) {
+	v.changeMainPassword.SetSensitive(val)
+	v.setUpDuress.SetSensitive(val)
+}
+
+func (u *gtkUI) toggleTorOnly() {
+	if u.config() != nil {
+		val := u.optionsMenu.torOnly.GetActive()
+		if u.config().TorOnly != val {
+			u.config().TorOnly = val
+			ournet.Dialers.SetTorOnly(val)
+			u.saveConfigOnly()
+		}
+	}
+}
+
+// confirmHistoryRemoval asks before turning off encryption when there is a history of conversations, since
+// the history can't be read without the main password. The history is removed if the user agrees.
+func (u *gtkUI) confirmHistoryRemoval() bool {
+	if !session.HasHistory(u.config()) {
+		return true
+	}
+
+	confirm := newBuilder("ConfirmHistoryRemoval").getObj("RemoveHistory").(gtki.MessageDialog)
+	confirm.SetTransientFor(u.window)
+	response := confirm.Run()
+	confirm.Destroy()
+	if gtki.ResponseType(response) != gtki.RESPONSE_YES {
+		return false
+	}
+
+	if err := session.DeleteHistory(u.config()); err != nil {
+		u.hasLog.log.WithError(err).Warn("Failed to remove the history of conversations")
+	}
+	return true
+}
+
 func (u *gtkUI) toggleEncryptedConfig() {
 	if u.config() != nil {
 		val := u.optionsMenu.encryptConfig.GetActive()
+		if !val && u.config().HasEncryptedStorage() && !u.confirmHistoryRemoval() {
+			// This is called again when the item is set back, but there is nothing to change then
+			u.optionsMenu.encryptConfig.SetActive(true)
+			return
+		}
+		u.optionsMenu.setPasswordItemsSensitive(val)
 		if u.config().SetShouldSaveFileEncrypted(val) {
 			if val {
 				u.captureInitialMainPassword(u.saveConfigOnly, func() {
 					u.config().SetShouldSaveFileEncrypted(false)
+					u.optionsMenu.setPasswordItemsSensitive(false)
 					u.saveConfigOnly()
 				})
 			} else {
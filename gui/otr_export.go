@@ -0,0 +1,209 @@
+package gui
+
+import (
+	"os"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/coyim/otr3"
+)
+
+// otrExport describes one of the things that can be exported for clients using libotr
+type otrExport struct {
+	title       string
+	message     string
+	fileName    string
+	write       func(*config.Account, config.LibOTRClient, string) error
+	done        func(u *gtkUI)
+	failed      func(u *gtkUI, file string)
+	privateKeys bool
+}
+
+func otrKeysExport() otrExport {
+	return otrExport{
+		title: i18n.Local("Export private keys"),
+		message: i18n.Local("Anyone who gets a copy of these keys can pretend to be you in encrypted conversations. " +
+			"Only save them somewhere nobody else can read, and delete the file once you have imported it."),
+		fileName: "otr.private_key",
+		write:    exportKeysFor,
+		done: func(u *gtkUI) {
+			u.notify(i18n.Local("Keys exported"), i18n.Local("Keys were exported correctly."))
+		},
+		failed: func(u *gtkUI, file string) {
+			u.notify(i18n.Local("Failure exporting keys"), i18n.Localf("Couldn't export keys to %s.", file))
+		},
+		privateKeys: true,
+	}
+}
+
+func otrFingerprintsExport() otrExport {
+	return otrExport{
+		title:    i18n.Local("Export fingerprints"),
+		message:  i18n.Local("The fingerprints you have verified will be marked as verified in the other client as well."),
+		fileName: "otr.fingerprints",
+		write:    exportFingerprintsFor,
+		done: func(u *gtkUI) {
+			u.notify(i18n.Local("Fingerprints exported"), i18n.Local("Fingerprints were exported correctly."))
+		},
+		failed: func(u *gtkUI, file string) {
+			u.notify(i18n.Local("Failure exporting fingerprints"), i18n.Localf("Couldn't export fingerprints to %s.", file))
+		},
+	}
+}
+
+// createPrivateFile opens the file for writing, so only the user can read it. The file might have existed
+// before, so its permissions are changed before anything is written to it.
+func createPrivateFile(file string) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Chmod(0600); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func exportKeysFor(account *config.Account, client config.LibOTRClient, file string) error {
+	f, err := createPrivateFile(file)
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return otr3.ExportKeysToFile(account.LibOTRPrivateKeys(client), file)
+}
+
+func exportFingerprintsFor(account *config.Account, client config.LibOTRClient, file string) error {
+	f, err := createPrivateFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := account.WriteLibOTRFingerprints(f, client); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (u *gtkUI) exportKeysForDialog(account *config.Account, w gtki.Dialog) {
+	u.exportOTRDataDialog(account, w, otrKeysExport())
+}
+
+func (u *gtkUI) exportFingerprintsForDialog(account *config.Account, w gtki.Dialog) {
+	u.exportOTRDataDialog(account, w, otrFingerprintsExport())
+}
+
+// exportOTRDataDialog asks which client to export for and, when the configuration file is encrypted,
+// for the main password, before asking where to save the file
+func (u *gtkUI) exportOTRDataDialog(account *config.Account, w gtki.Dialog, e otrExport) {
+	builder := newBuilder("ExportOTRData")
+	dialog := builder.getObj("ExportOTRData").(gtki.Dialog)
+	messageObj := builder.getObj("message").(gtki.Label)
+	clients := builder.getObj("clients").(gtki.ComboBoxText)
+	password := builder.getObj("password").(gtki.Entry)
+	status := builder.getObj("status").(gtki.Label)
+	exportButton := builder.getObj("btn-export").(gtki.Button)
+
+	dialog.SetTitle(e.title)
+	dialog.SetTransientFor(w)
+	if e.privateKeys {
+		messageObj.SetMarkup("<b>" + e.message + "</b>")
+	} else {
+		messageObj.SetLabel(e.message)
+	}
+
+	for _, cl := range config.LibOTRClients {
+		clients.AppendText(cl.Name)
+	}
+	clients.SetActive(0)
+
+	needsPassword := u.config().HasEncryptedStorage()
+	if needsPassword {
+		password.Show()
+	}
+
+	checking := false
+
+	export := func(client config.LibOTRClient) {
+		fc, _ := g.gtk.FileChooserDialogNewWith2Buttons(
+			e.title,
+			w,
+			gtki.FILE_CHOOSER_ACTION_SAVE,
+			i18n.Local("_Cancel"),
+			gtki.RESPONSE_CANCEL,
+			i18n.Local("_Export"),
+			gtki.RESPONSE_OK,
+		)
+		fc.SetCurrentName(e.fileName)
+		fc.SetDoOverwriteConfirmation(true)
+
+		if gtki.ResponseType(fc.Run()) == gtki.RESPONSE_OK {
+			file := fc.GetFilename()
+			if err := e.write(account, client, file); err != nil {
+				u.hasLog.log.WithError(err).Warn("Failed to export OTR data")
+				e.failed(u, file)
+			} else {
+				e.done(u)
+			}
+		}
+		fc.Destroy()
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_export": func() {
+			if checking {
+				return
+			}
+
+			ix := clients.GetActive()
+			if ix < 0 || ix >= len(config.LibOTRClients) {
+				return
+			}
+			client := config.LibOTRClients[ix]
+
+			if !needsPassword {
+				dialog.Destroy()
+				export(client)
+				return
+			}
+
+			pwd, _ := password.GetText()
+			checking = true
+			exportButton.SetSensitive(false)
+			status.SetLabel(i18n.Local("Checking the password..."))
+
+			// Deriving the key takes a while, so it can't happen in the UI thread
+			go func() {
+				err := u.config().CheckPassword(pwd)
+				doInUIThread(func() {
+					checking = false
+					exportButton.SetSensitive(true)
+
+					switch err {
+					case nil:
+						dialog.Destroy()
+						export(client)
+					case config.ErrWrongPassword:
+						status.SetLabel(i18n.Local("Incorrect password entered, please try again."))
+						password.GrabFocus()
+					default:
+						u.hasLog.log.WithError(err).Warn("Failed to check the password")
+						status.SetLabel(err.Error())
+					}
+				})
+			}()
+		},
+		"on_cancel": func() {
+			dialog.Destroy()
+		},
+	})
+
+	dialog.ShowAll()
+}
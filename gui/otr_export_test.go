@@ -0,0 +1,54 @@
+package gui
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chadsec1/decoyim/config"
+
+	. "gopkg.in/check.v1"
+)
+
+type OTRExportSuite struct{}
+
+var _ = Suite(&OTRExportSuite{})
+
+func (s *OTRExportSuite) Test_exportFingerprintsFor_writesAFileOnlyTheUserCanRead(c *C) {
+	file := filepath.Join(c.MkDir(), "otr.fingerprints")
+	c.Assert(ioutil.WriteFile(file, []byte("something that was there before"), 0644), IsNil)
+	c.Assert(os.Chmod(file, 0644), IsNil)
+
+	account := &config.Account{
+		Account: "someone@example.org",
+		Peers:   []*config.Peer{{UserID: "alice@example.org", Fingerprints: []*config.Fingerprint{{Fingerprint: []byte{0x01}, Trusted: true}}}},
+	}
+	c.Assert(exportFingerprintsFor(account, config.ProfanityOTRClient, file), IsNil)
+
+	content, _ := ioutil.ReadFile(file)
+	c.Assert(string(content), Equals, "alice@example.org\tsomeone@example.org\txmpp\t01\tverified\n")
+
+	info, err := os.Stat(file)
+	c.Assert(err, IsNil)
+	c.Assert(info.Mode().Perm(), Equals, os.FileMode(0600))
+}
+
+func (s *OTRExportSuite) Test_exportKeysFor_writesAFileOnlyTheUserCanRead(c *C) {
+	file := filepath.Join(c.MkDir(), "otr.private_key")
+	c.Assert(ioutil.WriteFile(file, []byte("something that was there before"), 0644), IsNil)
+	c.Assert(os.Chmod(file, 0644), IsNil)
+
+	account, err := config.NewAccount()
+	c.Assert(err, IsNil)
+	account.Account = "someone@example.org"
+	c.Assert(exportKeysFor(account, config.ProfanityOTRClient, file), IsNil)
+
+	content, _ := ioutil.ReadFile(file)
+	c.Assert(strings.Contains(string(content), "someone@example.org"), Equals, true)
+	c.Assert(strings.Contains(string(content), "something that was there before"), Equals, false)
+
+	info, err := os.Stat(file)
+	c.Assert(err, IsNil)
+	c.Assert(info.Mode().Perm(), Equals, os.FileMode(0600))
+}
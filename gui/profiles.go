@@ -0,0 +1,197 @@
+package gui
+
+import (
+	"encoding/hex"
+	"os"
+	"os/exec"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+// applicationIDFor returns the application ID for the profile. Every profile has its own, so the same
+// profile is never opened by two processes, and different profiles never share a process.
+func applicationIDFor(profile string) string {
+	if profile == "" {
+		return applicationID
+	}
+	return applicationID + ".profile_" + hex.EncodeToString([]byte(profile))
+}
+
+// configFileToLoad returns the configuration file given on the command line, or the one of the profile.
+// Giving both is refused when the application starts, with config.ErrConfigFileWithProfile.
+func configFileToLoad() string {
+	if *config.ConfigFile != "" {
+		return *config.ConfigFile
+	}
+
+	f, _ := config.ProfileConfigFile(*config.ProfileFlag)
+	return f
+}
+
+// shouldChooseProfile returns true if no profile or configuration file was given on the command line,
+// but there are named profiles to choose from
+func shouldChooseProfile() bool {
+	return *config.ConfigFile == "" && *config.ProfileFlag == "" && len(config.Profiles()) > 0
+}
+
+func (u *gtkUI) loadConfigOrChooseProfile() {
+	if shouldChooseProfile() {
+		u.chooseProfile()
+		return
+	}
+
+	go u.loadConfig(configFileToLoad())
+}
+
+// openProfileInNewProcess starts the application again with the named profile, and quits this one,
+// so that the profile gets a process of its own
+func (u *gtkUI) openProfileInNewProcess(name string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(exe, append([]string{"--profile", name}, os.Args[1:]...)...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	u.quit()
+	return nil
+}
+
+func (u *gtkUI) chooseProfile() {
+	builder := newBuilder("ProfileChooser")
+	dialog := builder.getObj("ProfileChooser").(gtki.Dialog)
+	profilesBox := builder.getObj("profiles").(gtki.ComboBoxText)
+	messageObj := builder.getObj("message").(gtki.Label)
+	renameButton := builder.getObj("btn-rename").(gtki.Button)
+	deleteButton := builder.getObj("btn-delete").(gtki.Button)
+
+	// The profile without a name comes first
+	var profiles []string
+	refresh := func(selected string) {
+		profiles = append([]string{""}, config.Profiles()...)
+		profilesBox.RemoveAll()
+		profilesBox.AppendText(i18n.Local("Default profile"))
+		active := 0
+		for i, p := range profiles[1:] {
+			profilesBox.AppendText(p)
+			if p == selected {
+				active = i + 1
+			}
+		}
+		profilesBox.SetActive(active)
+	}
+
+	selected := func() string {
+		if ix := profilesBox.GetActive(); ix > 0 && ix < len(profiles) {
+			return profiles[ix]
+		}
+		return ""
+	}
+
+	showError := func(err error) {
+		u.hasLog.log.WithError(err).Warn("Failed to change the profiles")
+		messageObj.SetLabel(err.Error())
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_profile_changed": func() {
+			named := selected() != ""
+			renameButton.SetSensitive(named)
+			deleteButton.SetSensitive(named)
+		},
+		"on_new": func() {
+			u.askForProfileName(dialog, "", func(name string) error {
+				if err := config.CreateProfile(name); err != nil {
+					return err
+				}
+				refresh(name)
+				return nil
+			})
+		},
+		"on_rename": func() {
+			old := selected()
+			u.askForProfileName(dialog, old, func(name string) error {
+				if err := config.RenameProfile(old, name); err != nil {
+					return err
+				}
+				refresh(name)
+				return nil
+			})
+		},
+		"on_delete": func() {
+			name := selected()
+			confirm := newBuilder("ConfirmProfileRemoval").getObj("RemoveProfile").(gtki.MessageDialog)
+			confirm.SetTransientFor(dialog)
+			_ = confirm.SetProperty("secondary-text", name)
+			response := confirm.Run()
+			confirm.Destroy()
+			if gtki.ResponseType(response) != gtki.RESPONSE_YES {
+				return
+			}
+			if err := config.DeleteProfile(name); err != nil {
+				showError(err)
+			}
+			refresh("")
+		},
+		"on_open": func() {
+			name := selected()
+			if name == "" {
+				dialog.Destroy()
+				go u.loadConfig(configFileToLoad())
+				return
+			}
+			if err := u.openProfileInNewProcess(name); err != nil {
+				showError(err)
+			}
+		},
+		"on_cancel": func() {
+			dialog.Destroy()
+			u.quit()
+		},
+	})
+
+	refresh("")
+	dialog.SetTransientFor(u.window)
+	dialog.ShowAll()
+}
+
+// askForProfileName asks for a name for a new profile, or a new name for an existing one.
+// The dialog stays open until the name is accepted or the user cancels.
+func (u *gtkUI) askForProfileName(parent gtki.Window, current string, accept func(string) error) {
+	builder := newBuilder("ProfileName")
+	dialog := builder.getObj("ProfileName").(gtki.Dialog)
+	entry := builder.getObj("name").(gtki.Entry)
+	messageObj := builder.getObj("message").(gtki.Label)
+
+	entry.SetText(current)
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_save": func() {
+			name, _ := entry.GetText()
+			if !config.ValidProfileName(name) {
+				messageObj.SetLabel(i18n.Local("Names can have letters, digits, dots, dashes and underscores."))
+				entry.GrabFocus()
+				return
+			}
+			if err := accept(name); err != nil {
+				messageObj.SetLabel(err.Error())
+				entry.GrabFocus()
+				return
+			}
+			dialog.Destroy()
+		},
+		"on_cancel": func() {
+			dialog.Destroy()
+		},
+	})
+
+	dialog.SetTransientFor(parent)
+	dialog.ShowAll()
+}
@@ -0,0 +1,15 @@
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type ProfilesSuite struct{}
+
+var _ = Suite(&ProfilesSuite{})
+
+func (s *ProfilesSuite) Test_applicationIDFor_givesEveryProfileItsOwnID(c *C) {
+	c.Assert(applicationIDFor(""), Equals, applicationID)
+	c.Assert(applicationIDFor("work"), Equals, "im.decoy.DecoyIM.profile_776f726b")
+	c.Assert(applicationIDFor("work.old"), Not(Equals), applicationIDFor("work_old"))
+}
@@ -10,6 +10,8 @@ var proxyTypes = [][]string{
 	{"tor-auto", "Automatic Tor"},
 	{"socks5", "SOCKS5"},
 	{"socks5+unix", "SOCKS5 Unix Domain"},
+	{"http", "HTTP"},
+	{"https", "HTTPS"},
 }
 
 func init() {
@@ -17,6 +19,8 @@ func init() {
 	_ = i18n.Local("Automatic Tor")
 	_ = i18n.Local("SOCKS5")
 	_ = i18n.Local("SOCKS5 Unix Domain")
+	_ = i18n.Local("HTTP")
+	_ = i18n.Local("HTTPS")
 }
 
 // findProxyTypeFor returns the index of the proxy type given
@@ -0,0 +1,36 @@
+package gui
+
+import (
+	ournet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/session/events"
+)
+
+func (u *gtkUI) startNetworkMonitor() {
+	u.accountManager.lock.Lock()
+	defer u.accountManager.lock.Unlock()
+
+	if u.networkMonitor != nil {
+		return
+	}
+
+	u.networkMonitor = ournet.NewNetworkMonitor(u.networkUp)
+	u.networkMonitor.Start()
+}
+
+func (u *gtkUI) networkUp() {
+	u.hasLog.log.Info("the network is available again, reconnecting accounts")
+	for _, a := range u.getAllAccounts() {
+		a.session.NetworkUp()
+	}
+}
+
+func (u *gtkUI) handleReconnectEvent(ev events.Reconnect, a *account) {
+	if ev.Stopped {
+		a.log.WithError(ev.LastError).Info("stopped trying to reconnect")
+		doInUIThread(a.removeReconnectingNotification)
+		return
+	}
+
+	a.log.WithField("attempt", ev.Attempt).WithField("next", ev.NextAttempt).Debug("waiting to reconnect")
+	u.notifyReconnecting(a, ev)
+}
@@ -0,0 +1,54 @@
+package gui
+
+import (
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/chadsec1/decoyim/sasl"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+func (u *gtkUI) mechanismVerifierFor(account *account) sasl.Verifier {
+	conf := account.session.GetConfig()
+	return &sasl.BasicVerifier{
+		StrongestSeen: func() (string, string) { return conf.SASLMechanism, conf.SASLChannelBinding },
+		SaveMechanism: func(mechanism, channelBinding string) {
+			conf.SaveSASLMechanism(mechanism, channelBinding)
+			u.SaveConfig()
+		},
+		AskDowngrade: func(previous, offered string) error {
+			if <-u.mechanismDowngradeShouldBeAccepted(account, previous, offered) {
+				return nil
+			}
+			account.session.SetWantToBeOnline(false)
+			return sasl.ErrMechanismDowngrade
+		},
+		OnDowngradeDeny: func() { account.session.SetWantToBeOnline(false) },
+		DowngradePolicy: conf.SASLDowngradePolicy,
+	}
+}
+
+func (u *gtkUI) mechanismDowngradeShouldBeAccepted(a *account, previous, offered string) <-chan bool {
+	c := make(chan bool)
+
+	doInUIThread(func() {
+		dialogID := "MechanismDowngradeDialog"
+		builder := newBuilder(dialogID)
+		dialogOb := builder.getObj(dialogID)
+
+		d := dialogOb.(gtki.MessageDialog)
+		d.SetDefaultResponse(gtki.RESPONSE_NO)
+		d.SetTransientFor(u.window)
+
+		message := i18n.Localf("The server for %s offered a weaker way to authenticate than before.", a.Account())
+		secondary := i18n.Localf("Previously the server supported %[1]s, but now the best it offers is %[2]s. This can happen if the server configuration changed, but it can also be the symptom of an attack that tries to get hold of your password.\n\nDo you want to authenticate anyway?", previous, offered)
+
+		_ = d.SetProperty("text", message)
+		_ = d.SetProperty("secondary_text", secondary)
+
+		responseType := gtki.ResponseType(d.Run())
+		d.Destroy()
+
+		c <- responseType == gtki.RESPONSE_YES
+	})
+
+	return c
+}
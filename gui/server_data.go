@@ -0,0 +1,181 @@
+package gui
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"strings"
+
+	"github.com/chadsec1/decoyim/i18n"
+	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/xmpp/data"
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+func (account *account) createExportServerDataItem(u *gtkUI) gtki.MenuItem {
+	item, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("Export _Server Data..."))
+	_ = item.Connect("activate", func() { u.exportServerData(account) })
+	item.SetSensitive(account.session.IsConnected())
+	account.observeConnectionEvents(u, func() {
+		item.SetSensitive(account.session.IsConnected())
+	})
+	return item
+}
+
+func (account *account) createImportServerDataItem(u *gtkUI) gtki.MenuItem {
+	item, _ := g.gtk.MenuItemNewWithMnemonic(i18n.Local("_Import Server Data..."))
+	_ = item.Connect("activate", func() { u.importServerData(account) })
+	item.SetSensitive(account.session.IsConnected())
+	account.observeConnectionEvents(u, func() {
+		item.SetSensitive(account.session.IsConnected())
+	})
+	return item
+}
+
+// suggestedServerDataName returns the file name offered when choosing where to save the server data
+func suggestedServerDataName(account string) string {
+	return strings.NewReplacer("@", "_at_", "/", "_").Replace(account) + "-server-data.xml"
+}
+
+func chooseServerDataFile(parent gtki.Window, action gtki.FileChooserAction, suggested string) (string, bool) {
+	title, button := i18n.Local("Choose where to save the server data"), i18n.Local("_Save")
+	if action == gtki.FILE_CHOOSER_ACTION_OPEN {
+		title, button = i18n.Local("Choose the server data to import"), i18n.Local("_Open")
+	}
+
+	dialog, _ := g.gtk.FileChooserDialogNewWith2Buttons(
+		title,
+		parent,
+		action,
+		i18n.Local("_Cancel"),
+		gtki.RESPONSE_CANCEL,
+		button,
+		gtki.RESPONSE_OK,
+	)
+	defer dialog.Destroy()
+
+	if action == gtki.FILE_CHOOSER_ACTION_SAVE {
+		dialog.SetCurrentName(suggested)
+		dialog.SetDoOverwriteConfirmation(true)
+	}
+
+	if gtki.ResponseType(dialog.Run()) != gtki.RESPONSE_OK {
+		return "", false
+	}
+	return dialog.GetFilename(), true
+}
+
+// exportServerData writes what the server keeps for the account to an XEP-0227 file,
+// so it can be imported into an account on another server
+func (u *gtkUI) exportServerData(a *account) {
+	path, ok := chooseServerDataFile(u.window, gtki.FILE_CHOOSER_ACTION_SAVE, suggestedServerDataName(a.Account()))
+	if !ok {
+		return
+	}
+
+	go func() {
+		sd, err := a.session.ExportServerData()
+		if err == nil {
+			err = writeServerData(sd, path)
+		}
+
+		if err != nil {
+			u.hasLog.log.WithError(err).WithField("file", path).Warn("couldn't export the server data")
+			u.notify(i18n.Local("Failure exporting server data"), i18n.Localf("The data on the server couldn't be written to %s.", path))
+			return
+		}
+
+		u.notify(i18n.Local("Server data exported"), i18n.Localf("The data on the server was written to %s.", path))
+	}()
+}
+
+func writeServerData(sd *data.ServerData, path string) error {
+	content, err := xml.MarshalIndent(sd, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append([]byte(xml.Header), content...), 0600)
+}
+
+func readServerData(path string) (*data.ServerDataUser, bool) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var sd data.ServerData
+	if err := xml.Unmarshal(content, &sd); err != nil {
+		return nil, false
+	}
+
+	return sd.OnlyUser()
+}
+
+// importServerData replays an XEP-0227 file into the account
+func (u *gtkUI) importServerData(a *account) {
+	path, ok := chooseServerDataFile(u.window, gtki.FILE_CHOOSER_ACTION_OPEN, "")
+	if !ok {
+		return
+	}
+
+	user, ok := readServerData(path)
+	if !ok {
+		u.notify(i18n.Local("Failure importing server data"), i18n.Localf("%s doesn't have the data of exactly one account.", path))
+		return
+	}
+
+	builder := newBuilder("ImportServerData")
+	dialog := builder.getObj("ImportServerData").(gtki.Dialog)
+	messageEntry := builder.getObj("subscriptionMessage").(gtki.Entry)
+	status := builder.getObj("status").(gtki.Label)
+	importButton := builder.getObj("import").(gtki.Button)
+
+	importing := false
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_cancel": dialog.Destroy,
+		"on_import": func() {
+			if importing {
+				return
+			}
+			importing = true
+			importButton.SetSensitive(false)
+			status.SetLabel(i18n.Local("Importing..."))
+
+			msg, _ := messageEntry.GetText()
+			go func() {
+				res, err := a.session.ImportServerData(user, msg)
+				doInUIThread(func() {
+					if err != nil {
+						importing = false
+						importButton.SetSensitive(true)
+						u.hasLog.log.WithError(err).Warn("couldn't import the server data")
+						status.SetLabel(err.Error())
+						return
+					}
+
+					dialog.Destroy()
+					u.notify(i18n.Local("Server data imported"), describeServerDataImport(res))
+				})
+			}()
+		},
+	})
+
+	dialog.SetTransientFor(u.window)
+	dialog.ShowAll()
+}
+
+func describeServerDataImport(res *sdata.ServerDataImport) string {
+	msg := i18n.Localf("%d contacts were added, and %d of them were asked to let you see their presence. %d bookmarks and other stored items were added.",
+		res.Contacts, res.SubscriptionsRequested, res.PrivateElements+res.PubSubItems)
+
+	if res.VCard {
+		msg += " " + i18n.Local("The vCard was replaced.")
+	}
+
+	if res.Failed > 0 {
+		msg += " " + i18n.Localf("The server refused %d of the changes.", res.Failed)
+	}
+
+	return msg
+}
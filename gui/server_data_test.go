@@ -0,0 +1,24 @@
+package gui
+
+import (
+	sdata "github.com/chadsec1/decoyim/session/data"
+
+	. "gopkg.in/check.v1"
+)
+
+type ServerDataSuite struct{}
+
+var _ = Suite(&ServerDataSuite{})
+
+func (s *ServerDataSuite) Test_suggestedServerDataName(c *C) {
+	c.Assert(suggestedServerDataName("someone@example.org"), Equals, "someone_at_example.org-server-data.xml")
+}
+
+func (s *ServerDataSuite) Test_describeServerDataImport_mentionsTheVCardAndFailuresOnlyWhenThereAreAny(c *C) {
+	c.Assert(describeServerDataImport(&sdata.ServerDataImport{Contacts: 2, SubscriptionsRequested: 1, PrivateElements: 1}), Equals,
+		"2 contacts were added, and 1 of them were asked to let you see their presence. 1 bookmarks and other stored items were added.")
+
+	c.Assert(describeServerDataImport(&sdata.ServerDataImport{VCard: true, Failed: 3}), Equals,
+		"0 contacts were added, and 0 of them were asked to let you see their presence. 0 bookmarks and other stored items were added. "+
+			"The vCard was replaced. The server refused 3 of the changes.")
+}
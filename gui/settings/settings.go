@@ -33,12 +33,23 @@ func getSchema() glibi.SettingsSchema {
 	return getSchemaSource().Lookup("im.coy.coyim.MainSettings", false)
 }
 
+// settingsPath is where the settings are kept. Named profiles keep theirs apart from each other.
+var settingsPath = "/im/coy/coyim/"
+
+// UseProfile makes the settings of the named profile be used. It should be called before using settings.
+func UseProfile(name string) {
+	settingsPath = "/im/coy/coyim/"
+	if name != "" {
+		settingsPath = fmt.Sprintf("/im/coy/coyim/profiles/%s/", name)
+	}
+}
+
 func getSettingsFor(s string) glibi.Settings {
-	return g.SettingsNewFull(getSchema(), nil, fmt.Sprintf("/im/coy/coyim/%s/", s))
+	return g.SettingsNewFull(getSchema(), nil, fmt.Sprintf("%s%s/", settingsPath, s))
 }
 
 func getDefaultSettings() glibi.Settings {
-	return g.SettingsNewFull(getSchema(), nil, "/im/coy/coyim/")
+	return g.SettingsNewFull(getSchema(), nil, settingsPath)
 }
 
 // Settings allow access to our configured settings
@@ -172,6 +172,37 @@ func (s *SettingsSuite) Test_getDefaultSettings(c *C) {
 	c.Assert(gv.settingsNewFullArg3, Equals, "/im/coy/coyim/")
 }
 
+func (s *SettingsSuite) Test_UseProfile_keepsTheSettingsOfTheProfileApart(c *C) {
+	orgG := g
+	defer func() {
+		g = orgG
+		UseProfile("")
+	}()
+
+	gv := &mockGlib{}
+	g = gv
+
+	defer func() {
+		cachedSchema = nil
+	}()
+
+	ss := &mockSettingsSchemaSource{}
+	cachedSchema = ss
+
+	UseProfile("work")
+
+	_ = getDefaultSettings()
+	c.Assert(gv.settingsNewFullArg3, Equals, "/im/coy/coyim/profiles/work/")
+
+	_ = getSettingsFor("bla")
+	c.Assert(gv.settingsNewFullArg3, Equals, "/im/coy/coyim/profiles/work/bla/")
+
+	UseProfile("")
+
+	_ = getDefaultSettings()
+	c.Assert(gv.settingsNewFullArg3, Equals, "/im/coy/coyim/")
+}
+
 func (s *SettingsSuite) Test_For_returnsOnlyDefaultSettings(c *C) {
 	orgG := g
 	defer func() {
@@ -2,7 +2,6 @@ package gui
 
 import (
 	"crypto/x509"
-	"errors"
 
 	"github.com/chadsec1/decoyim/config"
 	"github.com/chadsec1/decoyim/digests"
@@ -19,13 +18,13 @@ func (u *gtkUI) verifierFor(account *account) ourtls.Verifier {
 			if <-u.certificateFailedToVerify(account, certs) {
 				return nil
 			}
-			return errors.New("tls: failed to verify TLS certificate: " + err.Error())
+			return ourtls.RejectCertificate("failed to verify TLS certificate: " + err.Error())
 		},
 		VerifyHostnameFailure: func(certs []*x509.Certificate, origin string, err error) error {
 			if <-u.certificateFailedToVerifyHostname(account, certs, origin) {
 				return nil
 			}
-			return errors.New("tls: failed to match TLS certificate to name: " + err.Error())
+			return ourtls.RejectCertificate("failed to match TLS certificate to name: " + err.Error())
 		},
 		AddCert: func(cert *x509.Certificate) {
 			conf.SaveCert(cert.Subject.CommonName, cert.Issuer.CommonName, digests.Sha3_256(cert.Raw))
@@ -36,11 +35,12 @@ func (u *gtkUI) verifierFor(account *account) ourtls.Verifier {
 				return nil
 			}
 			account.session.SetWantToBeOnline(false)
-			return errors.New("tls: you manually denied the possibility of connecting using this certificate")
+			return ourtls.RejectCertificate("you manually denied the possibility of connecting using this certificate")
 		},
 		HasCertificates: func() bool { return len(conf.Certificates) > 0 },
 		NeedToCheckPins: conf.PinningPolicy != "none",
 		PinningPolicy:   conf.PinningPolicy,
+		DANEPolicy:      conf.DANEPolicy,
 	}
 }
 
@@ -53,20 +53,20 @@ func (u *gtkUI) unassociatedVerifier() ourtls.Verifier {
 			if <-u.certificateFailedToVerify(nil, certs) {
 				return nil
 			}
-			return errors.New("tls: failed to verify TLS certificate: " + err.Error())
+			return ourtls.RejectCertificate("failed to verify TLS certificate: " + err.Error())
 		},
 		VerifyHostnameFailure: func(certs []*x509.Certificate, origin string, err error) error {
 			if <-u.certificateFailedToVerifyHostname(nil, certs, origin) {
 				return nil
 			}
-			return errors.New("tls: failed to match TLS certificate to name: " + err.Error())
+			return ourtls.RejectCertificate("failed to match TLS certificate to name: " + err.Error())
 		},
 		AddCert: func(cert *x509.Certificate) {},
 		AskPinning: func(certs []*x509.Certificate) error {
 			if <-u.validCertificateShouldBePinned(nil, certs) {
 				return nil
 			}
-			return errors.New("tls: you manually denied the possibility of connecting using this certificate")
+			return ourtls.RejectCertificate("you manually denied the possibility of connecting using this certificate")
 		},
 		HasCertificates: func() bool { return false },
 		NeedToCheckPins: false,
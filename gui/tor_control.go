@@ -0,0 +1,132 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/chadsec1/decoyim/i18n"
+	ournet "github.com/chadsec1/decoyim/net"
+)
+
+// torBootstrapTimeout is how long we wait for Tor to finish bootstrapping before trying to connect anyway
+const torBootstrapTimeout = 3 * time.Minute
+
+// torFailuresBeforeNewIdentity is the number of failed connections over Tor after which we ask for new circuits
+const torFailuresBeforeNewIdentity = 3
+
+// openTorControlFor returns a client for the Tor control port if the account connects with automatic Tor.
+// Not every Tor exposes its control port, so failing to open it is not an error for the connection.
+func (u *gtkUI) openTorControlFor(account *account) *ournet.TorControl {
+	conf := account.session.GetConfig()
+	if !conf.HasTorAuto() {
+		return nil
+	}
+
+	ctl, err := ournet.OpenTorControl(conf.TorControlPassword)
+	if err != nil {
+		account.log.WithError(err).Debug("Couldn't use the Tor control port")
+		return nil
+	}
+
+	return ctl
+}
+
+func (u *gtkUI) waitForTorBootstrap(account *account) {
+	ctl := u.openTorControlFor(account)
+	if ctl == nil {
+		return
+	}
+	defer func() {
+		_ = ctl.Close()
+	}()
+
+	err := ctl.WaitForBootstrap(torBootstrapTimeout, func(st *ournet.TorBootstrapStatus) {
+		if !st.Done() {
+			u.notifyTorBootstrapping(account, st)
+		}
+	})
+
+	if err != nil {
+		account.log.WithError(err).Warn("Tor didn't finish bootstrapping")
+	}
+}
+
+func (u *gtkUI) connectionFailedOverTor(account *account) {
+	conf := account.session.GetConfig()
+	if !conf.HasTorAuto() {
+		return
+	}
+
+	if !account.countTorFailure() {
+		return
+	}
+
+	// Even without the control port, new credentials give us new circuits for this account
+	conf.RenewTorIsolation()
+
+	ctl := u.openTorControlFor(account)
+	if ctl == nil {
+		return
+	}
+	defer func() {
+		_ = ctl.Close()
+	}()
+
+	if err := ctl.NewIdentity(); err != nil {
+		account.log.WithError(err).Warn("Failed to request a new Tor identity")
+		return
+	}
+
+	account.log.Info("Requested a new Tor identity after repeated connection failures")
+}
+
+func (u *gtkUI) checkTorIsolation(account *account) {
+	ctl := u.openTorControlFor(account)
+	if ctl == nil {
+		return
+	}
+	defer func() {
+		_ = ctl.Close()
+	}()
+
+	circuits, err := ctl.CircuitsIsolatedFor(account.session.GetConfig().TorIsolationUsername())
+	if err != nil {
+		account.log.WithError(err).Warn("Failed to get the Tor circuits")
+		u.notifyTorIsolationUnconfirmed(account)
+		return
+	}
+
+	if len(circuits) == 0 {
+		account.log.Warn("Couldn't confirm that the connection uses a Tor circuit isolated for this account")
+		u.notifyTorIsolationUnconfirmed(account)
+		return
+	}
+
+	account.log.WithField("circuits", len(circuits)).Info("The connection uses Tor circuits isolated for this account")
+}
+
+func (u *gtkUI) torIsolationUnconfirmedMoreInfo() {
+	u.notify(i18n.Local("Tor isolation"), i18n.Local("We couldn't confirm with Tor that the connection of this account uses its own circuits. "+
+		"The account is still connected over Tor, but other traffic might share its circuits.\n\n"+
+		"This can happen when Tor is configured without IsolateSOCKSAuth for its SOCKS port."))
+}
+
+// countTorFailure counts a failed connection attempt over Tor, and returns true when there have been enough of
+// them to ask for new circuits. The count starts again then.
+func (account *account) countTorFailure() bool {
+	account.torFailuresLock.Lock()
+	defer account.torFailuresLock.Unlock()
+
+	account.torFailures++
+	if account.torFailures < torFailuresBeforeNewIdentity {
+		return false
+	}
+	account.torFailures = 0
+	return true
+}
+
+func (account *account) resetTorFailures() {
+	account.torFailuresLock.Lock()
+	defer account.torFailuresLock.Unlock()
+
+	account.torFailures = 0
+}
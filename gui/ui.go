@@ -135,12 +135,12 @@ func NewGTK(version string, sf sessions.Factory, df interfaces.DialerFactory, gx
 	if *config.MultiFlag {
 		flags = glibi.APPLICATION_NON_UNIQUE
 	}
-	ret.app, err = g.gtk.ApplicationNew(applicationID, flags)
+	ret.app, err = g.gtk.ApplicationNew(applicationIDFor(*config.ProfileFlag), flags)
 	if err != nil {
 		panic(err)
 	}
 
-	ret.keySupplier = config.CachingKeySupplier(ret.getMainPassword)
+	ret.keySupplier = config.PasswordKeySupplier(ret.getMainPassword)
 
 	ret.accountManager.init(ret, ret.hasLog.log)
 
@@ -290,12 +290,24 @@ func (u *gtkUI) loadConfig(configFile string) {
 		}
 	}
 
+	if err == config.ErrConfigurationFromNewerVersion {
+		// The configuration is never assigned, so nothing can be saved over the newer file
+		u.hasLog.log.WithError(err).Warn("refusing to open the configuration file")
+		doInUIThread(u.configFromNewerVersion)
+		return
+	}
+
 	// We assign config here, AFTER the return - so that a nil config means we are in a state of incorrectness and shouldn't do stuff.
 	// We never check, since a panic here is a serious programming error
 	u.setConfig(conf)
 
 	if err != nil {
 		u.hasLog.log.WithError(err).Warn("something went wrong")
+		if conf.HasBackups() {
+			// The configuration file is there, but couldn't be read, so a backup might still be good
+			doInUIThread(u.offerToRestoreConfigBackup)
+			return
+		}
 		doInUIThread(u.initialSetupWindow)
 		return
 	}
@@ -305,6 +317,21 @@ func (u *gtkUI) loadConfig(configFile string) {
 	}
 }
 
+// configFromNewerVersion tells the user why the configuration can't be opened, and quits
+func (u *gtkUI) configFromNewerVersion() {
+	builder := newBuilder("SimpleNotification")
+	dlg := builder.getObj("dialog").(gtki.MessageDialog)
+
+	_ = dlg.SetProperty("title", i18n.Local("Configuration from a newer version"))
+	_ = dlg.SetProperty("text", i18n.Local("The configuration was saved by a newer version of DecoyIM. "+
+		"It wasn't opened, since this version would lose the settings it doesn't know about. Please update DecoyIM to use it."))
+	dlg.SetTransientFor(u.window)
+
+	dlg.Run()
+	dlg.Destroy()
+	u.quit()
+}
+
 func (u *gtkUI) updateUnifiedOrNot() {
 	if u.settings.GetSingleWindow() && u.unified == nil {
 		u.unified = u.unifiedCached
@@ -315,6 +342,8 @@ func (u *gtkUI) updateUnifiedOrNot() {
 }
 
 func (u *gtkUI) configLoaded(c *config.ApplicationConfig) {
+	c.WhenChangedElsewhere(u.configChangedElsewhere)
+	ournet.Dialers.SetTorOnly(c.TorOnly)
 	u.settings = settings.For(c.GetUniqueID())
 	u.roster.restoreCollapseStatus()
 	u.deNotify.updateWith(u.settings)
@@ -342,6 +371,8 @@ func (u *gtkUI) configLoaded(c *config.ApplicationConfig) {
 		u.connectAllAutomatics(false)
 	}
 
+	u.startNetworkMonitor()
+
 	go u.listenToToggleConnectAllAutomatically()
 	go u.listenToSetShowAdvancedSettings()
 }
@@ -369,11 +400,41 @@ func (u *gtkUI) SaveConfig() {
 	go func() {
 		err := u.saveConfigInternal()
 		if err != nil {
-			u.hasLog.log.WithError(err).Warn("Failed to save config file")
+			u.configNotSaved(err)
 		}
 	}()
 }
 
+func (u *gtkUI) configNotSaved(err error) {
+	u.hasLog.log.WithError(err).Warn("Failed to save config file")
+
+	if err == config.ErrConfigurationChangedElsewhere {
+		u.notify(i18n.Local("Configuration not saved"), i18n.Local("Another instance of DecoyIM changed the configuration in a way that can't be merged with the changes made here, "+
+			"for example by changing the password. Restart DecoyIM to continue with the configuration as it is now."))
+	}
+}
+
+// configChangedElsewhere is called when changes another instance made to the configuration were merged in while saving
+func (u *gtkUI) configChangedElsewhere(ch *config.ConfigurationChanges) {
+	go func() {
+		u.addNewAccountsFromConfig(u.config(), u.sessionFactory, u.dialerFactory)
+		doInUIThread(func() {
+			if u.window != nil {
+				_, _ = u.window.Emit(accountChangedSignal.String())
+			}
+		})
+	}()
+
+	if ch.Conflicts == 0 {
+		u.notify(i18n.Local("Configuration changed"), i18n.Localf("Another instance of DecoyIM changed the configuration. "+
+			"Its %d changes were added to the ones made here.", ch.Merged))
+		return
+	}
+
+	u.notify(i18n.Local("Configuration changed"), i18n.Localf("Another instance of DecoyIM changed the configuration. "+
+		"%d of its changes were added to the ones made here, and %d were left out, since the same things were changed here.", ch.Merged, ch.Conflicts))
+}
+
 func (u *gtkUI) removeSaveReload(acc *config.Account) {
 	//TODO: the account configs should be managed by the account manager
 	u.accountManager.removeAccount(acc, func() {
@@ -386,7 +447,7 @@ func (u *gtkUI) saveConfigOnly() {
 	go func() {
 		err := u.saveConfigOnlyInternal()
 		if err != nil {
-			u.hasLog.log.WithError(err).Warn("Failed to save config file")
+			u.configNotSaved(err)
 		}
 	}()
 }
@@ -401,7 +462,7 @@ func (u *gtkUI) onActivate() {
 	u.mainWindow()
 
 	go u.watchCommands()
-	go u.loadConfig(*config.ConfigFile)
+	u.loadConfigOrChooseProfile()
 }
 
 func (u *gtkUI) Loop() {
@@ -414,6 +475,7 @@ func (u *gtkUI) connectUISignals() {
 		"on_close_window":                       u.quit,
 		"on_add_contact_window":                 u.addContactWindow,
 		"on_new_conversation":                   u.newCustomConversation,
+		"on_search_history":                     u.searchHistoryWindow,
 		"on_about_dialog":                       u.aboutDialog,
 		"on_feedback_dialog":                    u.feedbackDialog,
 		"on_toggled_check_Item_Merge":           u.toggleMergeAccounts,
@@ -421,6 +483,10 @@ func (u *gtkUI) connectUISignals() {
 		"on_toggled_check_Item_Show_Waiting":    u.toggleShowWaiting,
 		"on_toggled_check_Item_Sort_By_Status":  u.toggleSortByStatus,
 		"on_toggled_encrypt_configuration_file": u.toggleEncryptedConfig,
+		"on_change_main_password":               u.changeMainPassword,
+		"on_set_up_duress_password":             u.setUpDuressPassword,
+		"on_restore_config_backup":              u.restoreConfigBackup,
+		"on_toggled_tor_only":                   u.toggleTorOnly,
 		"on_preferences":                        u.showGlobalPreferences,
 		"on_muc_show_public_rooms":              u.mucShowPublicRooms,
 		"on_muc_show_join_room":                 u.mucShowJoinRoom,
@@ -459,6 +525,11 @@ func (u *gtkUI) mainWindow() {
 	u.optionsMenu = new(optionsMenu)
 	u.optionsMenu.encryptConfig = u.mainBuilder.getObj("EncryptConfigurationFileCheckMenuItem").(gtki.CheckMenuItem)
 	u.displaySettings.defaultSettingsOn(u.optionsMenu.encryptConfig)
+	u.optionsMenu.changeMainPassword = u.mainBuilder.getObj("ChangeMainPasswordMenuItem").(gtki.MenuItem)
+	u.optionsMenu.setUpDuress = u.mainBuilder.getObj("SetUpDuressPasswordMenuItem").(gtki.MenuItem)
+	u.optionsMenu.restoreBackup = u.mainBuilder.getObj("RestoreConfigBackupMenuItem").(gtki.MenuItem)
+	u.optionsMenu.torOnly = u.mainBuilder.getObj("TorOnlyCheckMenuItem").(gtki.CheckMenuItem)
+	u.displaySettings.defaultSettingsOn(u.optionsMenu.torOnly)
 
 	u.initMenuBar()
 	obj := u.mainBuilder.getObj("Vbox")
@@ -121,6 +121,17 @@ func main() {
 		return
 	}
 
+	if *config.ProfileFlag != "" && !config.ValidProfileName(*config.ProfileFlag) {
+		fmt.Fprintln(os.Stderr, config.ErrInvalidProfileName)
+		os.Exit(1)
+	}
+
+	// The configuration file would be used, but the settings and the application ID of the profile
+	if *config.ProfileFlag != "" && *config.ConfigFile != "" {
+		fmt.Fprintln(os.Stderr, config.ErrConfigFileWithProfile)
+		os.Exit(1)
+	}
+
 	sasl.Debug = *config.DebugFlag
 
 	startProfileIfNecessary()
@@ -157,6 +168,7 @@ func runClient() {
 
 	i18n.InitLocalization(gliba.Real)
 	settings.InitSettings(gliba.Real)
+	settings.UseProfile(*config.ProfileFlag)
 
 	createGTK(g).Loop()
 }
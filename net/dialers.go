@@ -0,0 +1,111 @@
+package net
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// ErrWouldBypassTor is returned when a connection is refused because it wouldn't go through Tor
+var ErrWouldBypassTor = errors.New("net: refusing to connect outside of Tor since only connections through Tor are allowed")
+
+const defaultDirectDialTimeout = 60 * time.Second
+
+// DialerRegistry is the only place where DecoyIM opens network connections directly. Every other outbound
+// connection has to go through a proxy dialer built on top of the dialers it returns.
+// When connections are restricted to Tor, only connections to the local machine - where the Tor SOCKS and
+// control ports are - can be made directly.
+type DialerRegistry struct {
+	torOnly int32
+}
+
+// Dialers is the registry used for all outbound connections
+var Dialers = &DialerRegistry{}
+
+// SetTorOnly sets whether all connections have to go through Tor
+func (r *DialerRegistry) SetTorOnly(v bool) {
+	var val int32
+	if v {
+		val = 1
+	}
+	atomic.StoreInt32(&r.torOnly, val)
+}
+
+// TorOnly returns true if all connections have to go through Tor
+func (r *DialerRegistry) TorOnly() bool {
+	return atomic.LoadInt32(&r.torOnly) == 1
+}
+
+// Direct returns a dialer that connects directly. If torOnly is true, or connections are restricted to Tor
+// globally, it will only connect to the local machine.
+func (r *DialerRegistry) Direct(torOnly bool) proxy.Dialer {
+	return &directDialer{registry: r, torOnly: torOnly, timeout: defaultDirectDialTimeout}
+}
+
+// DialTimeout connects directly to the given address, with the given timeout
+func (r *DialerRegistry) DialTimeout(network, addr string, t time.Duration) (net.Conn, error) {
+	return (&directDialer{registry: r, timeout: t}).Dial(network, addr)
+}
+
+// CheckLookup returns an error if a lookup with the system resolver is not allowed
+func (r *DialerRegistry) CheckLookup(torOnly bool) error {
+	if torOnly || r.TorOnly() {
+		return ErrWouldBypassTor
+	}
+	return nil
+}
+
+type directDialer struct {
+	registry *DialerRegistry
+	torOnly  bool
+	timeout  time.Duration
+}
+
+func (d *directDialer) Dial(network, addr string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, addr)
+}
+
+// DialContext implements proxy.ContextDialer, so that connection attempts can be cancelled
+func (d *directDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if (d.torOnly || d.registry.TorOnly()) && !IsLocalAddress(network, addr) {
+		return nil, ErrWouldBypassTor
+	}
+
+	return (&net.Dialer{Timeout: d.timeout}).DialContext(ctx, network, addr)
+}
+
+// IsDirect returns true if the given dialer connects directly, without going through a proxy
+func IsDirect(d proxy.Dialer) bool {
+	_, ok := d.(*directDialer)
+	return ok
+}
+
+// IsLocalAddress returns true if connecting to the given address can't leave the local machine.
+// Host names other than localhost are not local, since resolving them could leak.
+func IsLocalAddress(network, addr string) bool {
+	if strings.HasPrefix(network, "unix") {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+
+	return IsLocalHost(host)
+}
+
+// IsLocalHost returns true if the given host is the local machine
+func IsLocalHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,194 @@
+package net
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type DialersSuite struct{}
+
+var _ = Suite(&DialersSuite{})
+
+func (s *DialersSuite) Test_DialerRegistry_Direct_refusesRemoteAddressesWhenTorOnly(c *C) {
+	r := &DialerRegistry{}
+
+	_, err := r.Direct(true).Dial("tcp", "192.0.2.1:5222")
+	c.Check(err, Equals, ErrWouldBypassTor)
+
+	_, err = r.Direct(true).Dial("tcp", "jabber.example.org:5222")
+	c.Check(err, Equals, ErrWouldBypassTor)
+
+	r.SetTorOnly(true)
+	_, err = r.Direct(false).Dial("tcp", "192.0.2.1:5222")
+	c.Check(err, Equals, ErrWouldBypassTor)
+
+	_, err = r.DialTimeout("tcp", "192.0.2.1:9050", timeout)
+	c.Check(err, Equals, ErrWouldBypassTor)
+
+	c.Check(r.CheckLookup(false), Equals, ErrWouldBypassTor)
+
+	r.SetTorOnly(false)
+	c.Check(r.TorOnly(), Equals, false)
+	c.Check(r.CheckLookup(false), IsNil)
+	c.Check(r.CheckLookup(true), Equals, ErrWouldBypassTor)
+}
+
+func (s *DialersSuite) Test_DialerRegistry_Direct_allowsLocalConnectionsWhenTorOnly(c *C) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = ln.Close()
+	}()
+
+	r := &DialerRegistry{}
+	r.SetTorOnly(true)
+
+	conn, err := r.Direct(true).Dial("tcp", ln.Addr().String())
+	c.Assert(err, IsNil)
+	_ = conn.Close()
+}
+
+func (s *DialersSuite) Test_IsLocalAddress(c *C) {
+	c.Check(IsLocalAddress("tcp", "127.0.0.1:9050"), Equals, true)
+	c.Check(IsLocalAddress("tcp", "[::1]:9050"), Equals, true)
+	c.Check(IsLocalAddress("tcp", "localhost:9050"), Equals, true)
+	c.Check(IsLocalAddress("unix", "/var/run/tor/socks"), Equals, true)
+	c.Check(IsLocalAddress("tcp", "10.0.0.1:9050"), Equals, false)
+	c.Check(IsLocalAddress("tcp", "tor.example.org:9050"), Equals, false)
+	c.Check(IsLocalAddress("tcp", "127.0.0.1"), Equals, false)
+}
+
+// forbiddenNetworkAccess lists the functions and variables that reach the network without going through Dialers
+var forbiddenNetworkAccess = map[string][]string{
+	"net":                    {"Dial", "DialTimeout", "DialTCP", "DialUDP", "DialIP", "DialUnix", "Dialer", "LookupHost", "LookupIP", "LookupAddr", "LookupCNAME", "LookupSRV", "LookupMX", "LookupNS", "LookupTXT", "DefaultResolver"},
+	"crypto/tls":             {"Dial", "DialWithDialer"},
+	"net/http":               {"Get", "Head", "Post", "PostForm", "DefaultClient", "DefaultTransport"},
+	"golang.org/x/net/proxy": {"Direct", "FromEnvironment"},
+}
+
+// allowedNetworkAccess lists the files that are allowed to access the network directly, and what they can use
+var allowedNetworkAccess = map[string][]string{
+	"net/dialers.go":  {"net.Dialer"},
+	"net/resolver.go": {"net.LookupSRV", "net.LookupHost"},
+}
+
+func networkAccessIn(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	found := map[string]string{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || pkg.Obj != nil {
+			return true
+		}
+		path, ok := imports[pkg.Name]
+		if !ok {
+			return true
+		}
+		for _, f := range forbiddenNetworkAccess[path] {
+			if sel.Sel.Name == f {
+				found[path+"."+f] = pkg.Name + "." + f
+			}
+		}
+		return true
+	})
+
+	return found
+}
+
+func (s *DialersSuite) Test_noPackageAccessesTheNetworkDirectly(c *C) {
+	root, err := filepath.Abs("..")
+	c.Assert(err, IsNil)
+
+	fset := token.NewFileSet()
+	checked := 0
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if strings.HasPrefix(info.Name(), ".") || info.Name() == "vendor" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		if !importsAnyOf(file, forbiddenNetworkAccess) {
+			return nil
+		}
+
+		file, err = parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		checked++
+
+		rel, _ := filepath.Rel(root, path)
+		rel = filepath.ToSlash(rel)
+		for _, used := range networkAccessIn(file) {
+			if !isAllowedNetworkAccess(rel, used) {
+				c.Errorf("%s uses %s directly - use net.Dialers instead", rel, used)
+			}
+		}
+
+		return nil
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(checked > 0, Equals, true)
+}
+
+func importsAnyOf(file *ast.File, pkgs map[string][]string) bool {
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if _, ok := pkgs[path]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isAllowedNetworkAccess(file, used string) bool {
+	for _, a := range allowedNetworkAccess[file] {
+		if a == used {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *DialersSuite) Test_IsDirect(c *C) {
+	c.Check(IsDirect(Dialers.Direct(false)), Equals, true)
+	c.Check(IsDirect(Dialers.Direct(true)), Equals, true)
+	c.Check(IsDirect(nil), Equals, false)
+	c.Check(IsDirect(&mockDialer{}), Equals, false)
+}
@@ -17,6 +17,9 @@ const defaultDNSServer = "208.67.222.222:53"
 
 const defaultLookupTimeout = 10 * time.Second
 
+// ErrValidatorNotLocal is returned when the answers of a validating resolver that isn't on this computer would be trusted
+var ErrValidatorNotLocal = errors.New("dns: only a validating resolver on this computer can be trusted to authenticate answers")
+
 // LookupSRV mirrors net.LookupSRV but uses the provided proxy dialer in order to do the lookup instead.
 // By default it uses the OpenDNS server
 func LookupSRV(dialer proxy.Dialer, service, proto, name string) (cname string, addrs []*net.SRV, err error) {
@@ -65,6 +68,93 @@ func LookupSRVWith(dialer proxy.Dialer, dnsServer, service, proto, name string)
 	}, defaultLookupTimeout)
 }
 
+// LookupTLSA looks up the TLSA records for the given port, protocol and host using the proxy dialer and the default dns server.
+// The answer is never reported as secure, see LookupTLSAWith.
+func LookupTLSA(dialer proxy.Dialer, port int, proto, name string) (records []*dns.TLSA, secure bool, err error) {
+	return LookupTLSAWith(dialer, defaultDNSServer, port, proto, name)
+}
+
+// LookupTLSAWith looks up the TLSA records for the given port, protocol and host using the proxy dialer and dns server given.
+// The answer comes over plain DNS, so anyone on the way - like the exit of a Tor circuit - could have set the AD bit of it.
+// Because of that, the answer is never reported as secure, no matter what the dns server says.
+func LookupTLSAWith(dialer proxy.Dialer, dnsServer string, port int, proto, name string) (records []*dns.TLSA, secure bool, err error) {
+	records, _, err = timingOutTLSALookup(dialer, dnsServer, createTLSAName(port, proto, name))
+	return records, false, err
+}
+
+// LookupTLSAFromLocalValidator looks up the TLSA records for the given port, protocol and host with the validating resolver
+// on this computer at the given address. The resolver is connected to directly, so nobody can change the answer on the way,
+// and it is reported as secure if the resolver authenticated it using DNSSEC.
+func LookupTLSAFromLocalValidator(dnsServer string, port int, proto, name string) (records []*dns.TLSA, secure bool, err error) {
+	if !IsLocalAddress("tcp", dnsServer) {
+		return nil, false, ErrValidatorNotLocal
+	}
+
+	return timingOutTLSALookup(Dialers.Direct(false), dnsServer, createTLSAName(port, proto, name))
+}
+
+func timingOutTLSALookup(dialer proxy.Dialer, dnsServer, tlsaName string) (records []*dns.TLSA, secure bool, err error) {
+	result := make(chan bool, 1)
+
+	go func() {
+		records, secure, err = lookupTLSA(dialer, dnsServer, tlsaName)
+		result <- true
+	}()
+
+	select {
+	case <-time.After(defaultLookupTimeout):
+		log.Warn("dns: lookup timed out")
+		return nil, false, ErrTimeout
+	case <-result:
+		return
+	}
+}
+
+func lookupTLSA(dialer proxy.Dialer, dnsServer, tlsaName string) (records []*dns.TLSA, secure bool, err error) {
+	conn, err := dialer.Dial("tcp", dnsServer)
+	if err != nil {
+		return
+	}
+
+	dnsConn := &dns.Conn{Conn: conn}
+	defer func() {
+		_ = dnsConn.Close()
+	}()
+
+	r, err := exchange(dnsConn, msgTLSA(tlsaName))
+	if err != nil {
+		return
+	}
+
+	return convertAnswersToTLSA(r.Answer), r.AuthenticatedData, nil
+}
+
+// createTLSAName returns the DNS name used for the TLSA records of the given port, protocol and host, as described in RFC 6698, section 3
+func createTLSAName(port int, proto, name string) string {
+	return "_" + strconv.Itoa(port) + "._" + proto + "." + dns.Fqdn(name)
+}
+
+func msgTLSA(name string) *dns.Msg {
+	m := &dns.Msg{}
+	m.SetQuestion(name, dns.TypeTLSA)
+	m.RecursionDesired = true
+	// RFC 6840, section 5.7: setting the AD bit asks the server to tell us whether the answer was validated
+	m.AuthenticatedData = true
+	m.SetEdns0(4096, true)
+	return m
+}
+
+func convertAnswersToTLSA(in []dns.RR) []*dns.TLSA {
+	result := make([]*dns.TLSA, 0, len(in))
+	for _, a := range in {
+		if tlsa, ok := a.(*dns.TLSA); ok {
+			result = append(result, tlsa)
+		}
+	}
+
+	return result
+}
+
 func createCName(service, proto, name string) string {
 	return "_" + service + "._" + proto + "." + name + "."
 }
@@ -328,3 +328,24 @@ func (s *DNSXmppSuite) Test_LookupSRV_failsIfResultIsNotSuccess(c *C) {
 	_, _, e := LookupSRV(dialer, "xmpp-client", "tcp", "reap.ec")
 	c.Assert(e, ErrorMatches, "got return: 5")
 }
+
+func (s *DNSXmppSuite) Test_createTLSAName_createsTheNameForAPortAndHost(c *C) {
+	c.Assert(createTLSAName(5222, "tcp", "xmpp.example.org"), Equals, "_5222._tcp.xmpp.example.org.")
+	c.Assert(createTLSAName(5223, "tcp", "xmpp.example.org."), Equals, "_5223._tcp.xmpp.example.org.")
+}
+
+func (s *DNSXmppSuite) Test_msgTLSA_createsMessageAskingForDNSSEC(c *C) {
+	res := msgTLSA("_5222._tcp.foo.com.")
+	c.Assert(res.Question[0].Name, Equals, "_5222._tcp.foo.com.")
+	c.Assert(res.Question[0].Qtype, Equals, dns.TypeTLSA)
+	c.Assert(res.AuthenticatedData, Equals, true)
+	c.Assert(res.IsEdns0().Do(), Equals, true)
+}
+
+func (s *DNSXmppSuite) Test_convertAnswersToTLSA_onlyKeepsTLSARecords(c *C) {
+	tlsa := &dns.TLSA{Usage: 3, Selector: 1, MatchingType: 1, Certificate: "abcd"}
+
+	res := convertAnswersToTLSA([]dns.RR{new(dns.CNAME), tlsa})
+	c.Assert(res, HasLen, 1)
+	c.Assert(res[0], Equals, tlsa)
+}
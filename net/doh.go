@@ -0,0 +1,99 @@
+package net
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+	"golang.org/x/net/proxy"
+)
+
+const dnsMessageContentType = "application/dns-message"
+
+// DoHResolver makes DNS queries over HTTPS to the given endpoint, as described in RFC 8484, through the given proxy dialer
+type DoHResolver struct {
+	Dialer proxy.Dialer
+	// URL is the URL of the DNS-over-HTTPS endpoint, for example https://dns.example.org/dns-query
+	URL string
+}
+
+// LookupSRV implements Resolver
+func (r *DoHResolver) LookupSRV(service, proto, name string) (string, []*net.SRV, error) {
+	return timingOutExchanger(r.exchange, defaultLookupTimeout).lookupSRV(service, proto, name)
+}
+
+// LookupHost implements Resolver
+func (r *DoHResolver) LookupHost(host string) ([]string, error) {
+	return timingOutExchanger(r.exchange, defaultLookupTimeout).lookupHost(host)
+}
+
+// LookupTLSA implements Resolver. The answer is reported as secure if the endpoint set the AD bit, and it came over HTTPS,
+// so nobody on the way could have set it.
+func (r *DoHResolver) LookupTLSA(port int, proto, name string) ([]*dns.TLSA, bool, error) {
+	records, secure, err := timingOutExchanger(r.exchange, defaultLookupTimeout).lookupTLSA(port, proto, name)
+	return records, secure && r.overHTTPS(), err
+}
+
+func (r *DoHResolver) overHTTPS() bool {
+	u, err := url.Parse(r.URL)
+	return err == nil && strings.EqualFold(u.Scheme, "https")
+}
+
+func (r *DoHResolver) client() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Dial: dialerOrDirect(r.Dialer).Dial,
+		},
+		Timeout: defaultLookupTimeout,
+	}
+}
+
+func (r *DoHResolver) exchange(m *dns.Msg) (*dns.Msg, error) {
+	// RFC 8484, section 4.1: the DNS ID should be zero to make the answers more cacheable
+	m.Id = 0
+	packed, err := m.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", r.URL, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dnsMessageContentType)
+	req.Header.Set("Accept", dnsMessageContentType)
+
+	resp, err := r.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("dns: DNS-over-HTTPS server returned status " + strconv.Itoa(resp.StatusCode))
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dns.Msg{}
+	if err = res.Unpack(body); err != nil {
+		return nil, err
+	}
+
+	if res.Rcode != dns.RcodeSuccess {
+		return nil, errors.New("got return: " + strconv.Itoa(res.Rcode))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package net
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/miekg/dns"
+	. "gopkg.in/check.v1"
+)
+
+type DoHSuite struct{}
+
+var _ = Suite(&DoHSuite{})
+
+func fakeDoHServer(answer func(*dns.Msg) *dns.Msg) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" || req.Header.Get("Content-Type") != dnsMessageContentType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		m := &dns.Msg{}
+		if m.Unpack(body) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		packed, _ := answer(m).Pack()
+		w.Header().Set("Content-Type", dnsMessageContentType)
+		_, _ = w.Write(packed)
+	}))
+}
+
+func (s *DoHSuite) Test_DoHResolver_LookupSRV_asksTheEndpoint(c *C) {
+	ts := fakeDoHServer(answerWith("_xmpp-client._tcp.example.org. 300 IN SRV 5 0 5222 xmpp.example.org."))
+	defer ts.Close()
+
+	r := &DoHResolver{URL: ts.URL}
+	_, addrs, e := r.LookupSRV("xmpp-client", "tcp", "example.org")
+	c.Assert(e, IsNil)
+	c.Assert(addrs, HasLen, 1)
+	c.Assert(addrs[0].Target, Equals, "xmpp.example.org.")
+}
+
+func (s *DoHSuite) Test_DoHResolver_LookupHost_asksTheEndpoint(c *C) {
+	ts := fakeDoHServer(answerWith("example.org. 300 IN A 192.0.2.1", "example.org. 300 IN AAAA 2001:db8::1"))
+	defer ts.Close()
+
+	r := &DoHResolver{URL: ts.URL}
+	addrs, e := r.LookupHost("example.org")
+	c.Assert(e, IsNil)
+	c.Assert(addrs, DeepEquals, []string{"192.0.2.1", "2001:db8::1"})
+}
+
+func (s *DoHSuite) Test_DoHResolver_LookupTLSA_asksTheEndpoint(c *C) {
+	ts := fakeDoHServer(func(m *dns.Msg) *dns.Msg {
+		r := answerWith("_5222._tcp.xmpp.example.org. 300 IN TLSA 3 1 1 abcd")(m)
+		r.AuthenticatedData = true
+		return r
+	})
+	defer ts.Close()
+
+	r := &DoHResolver{URL: ts.URL}
+	records, secure, e := r.LookupTLSA(5222, "tcp", "xmpp.example.org")
+	c.Assert(e, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].Certificate, Equals, "abcd")
+	// The fake endpoint is reached over plain HTTP, so the AD bit could have been set by anyone on the way
+	c.Assert(secure, Equals, false)
+}
+
+func (s *DoHSuite) Test_DoHResolver_overHTTPS(c *C) {
+	c.Assert((&DoHResolver{URL: "https://dns.example.org/dns-query"}).overHTTPS(), Equals, true)
+	c.Assert((&DoHResolver{URL: "HTTPS://dns.example.org/dns-query"}).overHTTPS(), Equals, true)
+	c.Assert((&DoHResolver{URL: "http://dns.example.org/dns-query"}).overHTTPS(), Equals, false)
+	c.Assert((&DoHResolver{URL: "::not a url"}).overHTTPS(), Equals, false)
+}
+
+func (s *DoHSuite) Test_DoHResolver_failsOnBadStatus(c *C) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := &DoHResolver{URL: ts.URL}
+	_, _, e := r.LookupSRV("xmpp-client", "tcp", "example.org")
+	c.Assert(e, ErrorMatches, "dns: DNS-over-HTTPS server returned status 404")
+}
+
+func (s *DoHSuite) Test_DoHResolver_failsOnUnsuccessfulAnswer(c *C) {
+	ts := fakeDoHServer(func(m *dns.Msg) *dns.Msg {
+		r := &dns.Msg{}
+		r.SetRcode(m, dns.RcodeNameError)
+		return r
+	})
+	defer ts.Close()
+
+	r := &DoHResolver{URL: ts.URL}
+	_, e := r.LookupHost("example.org")
+	c.Assert(e, ErrorMatches, "got return: 3")
+}
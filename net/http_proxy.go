@@ -0,0 +1,130 @@
+package net
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/proxy"
+)
+
+// HTTPConnect returns a dialer that makes connections through the HTTP or HTTPS proxy in the given URL,
+// using the CONNECT method and basic authentication if the URL has a user. It can be registered with
+// proxy.RegisterDialerType for the http and https schemes.
+func HTTPConnect(u *url.URL, forward proxy.Dialer) (proxy.Dialer, error) {
+	h := &httpConnect{
+		forward: dialerOrDirect(forward),
+	}
+
+	switch u.Scheme {
+	case "http":
+		h.addr = hostWithDefaultPort(u, "80")
+	case "https":
+		h.addr = hostWithDefaultPort(u, "443")
+		h.tlsConfig = &tls.Config{ServerName: u.Hostname()}
+	default:
+		return nil, errors.New("proxy: unknown HTTP proxy scheme " + u.Scheme)
+	}
+
+	if u.User != nil {
+		h.auth = &proxy.Auth{User: u.User.Username()}
+		h.auth.Password, _ = u.User.Password()
+	}
+
+	return h, nil
+}
+
+func hostWithDefaultPort(u *url.URL, port string) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+type httpConnect struct {
+	addr    string
+	auth    *proxy.Auth
+	forward proxy.Dialer
+
+	// tlsConfig is set when we should talk TLS to the proxy itself
+	tlsConfig *tls.Config
+}
+
+func (h *httpConnect) Dial(network, addr string) (net.Conn, error) {
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		return nil, errors.New("proxy: no support for HTTP proxy connections of type " + network)
+	}
+
+	conn, err := h.forward.Dial("tcp", h.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.tlsConfig != nil {
+		tlsConn := tls.Client(conn, h.tlsConfig)
+		if err = tlsConn.Handshake(); err != nil {
+			_ = conn.Close()
+			return nil, errors.New("proxy: failed to start TLS with HTTPS proxy at " + h.addr + ": " + err.Error())
+		}
+		conn = tlsConn
+	}
+
+	c, err := h.connect(conn, addr)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// connect asks the proxy on the given connection to extend it to the target address
+func (h *httpConnect) connect(conn net.Conn, target string) (net.Conn, error) {
+	req := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: target},
+		Host:   target,
+		Header: make(http.Header),
+	}
+
+	if h.auth != nil {
+		credentials := base64.StdEncoding.EncodeToString([]byte(h.auth.User + ":" + h.auth.Password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		return nil, errors.New("proxy: failed to write CONNECT request to HTTP proxy at " + h.addr + ": " + err.Error())
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, errors.New("proxy: failed to read CONNECT response from HTTP proxy at " + h.addr + ": " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("proxy: HTTP proxy at " + h.addr + " failed to connect: " + resp.Status)
+	}
+
+	// The proxy might have sent data from the server together with its response
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+
+	return conn, nil
+}
+
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
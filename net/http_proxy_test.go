@@ -0,0 +1,174 @@
+package net
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "gopkg.in/check.v1"
+)
+
+type HTTPProxySuite struct{}
+
+var _ = Suite(&HTTPProxySuite{})
+
+// connectStandIn is a minimal CONNECT proxy that, instead of connecting anywhere, greets the client with the target address
+type connectStandIn struct {
+	requests  []*http.Request
+	sendEarly bool
+}
+
+func (p *connectStandIn) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	p.requests = append(p.requests, req)
+
+	if req.Method != "CONNECT" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.Header.Get("Proxy-Authorization") == "" && req.Host == "secret.example.org:5222" {
+		w.WriteHeader(http.StatusProxyAuthRequired)
+		return
+	}
+
+	hj, _ := w.(http.Hijacker)
+	conn, buf, _ := hj.Hijack()
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	greeting := "hello " + req.Host + "\n"
+	if p.sendEarly {
+		_, _ = buf.WriteString("HTTP/1.1 200 Connection established\r\n\r\n" + greeting)
+		_ = buf.Flush()
+	} else {
+		_, _ = buf.WriteString("HTTP/1.1 200 Connection established\r\n\r\n")
+		_ = buf.Flush()
+		_, _ = conn.Write([]byte(greeting))
+	}
+
+	_, _ = io.ReadFull(buf, make([]byte, 1))
+}
+
+func readGreeting(c *C, conn net.Conn) string {
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	c.Assert(err, IsNil)
+	return line
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_connectsThroughAnHTTPProxy(c *C) {
+	standIn := &connectStandIn{}
+	ts := httptest.NewServer(standIn)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	d, err := HTTPConnect(u, nil)
+	c.Assert(err, IsNil)
+
+	conn, err := d.Dial("tcp", "xmpp.example.org:5222")
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	c.Assert(readGreeting(c, conn), Equals, "hello xmpp.example.org:5222\n")
+	c.Assert(standIn.requests[0].Header.Get("Proxy-Authorization"), Equals, "")
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_keepsDataSentTogetherWithTheResponse(c *C) {
+	ts := httptest.NewServer(&connectStandIn{sendEarly: true})
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	d, _ := HTTPConnect(u, nil)
+
+	conn, err := d.Dial("tcp", "xmpp.example.org:5222")
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	c.Assert(readGreeting(c, conn), Equals, "hello xmpp.example.org:5222\n")
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_sendsBasicAuthentication(c *C) {
+	standIn := &connectStandIn{}
+	ts := httptest.NewServer(standIn)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.User = url.UserPassword("someone", "p@ss:word")
+	d, _ := HTTPConnect(u, nil)
+
+	conn, err := d.Dial("tcp", "secret.example.org:5222")
+	c.Assert(err, IsNil)
+	_ = conn.Close()
+
+	user, pass, ok := (&http.Request{Header: http.Header{"Authorization": standIn.requests[0].Header["Proxy-Authorization"]}}).BasicAuth()
+	c.Assert(ok, Equals, true)
+	c.Assert(user, Equals, "someone")
+	c.Assert(pass, Equals, "p@ss:word")
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_failsWhenTheProxyRefuses(c *C) {
+	ts := httptest.NewServer(&connectStandIn{})
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	d, _ := HTTPConnect(u, nil)
+
+	_, err := d.Dial("tcp", "secret.example.org:5222")
+	c.Assert(err, ErrorMatches, "proxy: HTTP proxy at .* failed to connect: 407 Proxy Authentication Required")
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_connectsThroughAnHTTPSProxy(c *C) {
+	ts := httptest.NewTLSServer(&connectStandIn{})
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	d, err := HTTPConnect(u, nil)
+	c.Assert(err, IsNil)
+
+	_, err = d.Dial("tcp", "xmpp.example.org:5222")
+	c.Assert(err, ErrorMatches, "proxy: failed to start TLS with HTTPS proxy at .*")
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ts.Certificate())
+	d.(*httpConnect).tlsConfig = &tls.Config{RootCAs: roots, ServerName: "example.com"}
+
+	conn, err := d.Dial("tcp", "xmpp.example.org:5222")
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	c.Assert(readGreeting(c, conn), Equals, "hello xmpp.example.org:5222\n")
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_usesTheDefaultPorts(c *C) {
+	u, _ := url.Parse("http://proxy.example.org")
+	d, _ := HTTPConnect(u, nil)
+	c.Assert(d.(*httpConnect).addr, Equals, "proxy.example.org:80")
+
+	u, _ = url.Parse("https://proxy.example.org")
+	d, _ = HTTPConnect(u, nil)
+	c.Assert(d.(*httpConnect).addr, Equals, "proxy.example.org:443")
+	c.Assert(d.(*httpConnect).tlsConfig.ServerName, Equals, "proxy.example.org")
+
+	u, _ = url.Parse("ftp://proxy.example.org")
+	_, err := HTTPConnect(u, nil)
+	c.Assert(err, ErrorMatches, "proxy: unknown HTTP proxy scheme ftp")
+}
+
+func (s *HTTPProxySuite) Test_HTTPConnect_onlySupportsTCP(c *C) {
+	u, _ := url.Parse("http://proxy.example.org")
+	d, _ := HTTPConnect(u, nil)
+
+	_, err := d.Dial("udp", "xmpp.example.org:5222")
+	c.Assert(err, ErrorMatches, "proxy: no support for HTTP proxy connections of type udp")
+}
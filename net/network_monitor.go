@@ -0,0 +1,75 @@
+package net
+
+import (
+	"net"
+	"time"
+)
+
+const defaultNetworkPollInterval = 5 * time.Second
+
+// interfaceAddrs is the source of the local addresses, it can be replaced in tests
+var interfaceAddrs = net.InterfaceAddrs
+
+// NetworkMonitor notices when the machine gets a network connection, by looking at the
+// addresses of the network interfaces. It never connects anywhere.
+type NetworkMonitor struct {
+	interval time.Duration
+	onUp     func()
+	stop     chan bool
+}
+
+// NewNetworkMonitor returns a monitor that will call onUp every time the network becomes available
+func NewNetworkMonitor(onUp func()) *NetworkMonitor {
+	return &NetworkMonitor{
+		interval: defaultNetworkPollInterval,
+		onUp:     onUp,
+		stop:     make(chan bool),
+	}
+}
+
+// Start starts monitoring in the background
+func (m *NetworkMonitor) Start() {
+	go m.run()
+}
+
+// Stop stops the monitoring
+func (m *NetworkMonitor) Stop() {
+	close(m.stop)
+}
+
+func (m *NetworkMonitor) run() {
+	wasUp := HasNetwork()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-time.After(m.interval):
+		}
+
+		up := HasNetwork()
+		if up && !wasUp {
+			m.onUp()
+		}
+		wasUp = up
+	}
+}
+
+// HasNetwork returns true if any network interface has an address that is not a loopback or link local address
+func HasNetwork() bool {
+	addrs, err := interfaceAddrs()
+	if err != nil {
+		return false
+	}
+
+	for _, a := range addrs {
+		ipn, ok := a.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if !ipn.IP.IsLoopback() && !ipn.IP.IsLinkLocalUnicast() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package net
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type NetworkMonitorSuite struct{}
+
+var _ = Suite(&NetworkMonitorSuite{})
+
+func fakeInterfaceAddrs(addrs ...string) func() ([]net.Addr, error) {
+	return func() ([]net.Addr, error) {
+		result := []net.Addr{}
+		for _, a := range addrs {
+			_, ipn, _ := net.ParseCIDR(a)
+			result = append(result, ipn)
+		}
+		return result, nil
+	}
+}
+
+func (s *NetworkMonitorSuite) Test_HasNetwork_ignoresLoopbackAndLinkLocalAddresses(c *C) {
+	orgInterfaceAddrs := interfaceAddrs
+	defer func() {
+		interfaceAddrs = orgInterfaceAddrs
+	}()
+
+	interfaceAddrs = fakeInterfaceAddrs("127.0.0.1/8", "::1/128", "fe80::1/64")
+	c.Assert(HasNetwork(), Equals, false)
+
+	interfaceAddrs = fakeInterfaceAddrs("127.0.0.1/8", "192.168.1.0/24")
+	c.Assert(HasNetwork(), Equals, true)
+
+	interfaceAddrs = func() ([]net.Addr, error) {
+		return nil, errors.New("no interfaces")
+	}
+	c.Assert(HasNetwork(), Equals, false)
+}
+
+func (s *NetworkMonitorSuite) Test_NetworkMonitor_callsBackWhenTheNetworkComesUp(c *C) {
+	orgInterfaceAddrs := interfaceAddrs
+	defer func() {
+		interfaceAddrs = orgInterfaceAddrs
+	}()
+
+	addrs := make(chan func() ([]net.Addr, error), 1)
+	addrs <- fakeInterfaceAddrs("127.0.0.1/8")
+	current := fakeInterfaceAddrs("127.0.0.1/8")
+	interfaceAddrs = func() ([]net.Addr, error) {
+		select {
+		case f := <-addrs:
+			current = f
+		default:
+		}
+		return current()
+	}
+
+	up := make(chan bool, 1)
+	m := NewNetworkMonitor(func() {
+		up <- true
+	})
+	m.interval = time.Millisecond
+	m.Start()
+	defer m.Stop()
+
+	addrs <- fakeInterfaceAddrs("127.0.0.1/8", "10.1.2.0/24")
+
+	select {
+	case <-up:
+	case <-time.After(5 * time.Second):
+		c.Fatal("the network monitor never noticed the network coming up")
+	}
+}
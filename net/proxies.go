@@ -22,6 +22,8 @@ var proxyTypes = [][]string{
 	{"tor-auto", "Automatic Tor"},
 	{"socks5", "SOCKS5"},
 	{"socks5+unix", "SOCKS5 over Unix Domain Socket"},
+	{"http", "HTTP"},
+	{"https", "HTTPS"},
 }
 
 // FindProxyTypeFor returns the index of the proxy type given
@@ -94,14 +96,15 @@ func orEmpty(s *string) string {
 // ForProcessing represents a string valid for computer processing
 func (p Proxy) ForProcessing() string {
 	us := ""
-	ps := ""
 	compose := ""
 	if p.User != nil {
-		us = *p.User
-		compose = "@"
+		// The user information is escaped, since passwords for HTTP proxies in particular might contain any character
 		if p.Pass != nil {
-			ps = ":" + *p.Pass
+			us = url.UserPassword(*p.User, *p.Pass).String()
+		} else {
+			us = url.User(*p.User).String()
 		}
+		compose = "@"
 	}
 
 	pr := ""
@@ -109,7 +112,7 @@ func (p Proxy) ForProcessing() string {
 		pr = ":" + *p.Port
 	}
 
-	return fmt.Sprintf("%s://%s%s%s%s%s%s", p.Scheme, us, ps, compose, orEmpty(p.Host), pr, orEmpty(p.Path))
+	return fmt.Sprintf("%s://%s%s%s%s%s", p.Scheme, us, compose, orEmpty(p.Host), pr, orEmpty(p.Path))
 }
 
 // ForPresentation represents a string valid for user presentation - blanking out the password
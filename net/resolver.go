@@ -0,0 +1,191 @@
+package net
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+	"golang.org/x/net/proxy"
+)
+
+// ErrSRVNotSupported is returned by resolvers that can't look up SRV records
+var ErrSRVNotSupported = errors.New("dns: this resolver can't look up SRV records")
+
+// ErrTLSANotSupported is returned by resolvers that can't look up TLSA records
+var ErrTLSANotSupported = errors.New("dns: this resolver can't look up TLSA records")
+
+// ErrNoAddresses is returned when a host lookup gives no addresses back
+var ErrNoAddresses = errors.New("dns: no addresses found")
+
+// Resolver represents a way of doing the DNS lookups needed to connect to a server
+type Resolver interface {
+	LookupSRV(service, proto, name string) (cname string, addrs []*net.SRV, err error)
+	LookupHost(host string) (addrs []string, err error)
+	// LookupTLSA also returns whether the answer was authenticated using DNSSEC
+	LookupTLSA(port int, proto, name string) (records []*dns.TLSA, secure bool, err error)
+}
+
+// SystemResolver uses the resolver of the operating system. The queries it makes will not go through any proxy,
+// so it refuses to make them when only connections through Tor are allowed.
+type SystemResolver struct{}
+
+// LookupSRV implements Resolver
+func (*SystemResolver) LookupSRV(service, proto, name string) (string, []*net.SRV, error) {
+	if err := Dialers.CheckLookup(false); err != nil {
+		return "", nil, err
+	}
+	return net.LookupSRV(service, proto, name)
+}
+
+// LookupHost implements Resolver
+func (*SystemResolver) LookupHost(host string) ([]string, error) {
+	if err := Dialers.CheckLookup(false); err != nil {
+		return nil, err
+	}
+	return net.LookupHost(host)
+}
+
+// LookupTLSA implements Resolver. The resolver of the operating system can't be asked for TLSA records.
+func (*SystemResolver) LookupTLSA(port int, proto, name string) ([]*dns.TLSA, bool, error) {
+	return nil, false, ErrTLSANotSupported
+}
+
+// TCPResolver makes DNS queries over TCP to the given server, through the given proxy dialer
+type TCPResolver struct {
+	Dialer proxy.Dialer
+	// Server is the address of the DNS server. The default server will be used if it's empty.
+	Server string
+}
+
+func dialerOrDirect(d proxy.Dialer) proxy.Dialer {
+	if d == nil {
+		return Dialers.Direct(false)
+	}
+	return d
+}
+
+func (r *TCPResolver) server() string {
+	if r.Server == "" {
+		return defaultDNSServer
+	}
+	return r.Server
+}
+
+// LookupSRV implements Resolver
+func (r *TCPResolver) LookupSRV(service, proto, name string) (string, []*net.SRV, error) {
+	return LookupSRVWith(dialerOrDirect(r.Dialer), r.server(), service, proto, name)
+}
+
+// LookupHost implements Resolver
+func (r *TCPResolver) LookupHost(host string) ([]string, error) {
+	return timingOutExchanger(r.exchange, defaultLookupTimeout).lookupHost(host)
+}
+
+// LookupTLSA implements Resolver. The answer comes over plain DNS, so it is never reported as secure.
+func (r *TCPResolver) LookupTLSA(port int, proto, name string) ([]*dns.TLSA, bool, error) {
+	return LookupTLSAWith(dialerOrDirect(r.Dialer), r.server(), port, proto, name)
+}
+
+func (r *TCPResolver) exchange(m *dns.Msg) (*dns.Msg, error) {
+	conn, err := dialerOrDirect(r.Dialer).Dial("tcp", r.server())
+	if err != nil {
+		return nil, err
+	}
+
+	dnsConn := &dns.Conn{Conn: conn}
+	defer func() {
+		_ = dnsConn.Close()
+	}()
+
+	return exchange(dnsConn, m)
+}
+
+// msgExchanger sends a DNS query and returns the answer
+type msgExchanger func(*dns.Msg) (*dns.Msg, error)
+
+func timingOutExchanger(f msgExchanger, t time.Duration) msgExchanger {
+	return func(m *dns.Msg) (r *dns.Msg, err error) {
+		result := make(chan bool, 1)
+
+		go func() {
+			r, err = f(m)
+			result <- true
+		}()
+
+		select {
+		case <-time.After(t):
+			return nil, ErrTimeout
+		case <-result:
+			return
+		}
+	}
+}
+
+func (f msgExchanger) lookupSRV(service, proto, name string) (cname string, addrs []*net.SRV, err error) {
+	cname = createCName(service, proto, name)
+
+	r, err := f(msgSRV(cname))
+	if err != nil {
+		return
+	}
+
+	addrs = convertAnswersToSRV(r.Answer)
+	return
+}
+
+func (f msgExchanger) lookupTLSA(port int, proto, name string) ([]*dns.TLSA, bool, error) {
+	r, err := f(msgTLSA(createTLSAName(port, proto, name)))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return convertAnswersToTLSA(r.Answer), r.AuthenticatedData, nil
+}
+
+func msgHost(host string, qtype uint16) *dns.Msg {
+	m := &dns.Msg{}
+	m.SetQuestion(dns.Fqdn(host), qtype)
+	m.RecursionDesired = true
+	return m
+}
+
+func (f msgExchanger) lookupHost(host string) ([]string, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []string{host}, nil
+	}
+
+	var result []string
+	var lastErr error
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		r, err := f(msgHost(host, qtype))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		result = append(result, convertAnswersToAddresses(r.Answer)...)
+	}
+
+	if len(result) == 0 {
+		if lastErr != nil {
+			return nil, lastErr
+		}
+		return nil, ErrNoAddresses
+	}
+
+	return result, nil
+}
+
+func convertAnswersToAddresses(in []dns.RR) []string {
+	result := []string{}
+	for _, a := range in {
+		switch rr := a.(type) {
+		case *dns.A:
+			result = append(result, rr.A.String())
+		case *dns.AAAA:
+			result = append(result, rr.AAAA.String())
+		}
+	}
+	return result
+}
@@ -0,0 +1,192 @@
+package net
+
+import (
+	"errors"
+	"net"
+
+	"github.com/miekg/dns"
+	. "gopkg.in/check.v1"
+)
+
+type ResolverSuite struct{}
+
+var _ = Suite(&ResolverSuite{})
+
+type fixedConnDialer struct {
+	conn net.Conn
+	err  error
+
+	network, addr string
+}
+
+func (d *fixedConnDialer) Dial(network, addr string) (net.Conn, error) {
+	d.network, d.addr = network, addr
+	return d.conn, d.err
+}
+
+// fakeDNSServer answers every query it gets on the returned connection with the given function
+func fakeDNSServer(answer func(*dns.Msg) *dns.Msg) net.Conn {
+	client, server := net.Pipe()
+	go func() {
+		dc := &dns.Conn{Conn: server}
+		defer func() {
+			_ = dc.Close()
+		}()
+
+		m, err := dc.ReadMsg()
+		if err != nil {
+			return
+		}
+		_ = dc.WriteMsg(answer(m))
+	}()
+	return client
+}
+
+func answerWith(rrs ...string) func(*dns.Msg) *dns.Msg {
+	return func(m *dns.Msg) *dns.Msg {
+		r := &dns.Msg{}
+		r.SetReply(m)
+		for _, s := range rrs {
+			rr, _ := dns.NewRR(s)
+			if rr.Header().Rrtype == m.Question[0].Qtype {
+				r.Answer = append(r.Answer, rr)
+			}
+		}
+		return r
+	}
+}
+
+func (s *ResolverSuite) Test_msgExchanger_lookupHost_returnsIPsDirectly(c *C) {
+	f := msgExchanger(func(*dns.Msg) (*dns.Msg, error) {
+		return nil, errors.New("should not be called")
+	})
+
+	res, e := f.lookupHost("10.0.0.1")
+	c.Assert(e, IsNil)
+	c.Assert(res, DeepEquals, []string{"10.0.0.1"})
+}
+
+func (s *ResolverSuite) Test_msgExchanger_lookupHost_asksForIPv4AndIPv6(c *C) {
+	answer := answerWith("example.org. 300 IN A 192.0.2.1", "example.org. 300 IN AAAA 2001:db8::1")
+	f := msgExchanger(func(m *dns.Msg) (*dns.Msg, error) {
+		return answer(m), nil
+	})
+
+	res, e := f.lookupHost("example.org")
+	c.Assert(e, IsNil)
+	c.Assert(res, DeepEquals, []string{"192.0.2.1", "2001:db8::1"})
+}
+
+func (s *ResolverSuite) Test_msgExchanger_lookupHost_failsWithoutAddresses(c *C) {
+	f := msgExchanger(func(m *dns.Msg) (*dns.Msg, error) {
+		return answerWith()(m), nil
+	})
+
+	_, e := f.lookupHost("example.org")
+	c.Assert(e, Equals, ErrNoAddresses)
+
+	f = msgExchanger(func(m *dns.Msg) (*dns.Msg, error) {
+		return nil, errors.New("marker error")
+	})
+
+	_, e = f.lookupHost("example.org")
+	c.Assert(e, ErrorMatches, "marker error")
+}
+
+func (s *ResolverSuite) Test_msgExchanger_lookupSRV_convertsTheAnswer(c *C) {
+	answer := answerWith("_xmpp-client._tcp.example.org. 300 IN SRV 5 0 5222 xmpp.example.org.")
+	f := msgExchanger(func(m *dns.Msg) (*dns.Msg, error) {
+		return answer(m), nil
+	})
+
+	cname, addrs, e := f.lookupSRV("xmpp-client", "tcp", "example.org")
+	c.Assert(e, IsNil)
+	c.Assert(cname, Equals, "_xmpp-client._tcp.example.org.")
+	c.Assert(addrs, HasLen, 1)
+	c.Assert(addrs[0].Target, Equals, "xmpp.example.org.")
+	c.Assert(addrs[0].Port, Equals, uint16(5222))
+}
+
+func (s *ResolverSuite) Test_TCPResolver_LookupHost_asksTheServerThroughTheDialer(c *C) {
+	d := &fixedConnDialer{conn: fakeDNSServer(answerWith("example.org. 300 IN A 192.0.2.1"))}
+	r := &TCPResolver{Dialer: d, Server: "192.0.2.53:53"}
+
+	_, e := r.LookupHost("example.org")
+	c.Assert(d.network, Equals, "tcp")
+	c.Assert(d.addr, Equals, "192.0.2.53:53")
+	// The fake server only answers one query, so the AAAA lookup fails but the A lookup is enough
+	c.Assert(e, IsNil)
+}
+
+func answerAuthenticatedWith(rrs ...string) func(*dns.Msg) *dns.Msg {
+	return func(m *dns.Msg) *dns.Msg {
+		r := answerWith(rrs...)(m)
+		r.AuthenticatedData = true
+		return r
+	}
+}
+
+func (s *ResolverSuite) Test_TCPResolver_LookupTLSA_neverTrustsTheAnswer(c *C) {
+	d := &fixedConnDialer{conn: fakeDNSServer(answerAuthenticatedWith("_5222._tcp.xmpp.example.org. 300 IN TLSA 3 1 1 abcd"))}
+	r := &TCPResolver{Dialer: d, Server: "192.0.2.53:53"}
+
+	records, secure, e := r.LookupTLSA(5222, "tcp", "xmpp.example.org")
+	c.Assert(e, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(secure, Equals, false)
+}
+
+func (s *ResolverSuite) Test_LookupTLSAFromLocalValidator_refusesValidatorsElsewhere(c *C) {
+	_, _, e := LookupTLSAFromLocalValidator("9.9.9.9:53", 5222, "tcp", "xmpp.example.org")
+	c.Assert(e, Equals, ErrValidatorNotLocal)
+}
+
+func (s *ResolverSuite) Test_LookupTLSAFromLocalValidator_trustsTheAnswerOfALocalValidator(c *C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = l.Close()
+	}()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		dc := &dns.Conn{Conn: conn}
+		defer func() {
+			_ = dc.Close()
+		}()
+
+		m, err := dc.ReadMsg()
+		if err != nil {
+			return
+		}
+		_ = dc.WriteMsg(answerAuthenticatedWith("_5222._tcp.xmpp.example.org. 300 IN TLSA 3 1 1 abcd")(m))
+	}()
+
+	records, secure, e := LookupTLSAFromLocalValidator(l.Addr().String(), 5222, "tcp", "xmpp.example.org")
+	c.Assert(e, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(secure, Equals, true)
+}
+
+func (s *ResolverSuite) Test_TCPResolver_usesTheDefaultServer(c *C) {
+	d := &fixedConnDialer{err: errors.New("marker error")}
+	r := &TCPResolver{Dialer: d}
+
+	_, _, e := r.LookupSRV("xmpp-client", "tcp", "example.org")
+	c.Assert(e, ErrorMatches, "marker error")
+	c.Assert(d.addr, Equals, defaultDNSServer)
+}
+
+func (s *ResolverSuite) Test_SystemResolver_refusesLookupsWhenTorOnly(c *C) {
+	Dialers.SetTorOnly(true)
+	defer Dialers.SetTorOnly(false)
+
+	_, _, err := (&SystemResolver{}).LookupSRV("xmpp-client", "tcp", "example.org")
+	c.Check(err, Equals, ErrWouldBypassTor)
+
+	_, err = (&SystemResolver{}).LookupHost("example.org")
+	c.Check(err, Equals, ErrWouldBypassTor)
+}
@@ -66,7 +66,7 @@ var detectTor = detectTorInternal
 func detectTorInternal(host string, ports []string) (string, bool) {
 	for _, port := range ports {
 		addr := net.JoinHostPort(host, port)
-		conn, err := net.DialTimeout("tcp", addr, timeout)
+		conn, err := Dialers.DialTimeout("tcp", addr, timeout)
 		if err != nil {
 			continue
 		}
@@ -93,7 +93,7 @@ var httpGet = func(c *http.Client, url string) (*http.Response, error) {
 // IsConnectionOverTor will make a connection to the check.torproject page to see if we're using Tor or not
 func (*defaultTorManager) IsConnectionOverTor(d proxy.Dialer) bool {
 	if d == nil {
-		d = proxy.Direct
+		d = Dialers.Direct(false)
 	}
 
 	c := &http.Client{Transport: &http.Transport{Dial: func(network, addr string) (net.Conn, error) {
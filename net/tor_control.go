@@ -0,0 +1,468 @@
+package net
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The Tor control protocol is described in
+// https://gitweb.torproject.org/torspec.git/tree/control-spec.txt
+
+var (
+	defaultTorControlPorts = []string{"9051", "9151"}
+
+	// torBootstrapPollInterval is how often we ask Tor about the bootstrap progress while waiting for it
+	torBootstrapPollInterval = 500 * time.Millisecond
+)
+
+const (
+	torSafeCookieServerKey = "Tor safe cookie authentication server-to-controller hash"
+	torSafeCookieClientKey = "Tor safe cookie authentication controller-to-server hash"
+	torCookieLength        = 32
+)
+
+var (
+	// ErrTorControlNotFound is returned when no Tor control port can be found
+	ErrTorControlNotFound = errors.New("tor: control port not found")
+	// ErrTorControlNoAuthMethod is returned when Tor doesn't offer any authentication method we can use
+	ErrTorControlNoAuthMethod = errors.New("tor: no supported authentication method for the control port")
+	// ErrTorControlServerHashMismatch is returned when Tor can't prove that it knows the authentication cookie
+	ErrTorControlServerHashMismatch = errors.New("tor: the control port sent a wrong safe cookie server hash")
+	// ErrTorBootstrapTimeout is returned when Tor doesn't finish bootstrapping in time
+	ErrTorBootstrapTimeout = errors.New("tor: timed out waiting for Tor to bootstrap")
+)
+
+// TorControl is a client for the control port of a running Tor
+type TorControl struct {
+	conn io.ReadWriteCloser
+	r    *textproto.Reader
+
+	sync.Mutex
+}
+
+// TorBootstrapStatus contains the bootstrap progress reported by Tor
+type TorBootstrapStatus struct {
+	Progress int
+	Tag      string
+	Summary  string
+}
+
+// Done returns true if Tor has finished bootstrapping
+func (s *TorBootstrapStatus) Done() bool {
+	return s.Progress >= 100
+}
+
+// TorCircuit contains the information about one circuit, as reported by Tor
+type TorCircuit struct {
+	ID            string
+	Status        string
+	Path          []string
+	Purpose       string
+	SOCKSUsername string
+}
+
+type torReply struct {
+	code  int
+	lines []string
+}
+
+// DetectTorControl returns the address of the Tor control port, if one can be found on the default ports
+func DetectTorControl() (string, bool) {
+	return detectTor(defaultTorHost, defaultTorControlPorts)
+}
+
+// OpenTorControl connects to the Tor control port on the default ports and authenticates with it.
+// The password is only used if Tor doesn't allow cookie authentication.
+func OpenTorControl(password string) (*TorControl, error) {
+	addr, ok := DetectTorControl()
+	if !ok {
+		return nil, ErrTorControlNotFound
+	}
+
+	return DialTorControl("tcp", addr, password)
+}
+
+// DialTorControl connects to the Tor control port at the given address and authenticates with it
+func DialTorControl(network, addr, password string) (*TorControl, error) {
+	conn, err := Dialers.DialTimeout(network, addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewTorControl(conn)
+	if err = c.Authenticate(password); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// NewTorControl returns a control port client that talks over the given connection.
+// Authenticate has to be called before any other command.
+func NewTorControl(conn io.ReadWriteCloser) *TorControl {
+	return &TorControl{
+		conn: conn,
+		r:    textproto.NewReader(bufio.NewReader(conn)),
+	}
+}
+
+// Close closes the connection to the control port
+func (c *TorControl) Close() error {
+	return c.conn.Close()
+}
+
+func (c *TorControl) readReply() (*torReply, error) {
+	r := &torReply{}
+	for {
+		line, err := c.r.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(line) < 4 {
+			return nil, errors.New("tor: malformed control port reply: " + line)
+		}
+
+		r.code, err = strconv.Atoi(line[:3])
+		if err != nil {
+			return nil, errors.New("tor: malformed control port reply: " + line)
+		}
+
+		text := line[4:]
+		switch line[3] {
+		case ' ':
+			r.lines = append(r.lines, text)
+			return r, nil
+		case '-':
+			r.lines = append(r.lines, text)
+		case '+':
+			data, err := c.r.ReadDotLines()
+			if err != nil {
+				return nil, err
+			}
+			r.lines = append(r.lines, text+strings.Join(data, "\n"))
+		default:
+			return nil, errors.New("tor: malformed control port reply: " + line)
+		}
+	}
+}
+
+func (c *TorControl) command(cmd string) (*torReply, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, err := io.WriteString(c.conn, cmd+"\r\n"); err != nil {
+		return nil, err
+	}
+
+	r, err := c.readReply()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.code != 250 {
+		return nil, errors.New("tor: control port command failed: " + strconv.Itoa(r.code) + " " + strings.Join(r.lines, " "))
+	}
+
+	return r, nil
+}
+
+func (c *TorControl) getInfo(key string) (string, error) {
+	r, err := c.command("GETINFO " + key)
+	if err != nil {
+		return "", err
+	}
+
+	for _, l := range r.lines {
+		if strings.HasPrefix(l, key+"=") {
+			return strings.TrimPrefix(l, key+"="), nil
+		}
+	}
+
+	return "", errors.New("tor: control port didn't return " + key)
+}
+
+// Authenticate authenticates with the control port, using the best method Tor offers.
+// Cookie authentication is preferred and the password is only used for Tor configured with HashedControlPassword.
+func (c *TorControl) Authenticate(password string) error {
+	r, err := c.command("PROTOCOLINFO 1")
+	if err != nil {
+		return err
+	}
+
+	var methods map[string]bool
+	var cookieFile string
+	for _, l := range r.lines {
+		if strings.HasPrefix(l, "AUTH ") {
+			_, args := parseTorArguments(strings.TrimPrefix(l, "AUTH "))
+			methods = make(map[string]bool)
+			for _, m := range strings.Split(args["METHODS"], ",") {
+				methods[m] = true
+			}
+			cookieFile = args["COOKIEFILE"]
+		}
+	}
+
+	switch {
+	case methods["NULL"]:
+		_, err = c.command("AUTHENTICATE")
+	case methods["SAFECOOKIE"] && cookieFile != "":
+		err = c.authenticateSafeCookie(cookieFile)
+	case methods["COOKIE"] && cookieFile != "":
+		err = c.authenticateCookie(cookieFile)
+	case methods["HASHEDPASSWORD"] && password != "":
+		_, err = c.command("AUTHENTICATE " + quoteTorString(password))
+	default:
+		err = ErrTorControlNoAuthMethod
+	}
+
+	return err
+}
+
+func readTorCookie(cookieFile string) ([]byte, error) {
+	cookie, err := ioutil.ReadFile(cookieFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cookie) != torCookieLength {
+		return nil, errors.New("tor: the authentication cookie in " + cookieFile + " has the wrong length")
+	}
+
+	return cookie, nil
+}
+
+func (c *TorControl) authenticateCookie(cookieFile string) error {
+	cookie, err := readTorCookie(cookieFile)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.command("AUTHENTICATE " + hex.EncodeToString(cookie))
+	return err
+}
+
+func torSafeCookieHash(key string, cookie, clientNonce, serverNonce []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	_, _ = mac.Write(cookie)
+	_, _ = mac.Write(clientNonce)
+	_, _ = mac.Write(serverNonce)
+	return mac.Sum(nil)
+}
+
+func (c *TorControl) authenticateSafeCookie(cookieFile string) error {
+	cookie, err := readTorCookie(cookieFile)
+	if err != nil {
+		return err
+	}
+
+	clientNonce := make([]byte, torCookieLength)
+	if _, err = io.ReadFull(rand.Reader, clientNonce); err != nil {
+		return err
+	}
+
+	r, err := c.command("AUTHCHALLENGE SAFECOOKIE " + hex.EncodeToString(clientNonce))
+	if err != nil {
+		return err
+	}
+
+	_, args := parseTorArguments(strings.TrimPrefix(r.lines[0], "AUTHCHALLENGE "))
+	serverHash, e1 := hex.DecodeString(args["SERVERHASH"])
+	serverNonce, e2 := hex.DecodeString(args["SERVERNONCE"])
+	if e1 != nil || e2 != nil {
+		return errors.New("tor: malformed safe cookie challenge: " + r.lines[0])
+	}
+
+	expected := torSafeCookieHash(torSafeCookieServerKey, cookie, clientNonce, serverNonce)
+	if !hmac.Equal(serverHash, expected) {
+		return ErrTorControlServerHashMismatch
+	}
+
+	clientHash := torSafeCookieHash(torSafeCookieClientKey, cookie, clientNonce, serverNonce)
+	_, err = c.command("AUTHENTICATE " + hex.EncodeToString(clientHash))
+	return err
+}
+
+// BootstrapStatus returns how far Tor has come in bootstrapping
+func (c *TorControl) BootstrapStatus() (*TorBootstrapStatus, error) {
+	phase, err := c.getInfo("status/bootstrap-phase")
+	if err != nil {
+		return nil, err
+	}
+
+	_, args := parseTorArguments(phase)
+	progress, err := strconv.Atoi(args["PROGRESS"])
+	if err != nil {
+		return nil, errors.New("tor: malformed bootstrap phase: " + phase)
+	}
+
+	return &TorBootstrapStatus{
+		Progress: progress,
+		Tag:      args["TAG"],
+		Summary:  args["SUMMARY"],
+	}, nil
+}
+
+// WaitForBootstrap waits until Tor has finished bootstrapping, calling onProgress every time the progress changes
+func (c *TorControl) WaitForBootstrap(t time.Duration, onProgress func(*TorBootstrapStatus)) error {
+	deadline := time.Now().Add(t)
+	last := -1
+	for {
+		st, err := c.BootstrapStatus()
+		if err != nil {
+			return err
+		}
+
+		if st.Progress != last && onProgress != nil {
+			onProgress(st)
+		}
+		last = st.Progress
+
+		if st.Done() {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return ErrTorBootstrapTimeout
+		}
+
+		time.Sleep(torBootstrapPollInterval)
+	}
+}
+
+// NewIdentity asks Tor to use new circuits for all new connections
+func (c *TorControl) NewIdentity() error {
+	_, err := c.command("SIGNAL NEWNYM")
+	return err
+}
+
+// Circuits returns all the circuits Tor currently knows about
+func (c *TorControl) Circuits() ([]*TorCircuit, error) {
+	status, err := c.getInfo("circuit-status")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*TorCircuit
+	for _, l := range strings.Split(status, "\n") {
+		if circ := parseTorCircuit(l); circ != nil {
+			result = append(result, circ)
+		}
+	}
+
+	return result, nil
+}
+
+// CircuitsIsolatedFor returns the circuits Tor has built for the streams of the given SOCKS username.
+// Since Tor isolates streams with different SOCKS authentication by default, these circuits are not
+// shared with any connection that uses other credentials.
+func (c *TorControl) CircuitsIsolatedFor(socksUsername string) ([]*TorCircuit, error) {
+	all, err := c.Circuits()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*TorCircuit
+	for _, circ := range all {
+		if circ.SOCKSUsername == socksUsername && circ.Status == "BUILT" {
+			result = append(result, circ)
+		}
+	}
+
+	return result, nil
+}
+
+func parseTorCircuit(line string) *TorCircuit {
+	pos, args := parseTorArguments(line)
+	if len(pos) < 2 {
+		return nil
+	}
+
+	circ := &TorCircuit{
+		ID:            pos[0],
+		Status:        pos[1],
+		Purpose:       args["PURPOSE"],
+		SOCKSUsername: args["SOCKS_USERNAME"],
+	}
+
+	if len(pos) > 2 {
+		circ.Path = strings.Split(pos[2], ",")
+	}
+
+	return circ
+}
+
+// parseTorArguments splits a line from the control port into its positional arguments and its
+// keyword arguments. Values of keyword arguments can be quoted strings.
+func parseTorArguments(s string) (positional []string, keywords map[string]string) {
+	keywords = make(map[string]string)
+
+	for len(s) > 0 {
+		s = strings.TrimLeft(s, " ")
+		if s == "" {
+			break
+		}
+
+		end := strings.IndexAny(s, " =")
+		if end == -1 || s[end] == ' ' {
+			if end == -1 {
+				end = len(s)
+			}
+			positional = append(positional, s[:end])
+			s = s[end:]
+			continue
+		}
+
+		key := s[:end]
+		s = s[end+1:]
+
+		var value string
+		value, s = parseTorValue(s)
+		keywords[key] = value
+	}
+
+	return
+}
+
+func parseTorValue(s string) (string, string) {
+	if !strings.HasPrefix(s, "\"") {
+		end := strings.IndexByte(s, ' ')
+		if end == -1 {
+			return s, ""
+		}
+		return s[:end], s[end:]
+	}
+
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				value.WriteByte(s[i])
+			}
+		case '"':
+			return value.String(), s[i+1:]
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+
+	return value.String(), ""
+}
+
+func quoteTorString(s string) string {
+	return "\"" + strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s) + "\""
+}
@@ -0,0 +1,310 @@
+package net
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type TorControlSuite struct{}
+
+var _ = Suite(&TorControlSuite{})
+
+// torControlStep is one exchange with the scripted control port: it receives the command sent
+// and returns the raw reply lines
+type torControlStep func(c *C, cmd string) []string
+
+func expectTorCommand(expected string, reply ...string) torControlStep {
+	return func(c *C, cmd string) []string {
+		c.Check(cmd, Equals, expected)
+		return reply
+	}
+}
+
+func expectTorCommandPrefix(prefix string, reply ...string) torControlStep {
+	return func(c *C, cmd string) []string {
+		c.Check(strings.HasPrefix(cmd, prefix), Equals, true)
+		return reply
+	}
+}
+
+// scriptedTorControl returns a client connected to a control port stand-in that goes through the given steps
+func scriptedTorControl(c *C, steps ...torControlStep) (*TorControl, chan bool) {
+	client, server := net.Pipe()
+	done := make(chan bool, 1)
+
+	go func() {
+		defer func() {
+			_ = server.Close()
+			done <- true
+		}()
+
+		r := bufio.NewReader(server)
+		for _, step := range steps {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				c.Errorf("control port stand-in failed reading: %v", err)
+				return
+			}
+
+			reply := step(c, strings.TrimSuffix(line, "\r\n"))
+			_, _ = server.Write([]byte(strings.Join(reply, "\r\n") + "\r\n"))
+		}
+	}()
+
+	return NewTorControl(client), done
+}
+
+func writeTorCookie(c *C) ([]byte, string) {
+	cookie := []byte("0123456789abcdef0123456789abcdef")
+	file := filepath.Join(c.MkDir(), "control_auth_cookie")
+	c.Assert(ioutil.WriteFile(file, cookie, 0600), IsNil)
+	return cookie, file
+}
+
+func protocolInfoWith(methods, cookieFile string) torControlStep {
+	auth := "250-AUTH METHODS=" + methods
+	if cookieFile != "" {
+		auth += " COOKIEFILE=" + quoteTorString(cookieFile)
+	}
+	return expectTorCommand("PROTOCOLINFO 1", "250-PROTOCOLINFO 1", auth, "250-VERSION Tor=\"0.4.8.9\"", "250 OK")
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_usesNullAuthenticationWhenAvailable(c *C) {
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("NULL", ""),
+		expectTorCommand("AUTHENTICATE", "250 OK"),
+	)
+
+	c.Assert(ctl.Authenticate("secret"), IsNil)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_usesSafeCookieAuthentication(c *C) {
+	cookie, file := writeTorCookie(c)
+	serverNonce := []byte("fedcba9876543210fedcba9876543210")
+	var clientNonce []byte
+
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("COOKIE,SAFECOOKIE,HASHEDPASSWORD", file),
+		func(c *C, cmd string) []string {
+			c.Assert(strings.HasPrefix(cmd, "AUTHCHALLENGE SAFECOOKIE "), Equals, true)
+			clientNonce, _ = hex.DecodeString(strings.TrimPrefix(cmd, "AUTHCHALLENGE SAFECOOKIE "))
+			serverHash := torSafeCookieHash(torSafeCookieServerKey, cookie, clientNonce, serverNonce)
+			return []string{"250 AUTHCHALLENGE SERVERHASH=" + hex.EncodeToString(serverHash) + " SERVERNONCE=" + hex.EncodeToString(serverNonce)}
+		},
+		func(c *C, cmd string) []string {
+			clientHash := torSafeCookieHash(torSafeCookieClientKey, cookie, clientNonce, serverNonce)
+			c.Check(cmd, Equals, "AUTHENTICATE "+hex.EncodeToString(clientHash))
+			return []string{"250 OK"}
+		},
+	)
+
+	c.Assert(ctl.Authenticate(""), IsNil)
+	c.Check(clientNonce, HasLen, torCookieLength)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_failsIfTorDoesntKnowTheCookie(c *C) {
+	_, file := writeTorCookie(c)
+
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("SAFECOOKIE", file),
+		expectTorCommandPrefix("AUTHCHALLENGE SAFECOOKIE ",
+			"250 AUTHCHALLENGE SERVERHASH=00112233 SERVERNONCE=44556677"),
+	)
+
+	c.Assert(ctl.Authenticate(""), Equals, ErrTorControlServerHashMismatch)
+	_ = ctl.Close()
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_usesCookieAuthentication(c *C) {
+	cookie, file := writeTorCookie(c)
+
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("COOKIE", file),
+		expectTorCommand("AUTHENTICATE "+hex.EncodeToString(cookie), "250 OK"),
+	)
+
+	c.Assert(ctl.Authenticate(""), IsNil)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_usesAQuotedPassword(c *C) {
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("HASHEDPASSWORD", ""),
+		expectTorCommand(`AUTHENTICATE "my \"secret\" pass"`, "250 OK"),
+	)
+
+	c.Assert(ctl.Authenticate(`my "secret" pass`), IsNil)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_reportsAFailedAuthentication(c *C) {
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("HASHEDPASSWORD", ""),
+		expectTorCommand(`AUTHENTICATE "wrong"`, "515 Authentication failed: Password did not match HashedControlPassword value from configuration"),
+	)
+
+	err := ctl.Authenticate("wrong")
+	c.Assert(err, ErrorMatches, "tor: control port command failed: 515 Authentication failed.*")
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_failsWithoutAUsableMethod(c *C) {
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("HASHEDPASSWORD", ""),
+	)
+
+	c.Assert(ctl.Authenticate(""), Equals, ErrTorControlNoAuthMethod)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_BootstrapStatus_parsesTheBootstrapPhase(c *C) {
+	ctl, done := scriptedTorControl(c,
+		expectTorCommand("GETINFO status/bootstrap-phase",
+			`250-status/bootstrap-phase=NOTICE BOOTSTRAP PROGRESS=45 TAG=requesting_descriptors SUMMARY="Asking for relay descriptors"`,
+			"250 OK"),
+	)
+
+	st, err := ctl.BootstrapStatus()
+	c.Assert(err, IsNil)
+	c.Check(st, DeepEquals, &TorBootstrapStatus{Progress: 45, Tag: "requesting_descriptors", Summary: "Asking for relay descriptors"})
+	c.Check(st.Done(), Equals, false)
+	<-done
+}
+
+func bootstrapPhase(progress, tag, summary string) torControlStep {
+	return expectTorCommand("GETINFO status/bootstrap-phase",
+		"250-status/bootstrap-phase=NOTICE BOOTSTRAP PROGRESS="+progress+" TAG="+tag+" SUMMARY="+quoteTorString(summary),
+		"250 OK")
+}
+
+func (s *TorControlSuite) Test_TorControl_WaitForBootstrap_reportsProgressUntilDone(c *C) {
+	orgInterval := torBootstrapPollInterval
+	defer func() {
+		torBootstrapPollInterval = orgInterval
+	}()
+	torBootstrapPollInterval = time.Millisecond
+
+	ctl, done := scriptedTorControl(c,
+		bootstrapPhase("10", "conn_done", "Connected to a relay"),
+		bootstrapPhase("10", "conn_done", "Connected to a relay"),
+		bootstrapPhase("80", "ap_conn", "Connecting to a relay to build circuits"),
+		bootstrapPhase("100", "done", "Done"),
+	)
+
+	var seen []int
+	err := ctl.WaitForBootstrap(time.Minute, func(st *TorBootstrapStatus) {
+		seen = append(seen, st.Progress)
+	})
+
+	c.Assert(err, IsNil)
+	c.Check(seen, DeepEquals, []int{10, 80, 100})
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_WaitForBootstrap_timesOut(c *C) {
+	ctl, done := scriptedTorControl(c,
+		bootstrapPhase("10", "conn_done", "Connected to a relay"),
+	)
+
+	err := ctl.WaitForBootstrap(0, nil)
+
+	c.Assert(err, Equals, ErrTorBootstrapTimeout)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_NewIdentity_sendsNEWNYM(c *C) {
+	ctl, done := scriptedTorControl(c,
+		expectTorCommand("SIGNAL NEWNYM", "250 OK"),
+	)
+
+	c.Assert(ctl.NewIdentity(), IsNil)
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_CircuitsIsolatedFor_returnsTheBuiltCircuitsForTheUsername(c *C) {
+	ctl, done := scriptedTorControl(c,
+		expectTorCommand("GETINFO circuit-status",
+			"250+circuit-status=",
+			`1 BUILT $AAAA~relayA,$BBBB~relayB,$CCCC~relayC BUILD_FLAGS=NEED_CAPACITY PURPOSE=GENERAL SOCKS_USERNAME="account-one" SOCKS_PASSWORD="x"`,
+			`2 BUILT $DDDD~relayD,$EEEE~relayE BUILD_FLAGS=NEED_CAPACITY PURPOSE=GENERAL SOCKS_USERNAME="account-two" SOCKS_PASSWORD="y"`,
+			`3 EXTENDED $AAAA~relayA PURPOSE=GENERAL SOCKS_USERNAME="account-one" SOCKS_PASSWORD="x"`,
+			`4 LAUNCHED PURPOSE=GENERAL`,
+			".",
+			"250 OK"),
+	)
+
+	circs, err := ctl.CircuitsIsolatedFor("account-one")
+	c.Assert(err, IsNil)
+	c.Assert(circs, HasLen, 1)
+	c.Check(circs[0].ID, Equals, "1")
+	c.Check(circs[0].Path, DeepEquals, []string{"$AAAA~relayA", "$BBBB~relayB", "$CCCC~relayC"})
+	c.Check(circs[0].Purpose, Equals, "GENERAL")
+	<-done
+}
+
+func (s *TorControlSuite) Test_TorControl_Circuits_handlesNoCircuits(c *C) {
+	ctl, done := scriptedTorControl(c,
+		expectTorCommand("GETINFO circuit-status", "250-circuit-status=", "250 OK"),
+	)
+
+	circs, err := ctl.Circuits()
+	c.Assert(err, IsNil)
+	c.Check(circs, HasLen, 0)
+	<-done
+}
+
+func (s *TorControlSuite) Test_DialTorControl_connectsAndAuthenticates(c *C) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = ln.Close()
+	}()
+
+	go func() {
+		conn, e := ln.Accept()
+		if e != nil {
+			return
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		r := bufio.NewReader(conn)
+		_, _ = r.ReadString('\n')
+		_, _ = conn.Write([]byte("250-PROTOCOLINFO 1\r\n250-AUTH METHODS=HASHEDPASSWORD\r\n250 OK\r\n"))
+		_, _ = r.ReadString('\n')
+		_, _ = conn.Write([]byte("250 OK\r\n"))
+		_, _ = r.ReadString('\n')
+	}()
+
+	ctl, err := DialTorControl("tcp", ln.Addr().String(), "secret")
+	c.Assert(err, IsNil)
+	c.Assert(ctl.Close(), IsNil)
+}
+
+func (s *TorControlSuite) Test_TorControl_Authenticate_failsIfTheCookieCantBeRead(c *C) {
+	ctl, done := scriptedTorControl(c,
+		protocolInfoWith("COOKIE", filepath.Join(os.TempDir(), "does-not-exist", "cookie")),
+	)
+
+	c.Assert(ctl.Authenticate(""), NotNil)
+	<-done
+}
+
+func (s *TorControlSuite) Test_parseTorArguments_handlesQuotedValues(c *C) {
+	pos, kw := parseTorArguments(`NOTICE BOOTSTRAP PROGRESS=5 SUMMARY="Say \"hi\" \\ there" TAG=conn`)
+
+	c.Check(pos, DeepEquals, []string{"NOTICE", "BOOTSTRAP"})
+	c.Check(kw, DeepEquals, map[string]string{"PROGRESS": "5", "SUMMARY": `Say "hi" \ there`, "TAG": "conn"})
+}
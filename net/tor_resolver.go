@@ -0,0 +1,170 @@
+package net
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/miekg/dns"
+	"golang.org/x/net/proxy"
+)
+
+// The RESOLVE command is a Tor extension to SOCKS5, described in
+// https://gitweb.torproject.org/torspec.git/tree/socks-extensions.txt
+const (
+	socks5Version      = 5
+	socks5AuthNone     = 0
+	socks5AuthPassword = 2
+	socks5TorResolve   = 0xF0
+	socks5IP4          = 1
+	socks5Domain       = 3
+	socks5IP6          = 4
+)
+
+// TorResolver resolves host names using the RESOLVE extension of the Tor SOCKS proxy at the given address.
+// Tor can only resolve host names this way, so SRV lookups are not supported.
+type TorResolver struct {
+	Network string
+	Address string
+	Auth    *proxy.Auth
+	// Forward is the dialer used to reach the Tor SOCKS proxy
+	Forward proxy.Dialer
+}
+
+// LookupSRV implements Resolver
+func (*TorResolver) LookupSRV(service, proto, name string) (string, []*net.SRV, error) {
+	return "", nil, ErrSRVNotSupported
+}
+
+// LookupTLSA implements Resolver. Tor can only resolve host names, so TLSA lookups are not supported either.
+func (*TorResolver) LookupTLSA(port int, proto, name string) ([]*dns.TLSA, bool, error) {
+	return nil, false, ErrTLSANotSupported
+}
+
+// LookupHost implements Resolver
+func (r *TorResolver) LookupHost(host string) ([]string, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []string{host}, nil
+	}
+
+	conn, err := dialerOrDirect(r.Forward).Dial(r.Network, r.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_ = conn.SetDeadline(time.Now().Add(defaultLookupTimeout))
+
+	if err = r.greet(conn); err != nil {
+		return nil, err
+	}
+
+	ip, err := r.resolve(conn, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{ip.String()}, nil
+}
+
+func (r *TorResolver) proxyError(msg string, err error) error {
+	if err != nil {
+		msg = msg + ": " + err.Error()
+	}
+	return errors.New("proxy: " + msg + " (SOCKS5 proxy at " + r.Address + ")")
+}
+
+func (r *TorResolver) greet(conn io.ReadWriter) error {
+	buf := []byte{socks5Version, 1, socks5AuthNone}
+	if r.Auth != nil && len(r.Auth.User) > 0 && len(r.Auth.User) < 256 && len(r.Auth.Password) < 256 {
+		buf = []byte{socks5Version, 2, socks5AuthNone, socks5AuthPassword}
+	}
+
+	if _, err := conn.Write(buf); err != nil {
+		return r.proxyError("failed to write greeting", err)
+	}
+
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return r.proxyError("failed to read greeting", err)
+	}
+	if buf[0] != socks5Version {
+		return r.proxyError("unexpected version "+strconv.Itoa(int(buf[0])), nil)
+	}
+
+	switch buf[1] {
+	case socks5AuthNone:
+		return nil
+	case socks5AuthPassword:
+		if r.Auth == nil {
+			return r.proxyError("requires authentication", nil)
+		}
+	default:
+		return r.proxyError("requires authentication", nil)
+	}
+
+	buf = []byte{1, uint8(len(r.Auth.User))}
+	buf = append(buf, r.Auth.User...)
+	buf = append(buf, uint8(len(r.Auth.Password)))
+	buf = append(buf, r.Auth.Password...)
+
+	if _, err := conn.Write(buf); err != nil {
+		return r.proxyError("failed to write authentication request", err)
+	}
+
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return r.proxyError("failed to read authentication reply", err)
+	}
+
+	if buf[1] != 0 {
+		return r.proxyError("rejected username/password", nil)
+	}
+
+	return nil
+}
+
+func (r *TorResolver) resolve(conn io.ReadWriter, host string) (net.IP, error) {
+	if len(host) > 255 {
+		return nil, errors.New("proxy: hostname too long: " + host)
+	}
+
+	buf := []byte{socks5Version, socks5TorResolve, 0, socks5Domain, byte(len(host))}
+	buf = append(buf, host...)
+	buf = append(buf, 0, 0)
+
+	if _, err := conn.Write(buf); err != nil {
+		return nil, r.proxyError("failed to write resolve request", err)
+	}
+
+	if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+		return nil, r.proxyError("failed to read resolve reply", err)
+	}
+
+	if buf[1] != 0 {
+		return nil, r.proxyError("failed to resolve "+host+" with error code "+strconv.Itoa(int(buf[1])), nil)
+	}
+
+	var ip net.IP
+	switch buf[3] {
+	case socks5IP4:
+		ip = make(net.IP, net.IPv4len)
+	case socks5IP6:
+		ip = make(net.IP, net.IPv6len)
+	default:
+		return nil, r.proxyError("got unknown address type "+strconv.Itoa(int(buf[3])), nil)
+	}
+
+	if _, err := io.ReadFull(conn, ip); err != nil {
+		return nil, r.proxyError("failed to read address", err)
+	}
+
+	// The port is meaningless for RESOLVE, but it is still sent
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return nil, r.proxyError("failed to read port", err)
+	}
+
+	return ip, nil
+}
@@ -0,0 +1,98 @@
+package net
+
+import (
+	"io"
+	"net"
+
+	"golang.org/x/net/proxy"
+	. "gopkg.in/check.v1"
+)
+
+type TorResolverSuite struct{}
+
+var _ = Suite(&TorResolverSuite{})
+
+// fakeSOCKSServer checks that the client sends the expected bytes in each step and replies with the corresponding answer
+func fakeSOCKSServer(expected, replies [][]byte) (net.Conn, chan bool) {
+	client, server := net.Pipe()
+	ok := make(chan bool, 1)
+	go func() {
+		defer func() {
+			_ = server.Close()
+		}()
+
+		for ix, exp := range expected {
+			buf := make([]byte, len(exp))
+			if _, err := io.ReadFull(server, buf); err != nil || string(buf) != string(exp) {
+				ok <- false
+				return
+			}
+			if _, err := server.Write(replies[ix]); err != nil {
+				ok <- false
+				return
+			}
+		}
+		ok <- true
+	}()
+	return client, ok
+}
+
+func resolveRequest(host string) []byte {
+	return append(append([]byte{5, 0xF0, 0, 3, byte(len(host))}, host...), 0, 0)
+}
+
+func (s *TorResolverSuite) Test_TorResolver_LookupSRV_isNotSupported(c *C) {
+	_, _, e := (&TorResolver{}).LookupSRV("xmpp-client", "tcp", "example.org")
+	c.Assert(e, Equals, ErrSRVNotSupported)
+}
+
+func (s *TorResolverSuite) Test_TorResolver_LookupTLSA_isNotSupported(c *C) {
+	_, _, e := (&TorResolver{}).LookupTLSA(5222, "tcp", "example.org")
+	c.Assert(e, Equals, ErrTLSANotSupported)
+}
+
+func (s *TorResolverSuite) Test_TorResolver_LookupHost_resolvesAnIPv4Address(c *C) {
+	conn, ok := fakeSOCKSServer(
+		[][]byte{{5, 1, 0}, resolveRequest("example.org")},
+		[][]byte{{5, 0}, {5, 0, 0, 1, 192, 0, 2, 1, 0, 0}},
+	)
+	d := &fixedConnDialer{conn: conn}
+
+	r := &TorResolver{Network: "tcp", Address: "127.0.0.1:9050", Forward: d}
+	res, e := r.LookupHost("example.org")
+	c.Assert(e, IsNil)
+	c.Assert(res, DeepEquals, []string{"192.0.2.1"})
+	c.Assert(d.addr, Equals, "127.0.0.1:9050")
+	c.Assert(<-ok, Equals, true)
+}
+
+func (s *TorResolverSuite) Test_TorResolver_LookupHost_authenticatesAndResolvesAnIPv6Address(c *C) {
+	reply := append([]byte{5, 0, 0, 4}, net.ParseIP("2001:db8::1")...)
+	conn, ok := fakeSOCKSServer(
+		[][]byte{{5, 2, 0, 2}, {1, 1, 'u', 1, 'p'}, resolveRequest("example.org")},
+		[][]byte{{5, 2}, {1, 0}, append(reply, 0, 0)},
+	)
+
+	r := &TorResolver{Network: "tcp", Address: "127.0.0.1:9050", Auth: &proxy.Auth{User: "u", Password: "p"}, Forward: &fixedConnDialer{conn: conn}}
+	res, e := r.LookupHost("example.org")
+	c.Assert(e, IsNil)
+	c.Assert(res, DeepEquals, []string{"2001:db8::1"})
+	c.Assert(<-ok, Equals, true)
+}
+
+func (s *TorResolverSuite) Test_TorResolver_LookupHost_failsWhenTorCantResolve(c *C) {
+	conn, _ := fakeSOCKSServer(
+		[][]byte{{5, 1, 0}, resolveRequest("example.org")},
+		[][]byte{{5, 0}, {5, 4, 0, 1}},
+	)
+
+	r := &TorResolver{Network: "tcp", Address: "127.0.0.1:9050", Forward: &fixedConnDialer{conn: conn}}
+	_, e := r.LookupHost("example.org")
+	c.Assert(e, ErrorMatches, "proxy: failed to resolve example.org with error code 4 .*")
+}
+
+func (s *TorResolverSuite) Test_TorResolver_LookupHost_returnsIPsDirectly(c *C) {
+	res, e := (&TorResolver{}).LookupHost("192.0.2.1")
+	c.Assert(e, IsNil)
+	c.Assert(res, DeepEquals, []string{"192.0.2.1"})
+}
@@ -0,0 +1,84 @@
+package sasl
+
+// BasicVerifier contains the shared logic for protecting against SASL mechanism downgrades
+type BasicVerifier struct {
+	StrongestSeen   func() (mechanism, channelBinding string)
+	SaveMechanism   func(mechanism, channelBinding string)
+	AskDowngrade    func(previous, offered string) error
+	OnDowngradeDeny func()
+	DowngradePolicy string
+}
+
+func channelBindingUsed(mechanism, channelBinding string) string {
+	if UsesChannelBinding(mechanism) {
+		return channelBinding
+	}
+	return ""
+}
+
+func (v *BasicVerifier) isDowngrade(mechanism, channelBinding string) bool {
+	previous, previousBinding := v.StrongestSeen()
+	if previous == "" {
+		return false
+	}
+
+	if MechanismStrength(mechanism) < MechanismStrength(previous) {
+		return true
+	}
+
+	// If we don't have channel binding information on our side, the server can't be blamed for not offering it
+	return previousBinding != "" && channelBinding != "" && !UsesChannelBinding(mechanism)
+}
+
+func (v *BasicVerifier) isUpgrade(mechanism, channelBinding string) bool {
+	previous, previousBinding := v.StrongestSeen()
+	if previous == "" {
+		return true
+	}
+
+	strength, previousStrength := MechanismStrength(mechanism), MechanismStrength(previous)
+	if strength != previousStrength {
+		return strength > previousStrength
+	}
+
+	return previousBinding == "" && channelBindingUsed(mechanism, channelBinding) != ""
+}
+
+func (v *BasicVerifier) handleDowngrade(mechanism string) error {
+	previous, _ := v.StrongestSeen()
+
+	switch v.DowngradePolicy {
+	case "", "ask": // We will ask the user whether to continue. This is the default.
+		return v.AskDowngrade(previous, mechanism)
+	case "deny": // We will never authenticate with a weaker mechanism, not even asking the user
+		v.OnDowngradeDeny()
+		return ErrMechanismDowngrade
+	}
+
+	v.OnDowngradeDeny()
+	return ErrMechanismDowngrade
+}
+
+// VerifyMechanism implements Verifier
+func (v *BasicVerifier) VerifyMechanism(mechanism, channelBinding string) error {
+	if v.DowngradePolicy == "none" {
+		return nil
+	}
+
+	if v.isDowngrade(mechanism, channelBinding) {
+		return v.handleDowngrade(mechanism)
+	}
+
+	return nil
+}
+
+// MechanismSucceeded implements Verifier
+func (v *BasicVerifier) MechanismSucceeded(mechanism, channelBinding string) {
+	if v.DowngradePolicy == "none" {
+		return
+	}
+
+	if v.isUpgrade(mechanism, channelBinding) {
+		v.SaveMechanism(mechanism, channelBindingUsed(mechanism, channelBinding))
+	}
+}
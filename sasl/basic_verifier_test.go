@@ -0,0 +1,144 @@
+package sasl
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type BasicVerifierSuite struct{}
+
+var _ = Suite(&BasicVerifierSuite{})
+
+type savedMechanism struct {
+	mechanism, channelBinding string
+}
+
+func verifierWithSeen(policy, mechanism, channelBinding string, saved *[]savedMechanism) *BasicVerifier {
+	return &BasicVerifier{
+		StrongestSeen: func() (string, string) { return mechanism, channelBinding },
+		SaveMechanism: func(m, cb string) {
+			*saved = append(*saved, savedMechanism{m, cb})
+		},
+		AskDowngrade:    func(string, string) error { return ErrMechanismDowngrade },
+		OnDowngradeDeny: func() {},
+		DowngradePolicy: policy,
+	}
+}
+
+func (s *BasicVerifierSuite) Test_MechanismSucceeded_savesTheFirstMechanismSeen(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "", "", &saved)
+
+	v.MechanismSucceeded("SCRAM-SHA-256-PLUS", "tls-unique")
+	c.Assert(saved, DeepEquals, []savedMechanism{{"SCRAM-SHA-256-PLUS", "tls-unique"}})
+}
+
+func (s *BasicVerifierSuite) Test_MechanismSucceeded_doesNotSaveChannelBindingIfNotUsed(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "", "", &saved)
+
+	v.MechanismSucceeded("SCRAM-SHA-256", "tls-unique")
+	c.Assert(saved, DeepEquals, []savedMechanism{{"SCRAM-SHA-256", ""}})
+}
+
+func (s *BasicVerifierSuite) Test_MechanismSucceeded_savesAStrongerMechanism(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "SCRAM-SHA-1", "", &saved)
+
+	v.MechanismSucceeded("SCRAM-SHA-512", "")
+	c.Assert(saved, DeepEquals, []savedMechanism{{"SCRAM-SHA-512", ""}})
+}
+
+func (s *BasicVerifierSuite) Test_MechanismSucceeded_savesWhenChannelBindingIsAdded(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "SCRAM-SHA-1", "", &saved)
+
+	v.MechanismSucceeded("SCRAM-SHA-1-PLUS", "tls-unique")
+	c.Assert(saved, DeepEquals, []savedMechanism{{"SCRAM-SHA-1-PLUS", "tls-unique"}})
+}
+
+func (s *BasicVerifierSuite) Test_MechanismSucceeded_doesNothingForTheSameMechanism(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "SCRAM-SHA-1-PLUS", "tls-unique", &saved)
+
+	v.MechanismSucceeded("SCRAM-SHA-1-PLUS", "tls-unique")
+	c.Assert(saved, HasLen, 0)
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_savesNothingBeforeAuthenticationSucceeds(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "SCRAM-SHA-1", "", &saved)
+
+	c.Assert(v.VerifyMechanism("SCRAM-SHA-512-PLUS", "tls-unique"), IsNil)
+	c.Assert(saved, HasLen, 0)
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_asksOnAWeakerMechanism(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("", "SCRAM-SHA-256", "", &saved)
+	var askedPrevious, askedOffered string
+	v.AskDowngrade = func(previous, offered string) error {
+		askedPrevious, askedOffered = previous, offered
+		return nil
+	}
+
+	c.Assert(v.VerifyMechanism("PLAIN", ""), IsNil)
+	c.Assert(askedPrevious, Equals, "SCRAM-SHA-256")
+	c.Assert(askedOffered, Equals, "PLAIN")
+	c.Assert(saved, HasLen, 0)
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_returnsTheErrorFromAsking(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("ask", "SCRAM-SHA-256", "", &saved)
+	v.AskDowngrade = func(string, string) error { return errors.New("no way") }
+
+	c.Assert(v.VerifyMechanism("DIGEST-MD5", ""), ErrorMatches, "no way")
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_treatsStrippedChannelBindingAsADowngrade(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("deny", "SCRAM-SHA-256-PLUS", "tls-unique", &saved)
+
+	c.Assert(v.VerifyMechanism("SCRAM-SHA-256", "tls-unique"), Equals, ErrMechanismDowngrade)
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_doesNotBlameTheServerWhenWeHaveNoChannelBinding(c *C) {
+	saved := []savedMechanism{}
+	v := verifierWithSeen("deny", "SCRAM-SHA-256-PLUS", "tls-unique", &saved)
+
+	c.Assert(v.VerifyMechanism("SCRAM-SHA-256", ""), IsNil)
+	c.Assert(saved, HasLen, 0)
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_withDenyPolicy_refusesWithoutAsking(c *C) {
+	saved := []savedMechanism{}
+	denied := false
+	v := verifierWithSeen("deny", "SCRAM-SHA-1", "", &saved)
+	v.OnDowngradeDeny = func() { denied = true }
+	v.AskDowngrade = func(string, string) error {
+		c.Fatal("should not ask")
+		return nil
+	}
+
+	c.Assert(v.VerifyMechanism("PLAIN", ""), Equals, ErrMechanismDowngrade)
+	c.Assert(denied, Equals, true)
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_withNonePolicy_acceptsAndSavesNothing(c *C) {
+	v := &BasicVerifier{DowngradePolicy: "none"}
+
+	c.Assert(v.VerifyMechanism("PLAIN", ""), IsNil)
+	v.MechanismSucceeded("PLAIN", "")
+}
+
+func (s *BasicVerifierSuite) Test_VerifyMechanism_withUnknownPolicy_refuses(c *C) {
+	saved := []savedMechanism{}
+	denied := false
+	v := verifierWithSeen("something", "SCRAM-SHA-1", "", &saved)
+	v.OnDowngradeDeny = func() { denied = true }
+
+	c.Assert(v.VerifyMechanism("PLAIN", ""), Equals, ErrMechanismDowngrade)
+	c.Assert(denied, Equals, true)
+}
@@ -14,6 +14,10 @@ const (
 	Service
 	QOP
 	ClientNonce
+	ScramSalt
+	ScramIter
+	ScramClientKey
+	ScramServerKey
 )
 
 // Properties represents a map of property and value
@@ -0,0 +1,23 @@
+package sasl
+
+// SaltedKeys contains the keys a salted mechanism like SCRAM derives from a password.
+// They can be used to authenticate instead of the password, as long as the server
+// keeps using the same salt and iteration count.
+type SaltedKeys struct {
+	Salt       []byte
+	Iterations int
+	ClientKey  []byte
+	ServerKey  []byte
+}
+
+// SaltedKeysSession represents a session for a mechanism that can authenticate with salted keys
+type SaltedKeysSession interface {
+	SetSaltedKeys(SaltedKeys)
+	SaltedKeys() (SaltedKeys, bool)
+}
+
+// SaltedKeyStore represents somewhere salted keys can be found and saved, per mechanism
+type SaltedKeyStore interface {
+	SaltedKeysFor(mechanism string) (SaltedKeys, bool)
+	SaveSaltedKeys(mechanism string, keys SaltedKeys)
+}
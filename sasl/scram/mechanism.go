@@ -7,7 +7,10 @@ import (
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
+	"encoding/hex"
+	"errors"
 	"hash"
+	"strconv"
 
 	"github.com/chadsec1/decoyim/sasl"
 )
@@ -23,6 +26,11 @@ var (
 	sha512PlusMechanism sasl.Mechanism = &scramMechanism{sha512.New, sha512.Size, true, true}
 )
 
+// ErrSaltedKeysOutdated is returned when authenticating with salted keys that were derived
+// with a different salt or iteration count than what the server uses now. This usually
+// means the password was changed.
+var ErrSaltedKeysOutdated = errors.New("the salted keys don't match the salt or iteration count from the server")
+
 const (
 	// Name is the authentication type associated with the SASL mechanism
 	sha1Name       = "SCRAM-SHA-1"
@@ -71,3 +79,45 @@ func (p *scram) NeedsMore() bool {
 func (p *scram) SetChannelBinding(v []byte) {
 	p.channelBinding = v
 }
+
+func (p *scram) SetSaltedKeys(k sasl.SaltedKeys) {
+	p.props[sasl.ScramSalt] = string(sasl.Token(k.Salt).Encode())
+	p.props[sasl.ScramIter] = strconv.Itoa(k.Iterations)
+	p.props[sasl.ScramClientKey] = hex.EncodeToString(k.ClientKey)
+	p.props[sasl.ScramServerKey] = hex.EncodeToString(k.ServerKey)
+}
+
+func (p *scram) SaltedKeys() (sasl.SaltedKeys, bool) {
+	return saltedKeysFrom(p.props)
+}
+
+func saltedKeysFrom(props sasl.Properties) (sasl.SaltedKeys, bool) {
+	salt, ok1 := props[sasl.ScramSalt]
+	iter, ok2 := props[sasl.ScramIter]
+	clientKey, ok3 := props[sasl.ScramClientKey]
+	serverKey, ok4 := props[sasl.ScramServerKey]
+	if !(ok1 && ok2 && ok3 && ok4) {
+		return sasl.SaltedKeys{}, false
+	}
+
+	var err error
+	result := sasl.SaltedKeys{}
+
+	if result.Salt, err = sasl.DecodeToken([]byte(salt)); err != nil {
+		return sasl.SaltedKeys{}, false
+	}
+
+	if result.Iterations, err = strconv.Atoi(iter); err != nil {
+		return sasl.SaltedKeys{}, false
+	}
+
+	if result.ClientKey, err = hex.DecodeString(clientKey); err != nil {
+		return sasl.SaltedKeys{}, false
+	}
+
+	if result.ServerKey, err = hex.DecodeString(serverKey); err != nil {
+		return sasl.SaltedKeys{}, false
+	}
+
+	return result, true
+}
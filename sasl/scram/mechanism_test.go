@@ -106,3 +106,94 @@ func (s *ScramSuite) Test_scram_SetChannelBinding(c *C) {
 	sc.SetChannelBinding([]byte("something"))
 	c.Assert(sc.channelBinding, DeepEquals, []byte("something"))
 }
+
+func (s *ScramSuite) TestScramWithRFC5802TestVector_savesSaltedKeys(c *C) {
+	mech := &scramMechanism{sha1.New, sha1.Size, false, false}
+	client := mech.NewClient()
+
+	_ = client.SetProperty(sasl.AuthID, "user")
+	_ = client.SetProperty(sasl.Password, "pencil")
+	_ = client.SetProperty(sasl.ClientNonce, "fyko+d2lbbFgONRv9qkxdawL")
+
+	_, _ = client.Step(nil)
+	_, err := client.Step(sasl.Token("r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,s=QSXCR+Q6sek8bf92,i=4096"))
+	c.Assert(err, IsNil)
+
+	keys, ok := client.(sasl.SaltedKeysSession).SaltedKeys()
+	c.Assert(ok, Equals, true)
+	c.Assert(keys.Iterations, Equals, 4096)
+	c.Assert(string(sasl.Token(keys.Salt).Encode()), Equals, "QSXCR+Q6sek8bf92")
+	c.Assert(keys.ClientKey, HasLen, sha1.Size)
+	c.Assert(keys.ServerKey, HasLen, sha1.Size)
+}
+
+func (s *ScramSuite) TestScramWithRFC5802TestVector_authenticatesWithSaltedKeys(c *C) {
+	mech := &scramMechanism{sha1.New, sha1.Size, false, false}
+	withPassword := mech.NewClient()
+	_ = withPassword.SetProperty(sasl.AuthID, "user")
+	_ = withPassword.SetProperty(sasl.Password, "pencil")
+	_ = withPassword.SetProperty(sasl.ClientNonce, "fyko+d2lbbFgONRv9qkxdawL")
+	_, _ = withPassword.Step(nil)
+	_, _ = withPassword.Step(sasl.Token("r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,s=QSXCR+Q6sek8bf92,i=4096"))
+	keys, _ := withPassword.(sasl.SaltedKeysSession).SaltedKeys()
+
+	client := mech.NewClient()
+	_ = client.SetProperty(sasl.AuthID, "user")
+	_ = client.SetProperty(sasl.ClientNonce, "fyko+d2lbbFgONRv9qkxdawL")
+	client.(sasl.SaltedKeysSession).SetSaltedKeys(keys)
+
+	_, err := client.Step(nil)
+	c.Check(err, IsNil)
+
+	t, err := client.Step(sasl.Token("r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,s=QSXCR+Q6sek8bf92,i=4096"))
+	c.Check(err, IsNil)
+	c.Check(t, DeepEquals, sasl.Token(`c=biws,r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,p=v0X8v3Bz2T0CJGbJQyF0X+HI4Ts=`))
+
+	t, err = client.Step(sasl.Token("v=rmF9pqV8S7suAoZWja4dJRkFsKQ="))
+	c.Check(err, IsNil)
+	c.Check(client.NeedsMore(), Equals, false)
+	c.Check(t, IsNil)
+}
+
+func (s *ScramSuite) TestScram_failsWithSaltedKeysForAnotherSalt(c *C) {
+	mech := &scramMechanism{sha1.New, sha1.Size, false, false}
+	client := mech.NewClient()
+	_ = client.SetProperty(sasl.AuthID, "user")
+	_ = client.SetProperty(sasl.ClientNonce, "fyko+d2lbbFgONRv9qkxdawL")
+	client.(sasl.SaltedKeysSession).SetSaltedKeys(sasl.SaltedKeys{
+		Salt:       []byte("another salt"),
+		Iterations: 4096,
+		ClientKey:  make([]byte, sha1.Size),
+		ServerKey:  make([]byte, sha1.Size),
+	})
+
+	_, _ = client.Step(nil)
+	_, err := client.Step(sasl.Token("r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,s=QSXCR+Q6sek8bf92,i=4096"))
+	c.Assert(err, Equals, ErrSaltedKeysOutdated)
+}
+
+func (s *ScramSuite) TestScram_failsWithSaltedKeysForAnotherIterationCount(c *C) {
+	mech := &scramMechanism{sha1.New, sha1.Size, false, false}
+	client := mech.NewClient()
+	_ = client.SetProperty(sasl.AuthID, "user")
+	_ = client.SetProperty(sasl.ClientNonce, "fyko+d2lbbFgONRv9qkxdawL")
+	salt, _ := sasl.DecodeToken([]byte("QSXCR+Q6sek8bf92"))
+	client.(sasl.SaltedKeysSession).SetSaltedKeys(sasl.SaltedKeys{
+		Salt:       salt,
+		Iterations: 10000,
+		ClientKey:  make([]byte, sha1.Size),
+		ServerKey:  make([]byte, sha1.Size),
+	})
+
+	_, _ = client.Step(nil)
+	_, err := client.Step(sasl.Token("r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,s=QSXCR+Q6sek8bf92,i=4096"))
+	c.Assert(err, Equals, ErrSaltedKeysOutdated)
+}
+
+func (s *ScramSuite) TestScram_SaltedKeys_returnsFalseWithoutKeys(c *C) {
+	mech := &scramMechanism{sha1.New, sha1.Size, false, false}
+	client := mech.NewClient()
+
+	_, ok := client.(sasl.SaltedKeysSession).SaltedKeys()
+	c.Assert(ok, Equals, false)
+}
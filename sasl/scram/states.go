@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"crypto/hmac"
 	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"hash"
@@ -95,8 +96,7 @@ func (c expectingServerFirstMessage) next(serverMessage sasl.Token, props sasl.P
 		return c, nil, err
 	}
 
-	password, ok := props[sasl.Password]
-	if !ok {
+	if !hasCredentials(props) {
 		return c, nil, sasl.PropertyMissingError{Property: sasl.Password}
 	}
 
@@ -109,11 +109,68 @@ func (c expectingServerFirstMessage) next(serverMessage sasl.Token, props sasl.P
 		return c, nil, errors.New("nonce mismatch")
 	}
 
+	clientKey, serverKey, err := c.keys(props, saltToken, numIterations)
+	if err != nil {
+		return c, nil, err
+	}
+
 	finalMessageBare := []byte(fmt.Sprintf("c=%s,r=%s", c.calculateChannelBinding(channelBinding), serverNonce))
+
+	return c.compose(clientKey, serverKey, finalMessageBare, serverMessage)
+}
+
+// keys derives the client and server keys from the password if we have it, and saves them
+// in the properties. Otherwise, it will use the keys previously saved in the properties.
+func (c expectingServerFirstMessage) keys(props sasl.Properties, salt []byte, iterations int) (clientKey, serverKey []byte, err error) {
+	password, ok := props[sasl.Password]
+	if !ok {
+		return c.storedKeys(props, salt, iterations)
+	}
+
 	normPass, _ := c.normalizedPassword(password)
-	saltedPassword := pbkdf2.Key([]byte(normPass), saltToken, numIterations, c.hashSize, c.hash)
+	saltedPassword := pbkdf2.Key([]byte(normPass), salt, iterations, c.hashSize, c.hash)
+
+	clientKey = c.hmac(saltedPassword, []byte("Client Key"))
+	serverKey = c.hmac(saltedPassword, []byte("Server Key"))
 
-	return c.compose(saltedPassword, finalMessageBare, serverMessage)
+	props[sasl.ScramSalt] = string(sasl.Token(salt).Encode())
+	props[sasl.ScramIter] = strconv.Itoa(iterations)
+	props[sasl.ScramClientKey] = hex.EncodeToString(clientKey)
+	props[sasl.ScramServerKey] = hex.EncodeToString(serverKey)
+
+	return clientKey, serverKey, nil
+}
+
+func hasCredentials(props sasl.Properties) bool {
+	if _, ok := props[sasl.Password]; ok {
+		return true
+	}
+
+	_, ok := saltedKeysFrom(props)
+	return ok
+}
+
+func (c expectingServerFirstMessage) storedKeys(props sasl.Properties, salt []byte, iterations int) (clientKey, serverKey []byte, err error) {
+	keys, ok := saltedKeysFrom(props)
+	if !ok {
+		return nil, nil, sasl.PropertyMissingError{Property: sasl.Password}
+	}
+
+	if !bytes.Equal(keys.Salt, salt) || keys.Iterations != iterations {
+		return nil, nil, ErrSaltedKeysOutdated
+	}
+
+	if len(keys.ClientKey) != c.hashSize || len(keys.ServerKey) != c.hashSize {
+		return nil, nil, errors.New("salted keys have the wrong size")
+	}
+
+	return keys.ClientKey, keys.ServerKey, nil
+}
+
+func (c expectingServerFirstMessage) hmac(key, message []byte) []byte {
+	mac := hmac.New(c.hash, key)
+	_, _ = mac.Write(message)
+	return mac.Sum(nil)
 }
 
 func calculateChannelBindingPrefix(plus, support bool) string {
@@ -139,18 +196,11 @@ func (c expectingServerFirstMessage) calculateChannelBinding(v []byte) string {
 	return string(sasl.Token(result).Encode())
 }
 
-func (c expectingServerFirstMessage) compose(saltedPassword, finalMessageBare, serverFirstMessage []byte) (state, sasl.Token, error) {
-	clientMAC := hmac.New(c.hash, saltedPassword)
-	_, _ = clientMAC.Write([]byte("Client Key"))
-	clientKey := clientMAC.Sum(nil)
+func (c expectingServerFirstMessage) compose(clientKey, serverKey, finalMessageBare, serverFirstMessage []byte) (state, sasl.Token, error) {
 	storedKeyHash := c.hash()
 	_, _ = storedKeyHash.Write(clientKey)
 	storedKey := storedKeyHash.Sum(nil)
 
-	serverMAC := hmac.New(c.hash, saltedPassword)
-	_, _ = serverMAC.Write([]byte("Server Key"))
-	serverKey := serverMAC.Sum(nil)
-
 	authMessage := bytes.Join([][]byte{
 		c.firstMessageBare,
 		serverFirstMessage,
@@ -0,0 +1,46 @@
+package sasl
+
+import (
+	"errors"
+	"strings"
+)
+
+// Verifier represents something that can decide whether a SASL mechanism is acceptable
+// to authenticate with. The channel binding is the type of channel binding that was
+// available on the connection, or the empty string if none was available.
+// MechanismSucceeded is only called once authenticating with the mechanism has succeeded,
+// so a server can't make us remember a mechanism it can't complete.
+type Verifier interface {
+	VerifyMechanism(mechanism, channelBinding string) error
+	MechanismSucceeded(mechanism, channelBinding string)
+}
+
+// ErrMechanismDowngrade is returned when the server only offers mechanisms weaker than
+// the strongest one we have seen for it before, and the downgrade was not accepted
+var ErrMechanismDowngrade = errors.New("sasl: the server offered a weaker authentication mechanism than before")
+
+const channelBindingSuffix = "-PLUS"
+
+var mechanismStrengths = map[string]int{
+	"PLAIN":         1,
+	"DIGEST-MD5":    2,
+	"SCRAM-SHA-1":   3,
+	"SCRAM-SHA-256": 4,
+	"SCRAM-SHA-512": 5,
+}
+
+// BaseMechanism returns the name of the given mechanism without its channel binding variant
+func BaseMechanism(mechanism string) string {
+	return strings.TrimSuffix(mechanism, channelBindingSuffix)
+}
+
+// MechanismStrength returns the relative strength of the given mechanism, ignoring channel binding.
+// Unknown mechanisms have a strength of zero.
+func MechanismStrength(mechanism string) int {
+	return mechanismStrengths[BaseMechanism(mechanism)]
+}
+
+// UsesChannelBinding returns true if the given mechanism is a channel binding variant
+func UsesChannelBinding(mechanism string) bool {
+	return strings.HasSuffix(mechanism, channelBindingSuffix)
+}
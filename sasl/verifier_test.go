@@ -0,0 +1,34 @@
+package sasl
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type MechanismStrengthSuite struct{}
+
+var _ = Suite(&MechanismStrengthSuite{})
+
+func (s *MechanismStrengthSuite) Test_MechanismStrength_ordersKnownMechanisms(c *C) {
+	c.Assert(MechanismStrength("PLAIN") < MechanismStrength("DIGEST-MD5"), Equals, true)
+	c.Assert(MechanismStrength("DIGEST-MD5") < MechanismStrength("SCRAM-SHA-1"), Equals, true)
+	c.Assert(MechanismStrength("SCRAM-SHA-1") < MechanismStrength("SCRAM-SHA-256"), Equals, true)
+	c.Assert(MechanismStrength("SCRAM-SHA-256") < MechanismStrength("SCRAM-SHA-512"), Equals, true)
+}
+
+func (s *MechanismStrengthSuite) Test_MechanismStrength_ignoresChannelBinding(c *C) {
+	c.Assert(MechanismStrength("SCRAM-SHA-256-PLUS"), Equals, MechanismStrength("SCRAM-SHA-256"))
+}
+
+func (s *MechanismStrengthSuite) Test_MechanismStrength_returnsZeroForUnknownMechanisms(c *C) {
+	c.Assert(MechanismStrength("X-OAUTH2"), Equals, 0)
+}
+
+func (s *MechanismStrengthSuite) Test_UsesChannelBinding(c *C) {
+	c.Assert(UsesChannelBinding("SCRAM-SHA-1-PLUS"), Equals, true)
+	c.Assert(UsesChannelBinding("SCRAM-SHA-1"), Equals, false)
+}
+
+func (s *MechanismStrengthSuite) Test_BaseMechanism(c *C) {
+	c.Assert(BaseMechanism("SCRAM-SHA-1-PLUS"), Equals, "SCRAM-SHA-1")
+	c.Assert(BaseMechanism("PLAIN"), Equals, "PLAIN")
+}
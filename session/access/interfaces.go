@@ -8,7 +8,9 @@ import (
 	"github.com/chadsec1/decoyim/decoylog"
 	"github.com/chadsec1/decoyim/otrclient"
 	"github.com/chadsec1/decoyim/roster"
+	"github.com/chadsec1/decoyim/sasl"
 	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/history"
 	"github.com/chadsec1/decoyim/session/muc"
 	mdata "github.com/chadsec1/decoyim/session/muc/data"
 	"github.com/chadsec1/decoyim/tls"
@@ -84,8 +86,12 @@ type Connection interface {
 	Close()
 	IsConnected() bool
 	IsDisconnected() bool
-	Connect(string, tls.Verifier) error
+	Connect(string, tls.Verifier, sasl.Verifier) error
 	SetConnector(Connector)
+	ReconnectNow()
+	StopReconnecting()
+	NetworkUp()
+	IsReconnecting() bool
 }
 
 // Subscription contains functionality related to subscriptions
@@ -127,6 +133,28 @@ type Sending interface {
 	SendPing()
 }
 
+// Outbox gives access to the messages waiting to be sent until we are connected again
+type Outbox interface {
+	QueueMessage(jid.Any, string) (sdata.QueuedMessage, error)
+	QueuedMessagesFor(jid.Any) []sdata.QueuedMessage
+	CancelQueuedMessage(string) bool
+}
+
+// ConversationHistory gives access to the stored history of conversations with peers and rooms
+type ConversationHistory interface {
+	HistoryWith(jid.Any, bool) ([]history.Message, error)
+	HistoryConversations() ([]history.Conversation, error)
+	ForgetHistoryWith(jid.Any, bool) error
+	SearchHistory(history.Query) ([]history.Match, error)
+	ExportHistory(jid.Any, bool, time.Time, time.Time) (*history.Export, error)
+}
+
+// ServerData gives access to what the server keeps for the account, to move it to another server
+type ServerData interface {
+	ExportServerData() (*data.ServerData, error)
+	ImportServerData(*data.ServerDataUser, string) (*sdata.ServerDataImport, error)
+}
+
 // ConnectionData gives access to information about the connection and session
 type ConnectionData interface {
 	DisplayName() string
@@ -167,6 +195,9 @@ type Session interface {
 	EncryptedChat
 	Roster
 	Sending
+	Outbox
+	ConversationHistory
+	ServerData
 	ConnectionData
 	Logging
 	Events
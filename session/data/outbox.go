@@ -0,0 +1,13 @@
+package data
+
+import "time"
+
+// QueuedMessage is a message waiting in the outbox until we are connected again
+type QueuedMessage struct {
+	ID     string
+	Peer   string
+	Body   string
+	Queued time.Time
+	// Encrypted is true if the message can only be sent in an encrypted conversation
+	Encrypted bool
+}
@@ -0,0 +1,13 @@
+package data
+
+// ServerDataImport tells what was replayed into an account from an XEP-0227 file
+type ServerDataImport struct {
+	Contacts int
+	// SubscriptionsRequested is the number of contacts we asked to see the presence of again
+	SubscriptionsRequested int
+	VCard                  bool
+	PrivateElements        int
+	PubSubItems            int
+	// Failed is the number of requests the server refused
+	Failed int
+}
@@ -28,6 +28,34 @@ const (
 	PongReceived
 )
 
+// Reconnect tells about the automatic attempts to connect again
+type Reconnect struct {
+	// Attempt is the number of the next attempt, starting from one
+	Attempt     int
+	NextAttempt time.Time
+	// Stopped is true if we don't try to connect again automatically
+	Stopped   bool
+	LastError error
+}
+
+// Outbox tells about changes to the messages waiting to be sent
+type Outbox struct {
+	Type    OutboxType
+	Message sdata.QueuedMessage
+	// Tracer is the tracer of the sent message, if it was sent in an encrypted conversation
+	Tracer int
+}
+
+// OutboxType represents the type of Outbox event
+type OutboxType int
+
+// Outbox event types
+const (
+	MessageQueued OutboxType = iota
+	QueuedMessageSent
+	QueuedMessageCancelled
+)
+
 // Peer represents an event associated to a peer
 type Peer struct {
 	Type PeerType
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/chadsec1/decoyim/config"
+	ournet "github.com/chadsec1/decoyim/net"
 	"github.com/chadsec1/decoyim/xmpp/data"
 	"github.com/chadsec1/decoyim/xmpp/socks5"
 	"golang.org/x/net/proxy"
@@ -30,7 +31,7 @@ func tryStreamhost(s hasConfigAndLog, sh data.BytestreamStreamhost, dstAddr stri
 	}
 
 	if p == nil {
-		p = proxy.Direct
+		p = ournet.Dialers.Direct(false)
 	}
 
 	dialer, e := socks5XMPP("tcp", net.JoinHostPort(sh.Host, strconv.Itoa(port)), nil, p)
@@ -0,0 +1,302 @@
+package session
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/otrclient"
+	"github.com/chadsec1/decoyim/session/history"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+)
+
+const historyDirectory = "history"
+
+// historyStores keeps one store for each history directory, so that all accounts share the same search index
+var historyStores = struct {
+	sync.Mutex
+	stores map[string]*history.Store
+}{stores: make(map[string]*history.Store)}
+
+func init() {
+	config.RegisterDataDir(historyDirectory, moveHistoryStore)
+}
+
+// moveHistoryStore moves a history directory, together with the store that keeps it if there is one,
+// so the sessions using the store keep the history files in the new place
+func moveHistoryStore(from, to string) error {
+	historyStores.Lock()
+	defer historyStores.Unlock()
+
+	st, ok := historyStores.stores[from]
+	if !ok {
+		return os.Rename(from, to)
+	}
+
+	if err := st.Move(to); err != nil {
+		return err
+	}
+	delete(historyStores.stores, from)
+	historyStores.stores[to] = st
+	return nil
+}
+
+func openHistoryStore(dir string, key []byte) (*history.Store, error) {
+	historyStores.Lock()
+	defer historyStores.Unlock()
+
+	if st, ok := historyStores.stores[dir]; ok {
+		return st, nil
+	}
+
+	st, err := history.Open(dir, key)
+	if err == nil {
+		historyStores.stores[dir] = st
+	}
+	return st, err
+}
+
+// OpenHistory returns the store that keeps the history of conversations for the accounts in the given
+// configuration. It's the same store the sessions of those accounts use. The configuration file has to
+// be encrypted, since the key of the history is derived from a secret that is only kept in the encrypted file.
+func OpenHistory(conf *config.ApplicationConfig) (*history.Store, error) {
+	key, err := conf.DeriveKey(historyDirectory)
+	if err != nil {
+		return nil, err
+	}
+	return openHistoryStore(conf.DataDir(historyDirectory), key)
+}
+
+// HasHistory returns true if there is a stored history of conversations for the accounts in the given configuration
+func HasHistory(conf *config.ApplicationConfig) bool {
+	_, err := os.Stat(conf.DataDir(historyDirectory))
+	return err == nil
+}
+
+// DeleteHistory removes the stored history of conversations of all the accounts in the given configuration.
+// The key of the history is derived from a secret that is only kept in the encrypted configuration file, so the history has to be removed when the
+// configuration file stops being encrypted - nothing could read it anymore.
+func DeleteHistory(conf *config.ApplicationConfig) error {
+	dir := conf.DataDir(historyDirectory)
+
+	historyStores.Lock()
+	defer historyStores.Unlock()
+
+	if st, ok := historyStores.stores[dir]; ok {
+		delete(historyStores.stores, dir)
+		return st.RemoveAll()
+	}
+	return os.RemoveAll(dir)
+}
+
+// conversationHistory returns the store for the history of this account, or nil if the account
+// doesn't keep history. Opening the store requires the configuration file to be encrypted, since
+// the key of the history is derived from a secret that is only kept in the encrypted file.
+func (s *session) conversationHistory() (*history.Store, error) {
+	a := s.GetConfig()
+	if a == nil || !a.KeepHistory || s.config == nil {
+		return nil, nil
+	}
+
+	s.historyLock.Lock()
+	defer s.historyLock.Unlock()
+
+	// The history is opened again if it was removed, or if the configuration file has been encrypted since
+	if s.history != nil && s.history.IsRemoved() {
+		s.history = nil
+	}
+	if s.historyErr == config.ErrConfigurationNotEncrypted && s.config.HasEncryptedStorage() {
+		s.historyErr = nil
+	}
+
+	if s.history == nil && s.historyErr == nil {
+		var err error
+		s.history, err = OpenHistory(s.config)
+		if err != nil {
+			s.historyErr = err
+			s.log.WithError(err).Warn("can't keep the history of conversations")
+		}
+	}
+
+	return s.history, s.historyErr
+}
+
+func (s *session) historyConversation(peer jid.Any, room bool) history.Conversation {
+	return history.Conversation{
+		Account: s.GetConfig().Account,
+		Peer:    peer.NoResource().String(),
+		Room:    room,
+	}
+}
+
+func (s *session) historyRetentionFor(peer string) history.Retention {
+	maxMessages, maxAgeDays := s.GetConfig().HistoryRetentionFor(peer)
+	return history.Retention{
+		MaxMessages: maxMessages,
+		MaxAge:      time.Duration(maxAgeDays) * 24 * time.Hour,
+	}
+}
+
+// recordHistory adds the message created by the given function to the history. The function is only
+// called if the account keeps history, so that nothing about the conversation is inspected otherwise.
+func (s *session) recordHistory(peer jid.Any, room bool, message func() history.Message) {
+	st, _ := s.conversationHistory()
+	if st == nil {
+		return
+	}
+
+	if err := st.Append(s.historyConversation(peer, room), message()); err != nil {
+		s.log.WithError(err).Warn("couldn't add the message to the history")
+	}
+}
+
+// isVerifiedConversation returns true if the conversation is encrypted with a fingerprint we trust
+func (s *session) isVerifiedConversation(peer jid.Any, c otrclient.Conversation) bool {
+	if c == nil || !c.IsEncrypted() {
+		return false
+	}
+
+	p, ok := s.GetConfig().GetPeer(peer.NoResource().String())
+	if !ok {
+		return false
+	}
+
+	trusted, _ := p.HasTrustedFingerprint(c.TheirFingerprint())
+	return trusted
+}
+
+func theirFingerprint(c otrclient.Conversation) []byte {
+	if c == nil || !c.IsEncrypted() {
+		return nil
+	}
+	return c.TheirFingerprint()
+}
+
+func (s *session) recordIncomingMessage(peer jid.Any, timestamp time.Time, c otrclient.Conversation, message []byte) {
+	s.recordHistory(peer, false, func() history.Message {
+		return history.Message{
+			Time:        timestamp,
+			From:        peer.NoResource().String(),
+			Body:        string(message),
+			Encrypted:   c != nil && c.IsEncrypted(),
+			Verified:    s.isVerifiedConversation(peer, c),
+			Fingerprint: theirFingerprint(c),
+		}
+	})
+}
+
+func (s *session) recordOutgoingMessage(peer jid.Any, c otrclient.Conversation, message string) {
+	s.recordHistory(peer, false, func() history.Message {
+		return history.Message{
+			Time:        time.Now(),
+			From:        s.GetConfig().Account,
+			Outgoing:    true,
+			Body:        message,
+			Encrypted:   c != nil && c.IsEncrypted(),
+			Verified:    s.isVerifiedConversation(peer, c),
+			Fingerprint: theirFingerprint(c),
+		}
+	})
+}
+
+func (s *session) recordRoomMessage(roomID jid.Bare, nickname, message string, timestamp time.Time) {
+	s.recordHistory(roomID, true, func() history.Message {
+		outgoing := false
+		if room, ok := s.muc.getRoom(roomID); ok {
+			outgoing = room.SelfOccupantNickname() == nickname
+		}
+
+		return history.Message{
+			Time:     timestamp,
+			From:     nickname,
+			Outgoing: outgoing,
+			Body:     message,
+		}
+	})
+}
+
+// applyHistoryRetention drops the messages that fall outside of the retention limits, in all conversations of this account
+func (s *session) applyHistoryRetention() {
+	st, _ := s.conversationHistory()
+	if st == nil {
+		return
+	}
+
+	cs, err := st.Conversations(s.GetConfig().Account)
+	if err != nil {
+		s.log.WithError(err).Warn("couldn't list the history of conversations")
+		return
+	}
+
+	now := time.Now()
+	for _, c := range cs {
+		if err := st.ApplyRetention(c, s.historyRetentionFor(c.Peer), now); err != nil {
+			s.log.WithError(err).WithField("peer", c.Peer).Warn("couldn't apply the retention limits to the history")
+		}
+	}
+}
+
+// HistoryWith returns the stored messages of the conversation with the given peer or room.
+// If the account doesn't keep history, no messages are returned.
+func (s *session) HistoryWith(peer jid.Any, room bool) ([]history.Message, error) {
+	st, err := s.conversationHistory()
+	if st == nil {
+		return nil, err
+	}
+
+	c := s.historyConversation(peer, room)
+	if err := st.ApplyRetention(c, s.historyRetentionFor(c.Peer), time.Now()); err != nil {
+		s.log.WithError(err).WithField("peer", c.Peer).Warn("couldn't apply the retention limits to the history")
+	}
+
+	return st.Messages(c)
+}
+
+// HistoryConversations returns the peers and rooms this account has a stored history with
+func (s *session) HistoryConversations() ([]history.Conversation, error) {
+	st, err := s.conversationHistory()
+	if st == nil {
+		return nil, err
+	}
+
+	return st.Conversations(s.GetConfig().Account)
+}
+
+// ForgetHistoryWith removes the stored history of the conversation with the given peer or room
+func (s *session) ForgetHistoryWith(peer jid.Any, room bool) error {
+	st, err := s.conversationHistory()
+	if st == nil {
+		return err
+	}
+
+	return st.Remove(s.historyConversation(peer, room))
+}
+
+// SearchHistory returns the messages in the history of this account that match the query, newest first
+func (s *session) SearchHistory(q history.Query) ([]history.Match, error) {
+	st, err := s.conversationHistory()
+	if st == nil {
+		return nil, err
+	}
+
+	q.Account = s.GetConfig().Account
+	return st.Search(q)
+}
+
+// ExportHistory returns the stored messages of the conversation with the given peer or room, in the given
+// period of time, ready to be written to a file. Zero values mean there is no limit.
+func (s *session) ExportHistory(peer jid.Any, room bool, since, until time.Time) (*history.Export, error) {
+	ms, err := s.HistoryWith(peer, room)
+	if err != nil {
+		return nil, err
+	}
+
+	return &history.Export{
+		Conversation: s.historyConversation(peer, room),
+		Since:        since,
+		Until:        until,
+		Exported:     time.Now(),
+		Messages:     history.Between(ms, since, until),
+	}, nil
+}
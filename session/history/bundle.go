@@ -0,0 +1,54 @@
+package history
+
+import "encoding/json"
+
+// bundledConversation is the history of one conversation, as it's kept in an account bundle
+type bundledConversation struct {
+	Peer     string
+	Room     bool `json:",omitempty"`
+	Messages []Message
+}
+
+// ExportAccount returns the history of all conversations of the account, to be put in an account bundle.
+// It returns nil if the account has no history.
+func (s *Store) ExportAccount(account string) (json.RawMessage, error) {
+	cs, err := s.Conversations(account)
+	if err != nil || len(cs) == 0 {
+		return nil, err
+	}
+
+	var result []bundledConversation
+	for _, c := range cs {
+		ms, err := s.Messages(c)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bundledConversation{Peer: c.Peer, Room: c.Room, Messages: ms})
+	}
+
+	return json.Marshal(result)
+}
+
+// ImportAccount merges the history from an account bundle into the history of the account, in the same way
+// Import does. It returns the number of messages that were added.
+func (s *Store) ImportAccount(account string, data json.RawMessage) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+
+	var cs []bundledConversation
+	if err := json.Unmarshal(data, &cs); err != nil {
+		return 0, err
+	}
+
+	added := 0
+	for _, bc := range cs {
+		n, err := s.Import(Conversation{Account: account, Peer: bc.Peer, Room: bc.Room}, bc.Messages)
+		if err != nil {
+			return added, err
+		}
+		added += n
+	}
+
+	return added, nil
+}
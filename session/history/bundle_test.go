@@ -0,0 +1,52 @@
+package history
+
+import (
+	"bytes"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type BundleSuite struct{}
+
+var _ = Suite(&BundleSuite{})
+
+func (s *BundleSuite) Test_Store_ExportAccount_and_ImportAccount_moveTheHistoryToAnotherStore(c *C) {
+	st, _ := openTestStore(c)
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	c.Assert(st.Append(aliceConv, Message{Time: t1, From: "alice@example.org", Body: "hi", Encrypted: true, Fingerprint: []byte{1, 2}}), IsNil)
+	c.Assert(st.Append(roomConv, Message{Time: t1, From: "bob", Body: "hello room"}), IsNil)
+	c.Assert(st.Append(Conversation{Account: "other@example.org", Peer: "alice@example.org"}, Message{Time: t1, Body: "not mine"}), IsNil)
+
+	data, err := st.ExportAccount("me@example.org")
+	c.Assert(err, IsNil)
+
+	other, err := Open(filepath.Join(c.MkDir(), "history"), bytes.Repeat([]byte{0x17}, 32))
+	c.Assert(err, IsNil)
+	c.Assert(other.Append(aliceConv, Message{Time: t1, From: "alice@example.org", Body: "hi"}), IsNil)
+
+	added, err := other.ImportAccount("me@example.org", data)
+	c.Assert(err, IsNil)
+	c.Assert(added, Equals, 1)
+
+	cs, _ := other.Conversations("")
+	c.Assert(cs, DeepEquals, []Conversation{aliceConv, roomConv})
+
+	ms, _ := other.Messages(roomConv)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].Body, Equals, "hello room")
+}
+
+func (s *BundleSuite) Test_Store_ExportAccount_returnsNothingWithoutHistory(c *C) {
+	st, _ := openTestStore(c)
+
+	data, err := st.ExportAccount("me@example.org")
+	c.Assert(err, IsNil)
+	c.Assert(data, IsNil)
+
+	added, err := st.ImportAccount("me@example.org", data)
+	c.Assert(err, IsNil)
+	c.Assert(added, Equals, 0)
+}
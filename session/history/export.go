@@ -0,0 +1,272 @@
+package history
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/digests"
+)
+
+// Format is a file format the history of a conversation can be exported to
+type Format string
+
+const (
+	// FormatHTML is a self-contained HTML page, meant to be read in a browser
+	FormatHTML Format = "html"
+	// FormatJSON is meant to be read by other programs
+	FormatJSON Format = "json"
+	// FormatText is plain text
+	FormatText Format = "txt"
+)
+
+// ErrUnknownFormat is returned when exporting to a format that doesn't exist
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// ManifestExtension is added to the name of an exported file to get the name of its manifest
+const ManifestExtension = ".sha256"
+
+const exportTimeFormat = "2006-01-02 15:04:05 MST"
+
+// Extension returns the file name extension for the format, including the dot
+func (f Format) Extension() string {
+	return "." + string(f)
+}
+
+// Export is the history of a conversation over a period of time, ready to be written to a file
+type Export struct {
+	Conversation Conversation
+	// Since and Until are the period of time that was asked for. Zero values mean there is no limit.
+	Since    time.Time
+	Until    time.Time
+	Exported time.Time
+	Messages []Message
+}
+
+// Between returns the messages sent or received in the given period of time. Zero values mean there is no limit.
+func Between(ms []Message, since, until time.Time) []Message {
+	result := []Message{}
+	for _, m := range ms {
+		if (since.IsZero() || !m.Time.Before(since)) && (until.IsZero() || !m.Time.After(until)) {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// exportedMessage is the representation of a message in the JSON export. It uses explicit
+// names, since other programs will depend on them.
+type exportedMessage struct {
+	Time        time.Time `json:"time"`
+	From        string    `json:"from"`
+	Outgoing    bool      `json:"outgoing"`
+	Body        string    `json:"body"`
+	Encrypted   bool      `json:"encrypted"`
+	Verified    bool      `json:"verified"`
+	Fingerprint string    `json:"fingerprint,omitempty"`
+}
+
+type exportedConversation struct {
+	Account  string            `json:"account"`
+	Peer     string            `json:"peer"`
+	Room     bool              `json:"room"`
+	Since    *time.Time        `json:"since,omitempty"`
+	Until    *time.Time        `json:"until,omitempty"`
+	Exported time.Time         `json:"exported"`
+	Messages []exportedMessage `json:"messages"`
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func (e *Export) writeJSON(w io.Writer) error {
+	ec := exportedConversation{
+		Account:  e.Conversation.Account,
+		Peer:     e.Conversation.Peer,
+		Room:     e.Conversation.Room,
+		Since:    optionalTime(e.Since),
+		Until:    optionalTime(e.Until),
+		Exported: e.Exported,
+		Messages: []exportedMessage{},
+	}
+
+	for _, m := range e.Messages {
+		ec.Messages = append(ec.Messages, exportedMessage{
+			Time:        m.Time,
+			From:        m.From,
+			Outgoing:    m.Outgoing,
+			Body:        m.Body,
+			Encrypted:   m.Encrypted,
+			Verified:    m.Verified,
+			Fingerprint: hex.EncodeToString(m.Fingerprint),
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ec)
+}
+
+// securityOf describes the encryption and verification state of a message
+func securityOf(m Message) string {
+	switch {
+	case m.Encrypted && m.Verified:
+		return "encrypted, verified"
+	case m.Encrypted:
+		return "encrypted, not verified"
+	default:
+		return "not encrypted"
+	}
+}
+
+func (e *Export) description() string {
+	kind := "Conversation between %s and %s"
+	if e.Conversation.Room {
+		kind = "Messages seen by %s in the room %s"
+	}
+	return fmt.Sprintf(kind, e.Conversation.Account, e.Conversation.Peer)
+}
+
+func (e *Export) period() string {
+	switch {
+	case e.Since.IsZero() && e.Until.IsZero():
+		return "all messages"
+	case e.Since.IsZero():
+		return "until " + e.Until.Format(exportTimeFormat)
+	case e.Until.IsZero():
+		return "since " + e.Since.Format(exportTimeFormat)
+	default:
+		return e.Since.Format(exportTimeFormat) + " to " + e.Until.Format(exportTimeFormat)
+	}
+}
+
+func (e *Export) writeText(w io.Writer) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s\n", e.description())
+	fmt.Fprintf(&b, "Period: %s\n", e.period())
+	fmt.Fprintf(&b, "Exported: %s\n\n", e.Exported.Format(exportTimeFormat))
+
+	for _, m := range e.Messages {
+		fmt.Fprintf(&b, "[%s] %s (%s", m.Time.Format(exportTimeFormat), m.From, securityOf(m))
+		if len(m.Fingerprint) > 0 {
+			fmt.Fprintf(&b, ", fingerprint %s", config.FormatFingerprint(m.Fingerprint))
+		}
+		fmt.Fprintf(&b, "):\n")
+		for _, line := range strings.Split(m.Body, "\n") {
+			fmt.Fprintf(&b, "    %s\n", line)
+		}
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+var htmlExportTemplate = template.Must(template.New("export").Funcs(template.FuncMap{
+	"time":        func(t time.Time) string { return t.Format(exportTimeFormat) },
+	"security":    securityOf,
+	"fingerprint": config.FormatFingerprint,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Description}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; vertical-align: top; padding: 0.3em 0.6em; border-bottom: 1px solid #ddd; }
+td.body { white-space: pre-wrap; }
+tr.outgoing td.from { color: #2a5d9f; }
+tr.incoming td.from { color: #a4372c; }
+.security, .fingerprint { font-size: 0.85em; color: #555; }
+.fingerprint { font-family: monospace; }
+</style>
+</head>
+<body>
+<h1>{{.Description}}</h1>
+<p>Period: {{.Period}}<br>Exported: {{time .Export.Exported}}</p>
+<table>
+<tr><th>Time</th><th>From</th><th>Message</th><th>Security</th></tr>
+{{- range .Export.Messages}}
+<tr class="{{if .Outgoing}}outgoing{{else}}incoming{{end}}">
+<td>{{time .Time}}</td>
+<td class="from">{{.From}}</td>
+<td class="body">{{.Body}}</td>
+<td><span class="security">{{security .}}</span>{{if .Fingerprint}}<br><span class="fingerprint">{{fingerprint .Fingerprint}}</span>{{end}}</td>
+</tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+func (e *Export) writeHTML(w io.Writer) error {
+	return htmlExportTemplate.Execute(w, struct {
+		Description string
+		Period      string
+		Export      *Export
+	}{e.description(), e.period(), e})
+}
+
+// Write writes the export in the given format
+func (e *Export) Write(w io.Writer, f Format) error {
+	switch f {
+	case FormatHTML:
+		return e.writeHTML(w)
+	case FormatJSON:
+		return e.writeJSON(w)
+	case FormatText:
+		return e.writeText(w)
+	}
+	return ErrUnknownFormat
+}
+
+// Manifest returns a detached manifest with the SHA-256 digest of the given file content,
+// in the format used by sha256sum, so it can be checked with "sha256sum -c"
+func Manifest(fileName string, content []byte) []byte {
+	return []byte(hex.EncodeToString(digests.Sha256(content)) + "  " + fileName + "\n")
+}
+
+// WriteFile writes the export to the given file, readable only by the current user. If asked for, it
+// also writes a manifest next to it, with the same name and ManifestExtension added.
+// The exported file is NOT encrypted - it's up to the caller to make sure the user knows that.
+func (e *Export) WriteFile(path string, f Format, withManifest bool) error {
+	var b bytes.Buffer
+	if err := e.Write(&b, f); err != nil {
+		return err
+	}
+
+	if err := writeExportFile(path, b.Bytes()); err != nil {
+		return err
+	}
+
+	if withManifest {
+		return writeExportFile(path+ManifestExtension, Manifest(filepath.Base(path), b.Bytes()))
+	}
+	return nil
+}
+
+func writeExportFile(path string, content []byte) error {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type ExportSuite struct{}
+
+var _ = Suite(&ExportSuite{})
+
+var exportStart = time.Date(2021, 5, 4, 10, 0, 0, 0, time.UTC)
+
+func testExport() *Export {
+	return &Export{
+		Conversation: aliceConv,
+		Exported:     exportStart.Add(24 * time.Hour),
+		Messages: []Message{
+			{Time: exportStart, From: "alice@example.org", Body: "hi <b>there</b>", Encrypted: true, Verified: true, Fingerprint: []byte{0x01, 0x02, 0x03, 0x04, 0xAA, 0xBB, 0xCC, 0xDD}},
+			{Time: exportStart.Add(time.Minute), From: "me@example.org", Outgoing: true, Body: "first line\nsecond line"},
+		},
+	}
+}
+
+func (s *ExportSuite) Test_Between_keepsTheMessagesInThePeriod(c *C) {
+	ms := []Message{
+		{Time: exportStart, Body: "one"},
+		{Time: exportStart.Add(time.Hour), Body: "two"},
+		{Time: exportStart.Add(2 * time.Hour), Body: "three"},
+	}
+
+	c.Assert(Between(ms, time.Time{}, time.Time{}), HasLen, 3)
+	c.Assert(Between(ms, exportStart.Add(time.Hour), time.Time{}), HasLen, 2)
+	c.Assert(Between(ms, time.Time{}, exportStart.Add(time.Hour)), HasLen, 2)
+
+	res := Between(ms, exportStart.Add(time.Minute), exportStart.Add(90*time.Minute))
+	c.Assert(res, HasLen, 1)
+	c.Assert(res[0].Body, Equals, "two")
+}
+
+func (s *ExportSuite) Test_Export_Write_failsForAnUnknownFormat(c *C) {
+	var b bytes.Buffer
+	c.Assert(testExport().Write(&b, Format("doc")), Equals, ErrUnknownFormat)
+}
+
+func (s *ExportSuite) Test_Export_Write_asJSON(c *C) {
+	var b bytes.Buffer
+	c.Assert(testExport().Write(&b, FormatJSON), IsNil)
+
+	var res exportedConversation
+	c.Assert(json.Unmarshal(b.Bytes(), &res), IsNil)
+	c.Assert(res.Account, Equals, "me@example.org")
+	c.Assert(res.Peer, Equals, "alice@example.org")
+	c.Assert(res.Since, IsNil)
+	c.Assert(res.Messages, HasLen, 2)
+	c.Assert(res.Messages[0].Encrypted, Equals, true)
+	c.Assert(res.Messages[0].Verified, Equals, true)
+	c.Assert(res.Messages[0].Fingerprint, Equals, "01020304aabbccdd")
+	c.Assert(res.Messages[1].Outgoing, Equals, true)
+	c.Assert(res.Messages[1].Fingerprint, Equals, "")
+	c.Assert(strings.Contains(b.String(), `"encrypted": false`), Equals, true)
+}
+
+func (s *ExportSuite) Test_Export_Write_asText(c *C) {
+	var b bytes.Buffer
+	c.Assert(testExport().Write(&b, FormatText), IsNil)
+
+	c.Assert(b.String(), Equals, ""+
+		"Conversation between me@example.org and alice@example.org\n"+
+		"Period: all messages\n"+
+		"Exported: 2021-05-05 10:00:00 UTC\n\n"+
+		"[2021-05-04 10:00:00 UTC] alice@example.org (encrypted, verified, fingerprint 01020304 AABBCCDD):\n"+
+		"    hi <b>there</b>\n"+
+		"[2021-05-04 10:01:00 UTC] me@example.org (not encrypted):\n"+
+		"    first line\n"+
+		"    second line\n")
+}
+
+func (s *ExportSuite) Test_Export_Write_asHTMLEscapesTheMessages(c *C) {
+	e := testExport()
+	e.Conversation = roomConv
+	e.Since = exportStart
+
+	var b bytes.Buffer
+	c.Assert(e.Write(&b, FormatHTML), IsNil)
+
+	out := b.String()
+	c.Assert(strings.HasPrefix(out, "<!DOCTYPE html>"), Equals, true)
+	c.Assert(strings.Contains(out, "hi &lt;b&gt;there&lt;/b&gt;"), Equals, true)
+	c.Assert(strings.Contains(out, "in the room room@conference.example.org"), Equals, true)
+	c.Assert(strings.Contains(out, "Period: since 2021-05-04 10:00:00 UTC"), Equals, true)
+	c.Assert(strings.Contains(out, "encrypted, verified"), Equals, true)
+	c.Assert(strings.Contains(out, "01020304 AABBCCDD"), Equals, true)
+	c.Assert(strings.Contains(out, "<script"), Equals, false)
+}
+
+func (s *ExportSuite) Test_Export_WriteFile_writesAManifest(c *C) {
+	dir := c.MkDir()
+	path := filepath.Join(dir, "alice.txt")
+
+	c.Assert(testExport().WriteFile(path, FormatText, true), IsNil)
+
+	content, err := ioutil.ReadFile(path)
+	c.Assert(err, IsNil)
+	st, _ := os.Stat(path)
+	c.Assert(st.Mode().Perm(), Equals, os.FileMode(0600))
+
+	manifest, err := ioutil.ReadFile(path + ManifestExtension)
+	c.Assert(err, IsNil)
+	c.Assert(string(manifest), Equals, string(Manifest("alice.txt", content)))
+	c.Assert(string(manifest), Matches, "[0-9a-f]{64}  alice.txt\n")
+}
+
+func (s *ExportSuite) Test_Export_WriteFile_withoutManifest(c *C) {
+	dir := c.MkDir()
+	path := filepath.Join(dir, "alice.json")
+
+	c.Assert(testExport().WriteFile(path, FormatJSON, false), IsNil)
+
+	_, err := os.Stat(path + ManifestExtension)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *ExportSuite) Test_Manifest_usesTheSHA256Digest(c *C) {
+	c.Assert(string(Manifest("f", []byte{0x01, 0x02, 0x03})), Equals, "039058c6f2c0cb492c533b0a4d14ef77cc0f78abccced5287d84a1a2011cfb81  f\n")
+}
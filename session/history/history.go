@@ -0,0 +1,587 @@
+// Package history implements an encrypted, append-only store for the messages of
+// conversations with peers and rooms.
+//
+// Every conversation lives in its own file. The name of the file is a keyed hash of the
+// account and the peer, so it doesn't reveal who the conversation was with. The file is a
+// sequence of records, where each record is a big endian 32 bit length followed by a random
+// nonce and the AES-GCM encryption of a JSON document. The name of the file is used as additional
+// data, so records can't be moved between conversations. The first record describes the
+// conversation, and every record after that is one message.
+//
+// New messages are only ever appended to the end of a file. If writing a record is interrupted,
+// the incomplete record at the end is ignored when reading, and cut off before the next record
+// is appended. Files are only rewritten when messages are dropped because of the retention limits.
+package history
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidKey is returned when the key used to open the store has the wrong size
+var ErrInvalidKey = errors.New("the history key must be 32 bytes long")
+
+// ErrCorrupted is returned when a record can't be decrypted, either because the file
+// has been tampered with or because it was written with a different key
+var ErrCorrupted = errors.New("the history file can't be decrypted")
+
+// ErrRemoved is returned when writing to a store after its whole history has been removed
+var ErrRemoved = errors.New("the history has been removed")
+
+const (
+	fileExtension = ".log"
+	nonceSize     = 12
+	lengthSize    = 4
+	// maxRecordSize guards against allocating huge buffers when reading a damaged length
+	maxRecordSize = 16 * 1024 * 1024
+)
+
+// Conversation identifies the conversation a message belongs to
+type Conversation struct {
+	Account string
+	// Peer is the bare JID of the peer, or of the room
+	Peer string
+	Room bool
+}
+
+// Message is one message in the history of a conversation
+type Message struct {
+	Time time.Time
+	// From is the bare JID of the sender, or the nickname of the occupant in rooms
+	From     string
+	Outgoing bool `json:",omitempty"`
+	Body     string
+	// Encrypted is true if the message was sent or received in an OTR conversation
+	Encrypted bool `json:",omitempty"`
+	// Verified is true if the fingerprint of the peer was trusted when the message was sent or received
+	Verified bool `json:",omitempty"`
+	// Fingerprint is the OTR fingerprint of the peer, for encrypted messages
+	Fingerprint []byte `json:",omitempty"`
+}
+
+// Retention describes how much of the history of a conversation should be kept.
+// Zero values mean there is no limit.
+type Retention struct {
+	MaxMessages int
+	MaxAge      time.Duration
+}
+
+// IsUnlimited returns true if the retention never drops any messages
+func (r Retention) IsUnlimited() bool {
+	return r.MaxMessages <= 0 && r.MaxAge <= 0
+}
+
+type record struct {
+	Conversation *Conversation `json:",omitempty"`
+	Message      *Message      `json:",omitempty"`
+}
+
+// Store gives access to the history files in one directory
+type Store struct {
+	dir     string
+	aead    cipher.AEAD
+	nameKey []byte
+	index   *searchIndex
+	removed bool
+
+	sync.Mutex
+}
+
+// Open returns a store that keeps the history files in the given directory, encrypted with the given key.
+// The directory is created when the first message is appended.
+func Open(dir string, key []byte) (*Store, error) {
+	if len(key) != 32 {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(subkey(key, "content"))
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		dir:     dir,
+		aead:    aead,
+		nameKey: subkey(key, "names"),
+	}, nil
+}
+
+func subkey(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func (s *Store) fileNameFor(c Conversation) string {
+	kind := "chat"
+	if c.Room {
+		kind = "room"
+	}
+
+	mac := hmac.New(sha256.New, s.nameKey)
+	_, _ = mac.Write([]byte(kind + "\x00" + c.Account + "\x00" + c.Peer))
+	return hex.EncodeToString(mac.Sum(nil)[:16]) + fileExtension
+}
+
+func (s *Store) pathFor(c Conversation) string {
+	return filepath.Join(s.dir, s.fileNameFor(c))
+}
+
+func (s *Store) seal(name string, r record) ([]byte, error) {
+	plain, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sealFrame(name, plain)
+}
+
+// sealFrame encrypts the content, and returns it prefixed with its length
+func (s *Store) sealFrame(name string, plain []byte) ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := s.aead.Seal(nonce, nonce, plain, []byte(name))
+
+	res := make([]byte, lengthSize, lengthSize+len(sealed))
+	binary.BigEndian.PutUint32(res, uint32(len(sealed)))
+	return append(res, sealed...), nil
+}
+
+func (s *Store) openFrame(name string, sealed []byte) ([]byte, error) {
+	if len(sealed) < nonceSize {
+		return nil, ErrCorrupted
+	}
+
+	plain, err := s.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(name))
+	if err != nil {
+		return nil, ErrCorrupted
+	}
+
+	return plain, nil
+}
+
+func (s *Store) open(name string, sealed []byte) (record, error) {
+	var r record
+	plain, err := s.openFrame(name, sealed)
+	if err != nil {
+		return r, err
+	}
+
+	err = json.Unmarshal(plain, &r)
+	return r, err
+}
+
+// readFrames returns the decrypted content of all complete frames in the given file. If the file doesn't exist, nothing is returned.
+func (s *Store) readFrames(path string) ([][]byte, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	name := filepath.Base(path)
+	var result [][]byte
+	for len(content) >= lengthSize {
+		l := binary.BigEndian.Uint32(content)
+		if l > maxRecordSize {
+			return nil, ErrCorrupted
+		}
+		if len(content) < lengthSize+int(l) {
+			// An interrupted write - the rest of the file is ignored
+			break
+		}
+
+		plain, err := s.openFrame(name, content[lengthSize:lengthSize+int(l)])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, plain)
+		content = content[lengthSize+int(l):]
+	}
+
+	return result, nil
+}
+
+// endOfCompleteFrames returns the offset just after the last complete frame in the file, without decrypting anything
+func endOfCompleteFrames(f *os.File) (int64, error) {
+	st, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	var end int64
+	header := make([]byte, lengthSize)
+	for st.Size()-end >= lengthSize {
+		if _, err := f.ReadAt(header, end); err != nil {
+			return 0, err
+		}
+
+		l := binary.BigEndian.Uint32(header)
+		if l > maxRecordSize {
+			return 0, ErrCorrupted
+		}
+		if st.Size()-end < lengthSize+int64(l) {
+			break
+		}
+		end += lengthSize + int64(l)
+	}
+
+	return end, nil
+}
+
+// readRecords returns all complete records in the given file. If the file doesn't exist, no records are returned.
+func (s *Store) readRecords(path string) ([]record, error) {
+	frames, err := s.readFrames(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]record, 0, len(frames))
+	for _, f := range frames {
+		var r record
+		if err := json.Unmarshal(f, &r); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+func (s *Store) encodeRecords(name string, rs []record) ([]byte, error) {
+	var b bytes.Buffer
+	for _, r := range rs {
+		sealed, err := s.seal(name, r)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(sealed)
+	}
+	return b.Bytes(), nil
+}
+
+// Append adds the message to the end of the history of the conversation
+func (s *Store) Append(c Conversation, m Message) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.ensureDir(); err != nil {
+		return err
+	}
+
+	path := s.pathFor(c)
+	name := filepath.Base(path)
+
+	f, err := os.OpenFile(filepath.Clean(path), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	// An incomplete record left by an interrupted write is cut off, so the new one
+	// doesn't end up behind it, where it could never be read
+	end, err := endOfCompleteFrames(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Truncate(end); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	rs := []record{{Message: &m}}
+	isNew := end == 0
+	if isNew {
+		rs = append([]record{{Conversation: &c}}, rs...)
+	}
+
+	content, err := s.encodeRecords(name, rs)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if _, err = f.WriteAt(content, end); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if s.index != nil {
+		if isNew {
+			s.index.reindex(name, fileVersion(path), c, nil)
+		}
+		s.index.add(name, s.index.Indexed[name], m)
+	}
+
+	return nil
+}
+
+func messagesIn(rs []record) []Message {
+	var result []Message
+	for _, r := range rs {
+		if r.Message != nil {
+			result = append(result, *r.Message)
+		}
+	}
+	return result
+}
+
+// Messages returns the messages in the history of the conversation, in the order they were added
+func (s *Store) Messages(c Conversation) ([]Message, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	rs, err := s.readRecords(s.pathFor(c))
+	if err != nil {
+		return nil, err
+	}
+
+	return messagesIn(rs), nil
+}
+
+// Conversations returns all conversations that have a history in this store, for the given account.
+// If the account is empty, the conversations of all accounts are returned.
+func (s *Store) Conversations(account string) ([]Conversation, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	files, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Conversation
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), fileExtension) {
+			continue
+		}
+
+		c, ok := s.conversationIn(filepath.Join(s.dir, f.Name()))
+		if ok && (account == "" || c.Account == account) {
+			result = append(result, c)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Account != result[j].Account {
+			return result[i].Account < result[j].Account
+		}
+		return result[i].Peer < result[j].Peer
+	})
+
+	return result, nil
+}
+
+// conversationIn returns the conversation described in the first record of the file. Files that
+// can't be decrypted with our key are ignored.
+func (s *Store) conversationIn(path string) (Conversation, bool) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return Conversation{}, false
+	}
+	defer f.Close()
+
+	l := make([]byte, lengthSize)
+	if _, err := io.ReadFull(f, l); err != nil {
+		return Conversation{}, false
+	}
+
+	size := binary.BigEndian.Uint32(l)
+	if size > maxRecordSize {
+		return Conversation{}, false
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(f, sealed); err != nil {
+		return Conversation{}, false
+	}
+
+	r, err := s.open(filepath.Base(path), sealed)
+	if err != nil || r.Conversation == nil {
+		return Conversation{}, false
+	}
+
+	return *r.Conversation, true
+}
+
+func (r Retention) filter(ms []Message, now time.Time) []Message {
+	if r.MaxAge > 0 {
+		oldest := now.Add(-r.MaxAge)
+		kept := ms[:0:0]
+		for _, m := range ms {
+			if !m.Time.Before(oldest) {
+				kept = append(kept, m)
+			}
+		}
+		ms = kept
+	}
+
+	if r.MaxMessages > 0 && len(ms) > r.MaxMessages {
+		ms = ms[len(ms)-r.MaxMessages:]
+	}
+
+	return ms
+}
+
+// ApplyRetention drops the messages of the conversation that fall outside of the retention limits.
+// The file is only rewritten if there is something to drop, and it's replaced atomically.
+func (s *Store) ApplyRetention(c Conversation, r Retention, now time.Time) error {
+	if r.IsUnlimited() {
+		return nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	path := s.pathFor(c)
+	rs, err := s.readRecords(path)
+	if err != nil || len(rs) == 0 {
+		return err
+	}
+
+	ms := messagesIn(rs)
+	kept := r.filter(ms, now)
+	if len(kept) == len(ms) {
+		return nil
+	}
+
+	if len(kept) == 0 {
+		s.updateIndexFor(c, nil)
+		return os.Remove(path)
+	}
+
+	nrs := []record{{Conversation: &c}}
+	for i := range kept {
+		nrs = append(nrs, record{Message: &kept[i]})
+	}
+
+	if err := s.replaceFile(path, nrs); err != nil {
+		return err
+	}
+
+	s.updateIndexFor(c, kept)
+	return nil
+}
+
+// replaceFile writes the records to a temporary file and renames it over the given path. Expects to be called with the lock held.
+func (s *Store) replaceFile(path string, rs []record) error {
+	content, err := s.encodeRecords(filepath.Base(path), rs)
+	if err != nil {
+		return err
+	}
+
+	return writeAtomically(path, content)
+}
+
+func writeAtomically(path string, content []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(filepath.Clean(tmp), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(content)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Remove deletes the whole history of the conversation
+func (s *Store) Remove(c Conversation) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.updateIndexFor(c, nil)
+
+	err := os.Remove(s.pathFor(c))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Move moves the directory of the store to the given place, which must not exist yet. The store keeps the
+// history files there from then on.
+func (s *Store) Move(dir string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := os.Rename(s.dir, dir); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	s.dir = dir
+	return nil
+}
+
+// ensureDir creates the directory of the store, unless the history has been removed. Expects to be called with the lock held.
+func (s *Store) ensureDir() error {
+	if s.removed {
+		return ErrRemoved
+	}
+	return os.MkdirAll(s.dir, 0700)
+}
+
+// RemoveAll deletes the whole history in the store. Nothing can be written to the store afterwards.
+func (s *Store) RemoveAll() error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.removed = true
+	s.index = nil
+	return os.RemoveAll(s.dir)
+}
+
+// IsRemoved returns true if the whole history in the store has been removed
+func (s *Store) IsRemoved() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.removed
+}
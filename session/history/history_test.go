@@ -0,0 +1,266 @@
+package history
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type HistorySuite struct{}
+
+var _ = Suite(&HistorySuite{})
+
+var testHistoryKey = bytes.Repeat([]byte{0x42}, 32)
+
+func openTestStore(c *C) (*Store, string) {
+	dir := c.MkDir()
+	s, err := Open(filepath.Join(dir, "history"), testHistoryKey)
+	c.Assert(err, IsNil)
+	return s, filepath.Join(dir, "history")
+}
+
+var (
+	aliceConv = Conversation{Account: "me@example.org", Peer: "alice@example.org"}
+	roomConv  = Conversation{Account: "me@example.org", Peer: "room@conference.example.org", Room: true}
+)
+
+func (s *HistorySuite) Test_Open_failsWithAKeyOfTheWrongSize(c *C) {
+	_, err := Open(c.MkDir(), []byte("short"))
+	c.Assert(err, Equals, ErrInvalidKey)
+}
+
+func (s *HistorySuite) Test_Store_Messages_returnsNothingForAnUnknownConversation(c *C) {
+	st, _ := openTestStore(c)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 0)
+}
+
+func (s *HistorySuite) Test_Store_Append_addsMessagesInOrder(c *C) {
+	st, _ := openTestStore(c)
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	c.Assert(st.Append(aliceConv, Message{Time: t1, From: "alice@example.org", Body: "hi", Encrypted: true, Verified: true}), IsNil)
+	c.Assert(st.Append(aliceConv, Message{Time: t1.Add(time.Minute), From: "me@example.org", Outgoing: true, Body: "hello"}), IsNil)
+	c.Assert(st.Append(roomConv, Message{Time: t1, From: "bob", Body: "in the room"}), IsNil)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 2)
+	c.Assert(ms[0].Body, Equals, "hi")
+	c.Assert(ms[0].Encrypted, Equals, true)
+	c.Assert(ms[0].Verified, Equals, true)
+	c.Assert(ms[0].Time.Equal(t1), Equals, true)
+	c.Assert(ms[1].Body, Equals, "hello")
+	c.Assert(ms[1].Outgoing, Equals, true)
+
+	ms, err = st.Messages(roomConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].From, Equals, "bob")
+}
+
+func (s *HistorySuite) Test_Store_doesntStoreAnythingInTheClear(c *C) {
+	st, dir := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{From: "alice@example.org", Body: "a very secret message"}), IsNil)
+
+	files, _ := ioutil.ReadDir(dir)
+	c.Assert(files, HasLen, 1)
+	c.Assert(bytes.Contains([]byte(files[0].Name()), []byte("alice")), Equals, false)
+
+	content, _ := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	c.Assert(bytes.Contains(content, []byte("secret")), Equals, false)
+	c.Assert(bytes.Contains(content, []byte("alice")), Equals, false)
+}
+
+func (s *HistorySuite) Test_Store_Messages_failsWithTheWrongKey(c *C) {
+	st, dir := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{Body: "hi"}), IsNil)
+
+	other, _ := Open(dir, bytes.Repeat([]byte{0x43}, 32))
+	other.nameKey = st.nameKey
+
+	_, err := other.Messages(aliceConv)
+	c.Assert(err, Equals, ErrCorrupted)
+}
+
+func (s *HistorySuite) Test_Store_Messages_failsIfARecordIsMovedToAnotherConversation(c *C) {
+	st, dir := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{Body: "hi"}), IsNil)
+
+	content, _ := ioutil.ReadFile(st.pathFor(aliceConv))
+	c.Assert(os.MkdirAll(dir, 0700), IsNil)
+	c.Assert(ioutil.WriteFile(st.pathFor(roomConv), content, 0600), IsNil)
+
+	_, err := st.Messages(roomConv)
+	c.Assert(err, Equals, ErrCorrupted)
+}
+
+func (s *HistorySuite) Test_Store_Messages_ignoresAnIncompleteRecordAtTheEnd(c *C) {
+	st, _ := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{Body: "one"}), IsNil)
+	c.Assert(st.Append(aliceConv, Message{Body: "two"}), IsNil)
+
+	path := st.pathFor(aliceConv)
+	content, _ := ioutil.ReadFile(path)
+	c.Assert(ioutil.WriteFile(path, content[:len(content)-5], 0600), IsNil)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].Body, Equals, "one")
+}
+
+func (s *HistorySuite) Test_Store_Append_cutsOffAnIncompleteRecordAtTheEnd(c *C) {
+	st, _ := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{Body: "one"}), IsNil)
+	c.Assert(st.Append(aliceConv, Message{Body: "two"}), IsNil)
+
+	path := st.pathFor(aliceConv)
+	content, _ := ioutil.ReadFile(path)
+	c.Assert(ioutil.WriteFile(path, content[:len(content)-5], 0600), IsNil)
+
+	c.Assert(st.Append(aliceConv, Message{Body: "three"}), IsNil)
+	c.Assert(st.Append(aliceConv, Message{Body: "four"}), IsNil)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 3)
+	c.Assert(ms[0].Body, Equals, "one")
+	c.Assert(ms[1].Body, Equals, "three")
+	c.Assert(ms[2].Body, Equals, "four")
+}
+
+func (s *HistorySuite) Test_Store_Append_startsOverWhenOnlyPartOfTheFirstRecordWasWritten(c *C) {
+	st, _ := openTestStore(c)
+	path := st.pathFor(aliceConv)
+	c.Assert(os.MkdirAll(filepath.Dir(path), 0700), IsNil)
+	c.Assert(ioutil.WriteFile(path, []byte{0x00, 0x00, 0x01}, 0600), IsNil)
+
+	c.Assert(st.Append(aliceConv, Message{Body: "one"}), IsNil)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 1)
+	cs, err := st.Conversations("me@example.org")
+	c.Assert(err, IsNil)
+	c.Assert(cs, DeepEquals, []Conversation{aliceConv})
+}
+
+func (s *HistorySuite) Test_Store_Conversations_listsTheConversationsOfAnAccount(c *C) {
+	st, _ := openTestStore(c)
+	other := Conversation{Account: "other@example.org", Peer: "alice@example.org"}
+
+	c.Assert(st.Append(roomConv, Message{Body: "one"}), IsNil)
+	c.Assert(st.Append(aliceConv, Message{Body: "two"}), IsNil)
+	c.Assert(st.Append(other, Message{Body: "three"}), IsNil)
+
+	cs, err := st.Conversations("me@example.org")
+	c.Assert(err, IsNil)
+	c.Assert(cs, DeepEquals, []Conversation{aliceConv, roomConv})
+
+	cs, err = st.Conversations("")
+	c.Assert(err, IsNil)
+	c.Assert(cs, HasLen, 3)
+}
+
+func (s *HistorySuite) Test_Store_Conversations_returnsNothingWhenTheDirectoryDoesntExist(c *C) {
+	st, _ := openTestStore(c)
+
+	cs, err := st.Conversations("")
+	c.Assert(err, IsNil)
+	c.Assert(cs, HasLen, 0)
+}
+
+func (s *HistorySuite) Test_Store_ApplyRetention_keepsTheNewestMessages(c *C) {
+	st, _ := openTestStore(c)
+	now := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+	for i, b := range []string{"one", "two", "three", "four"} {
+		c.Assert(st.Append(aliceConv, Message{Time: now.Add(time.Duration(i-4) * 24 * time.Hour), Body: b}), IsNil)
+	}
+
+	c.Assert(st.ApplyRetention(aliceConv, Retention{MaxMessages: 3}, now), IsNil)
+	ms, _ := st.Messages(aliceConv)
+	c.Assert(ms, HasLen, 3)
+	c.Assert(ms[0].Body, Equals, "two")
+
+	c.Assert(st.ApplyRetention(aliceConv, Retention{MaxAge: 36 * time.Hour}, now), IsNil)
+	ms, _ = st.Messages(aliceConv)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].Body, Equals, "four")
+
+	cs, _ := st.Conversations("")
+	c.Assert(cs, DeepEquals, []Conversation{aliceConv})
+
+	c.Assert(st.Append(aliceConv, Message{Body: "five"}), IsNil)
+	ms, _ = st.Messages(aliceConv)
+	c.Assert(ms, HasLen, 2)
+}
+
+func (s *HistorySuite) Test_Store_ApplyRetention_removesTheFileWhenNothingIsLeft(c *C) {
+	st, _ := openTestStore(c)
+	now := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+	c.Assert(st.Append(aliceConv, Message{Time: now.Add(-48 * time.Hour), Body: "old"}), IsNil)
+
+	c.Assert(st.ApplyRetention(aliceConv, Retention{MaxAge: time.Hour}, now), IsNil)
+
+	_, err := os.Stat(st.pathFor(aliceConv))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *HistorySuite) Test_Store_ApplyRetention_doesNothingWithoutLimits(c *C) {
+	st, _ := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{Body: "old"}), IsNil)
+
+	c.Assert(st.ApplyRetention(aliceConv, Retention{}, time.Now()), IsNil)
+
+	ms, _ := st.Messages(aliceConv)
+	c.Assert(ms, HasLen, 1)
+}
+
+func (s *HistorySuite) Test_Store_Remove_deletesTheHistory(c *C) {
+	st, _ := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{Body: "one"}), IsNil)
+
+	c.Assert(st.Remove(aliceConv), IsNil)
+	c.Assert(st.Remove(aliceConv), IsNil)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 0)
+}
+
+func (s *HistorySuite) Test_Store_Move_keepsTheHistoryInTheNewDirectory(c *C) {
+	st, dir := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{From: "alice@example.org", Body: "hi"}), IsNil)
+
+	moved := filepath.Join(c.MkDir(), "history")
+	c.Assert(st.Move(moved), IsNil)
+	c.Assert(st.Append(aliceConv, Message{From: "alice@example.org", Body: "again"}), IsNil)
+
+	_, err := os.Stat(dir)
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	other, err := Open(moved, testHistoryKey)
+	c.Assert(err, IsNil)
+	ms, err := other.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 2)
+}
+
+func (s *HistorySuite) Test_Store_RemoveAll_removesTheHistoryForGood(c *C) {
+	st, dir := openTestStore(c)
+	c.Assert(st.Append(aliceConv, Message{From: "alice@example.org", Body: "hi"}), IsNil)
+
+	c.Assert(st.RemoveAll(), IsNil)
+	c.Assert(st.IsRemoved(), Equals, true)
+	c.Assert(st.Append(aliceConv, Message{From: "alice@example.org", Body: "again"}), Equals, ErrRemoved)
+
+	_, err := os.Stat(dir)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
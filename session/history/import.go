@@ -0,0 +1,73 @@
+package history
+
+import (
+	"sort"
+	"strings"
+)
+
+// importKey identifies a message when merging history from other applications. Other applications
+// keep times with less precision, and name the sender in different ways, so only the second the
+// message was sent at and the text of the message are compared.
+type importKey struct {
+	second int64
+	body   string
+}
+
+func importKeyOf(m Message) importKey {
+	return importKey{m.Time.Unix(), strings.TrimSpace(m.Body)}
+}
+
+// Import merges the messages into the history of the conversation, skipping the ones that are
+// already there. The messages are sorted by time, and the file is replaced atomically.
+// It returns the number of messages that were added.
+func (s *Store) Import(c Conversation, ms []Message) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	path := s.pathFor(c)
+	rs, err := s.readRecords(path)
+	if err != nil {
+		return 0, err
+	}
+
+	existing := messagesIn(rs)
+	seen := make(map[importKey]bool, len(existing)+len(ms))
+	for _, m := range existing {
+		seen[importKeyOf(m)] = true
+	}
+
+	merged := existing
+	for _, m := range ms {
+		k := importKeyOf(m)
+		if seen[k] || k.body == "" {
+			continue
+		}
+		seen[k] = true
+		merged = append(merged, m)
+	}
+
+	added := len(merged) - len(existing)
+	if added == 0 {
+		return 0, nil
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Time.Before(merged[j].Time)
+	})
+
+	if err := s.ensureDir(); err != nil {
+		return 0, err
+	}
+
+	nrs := []record{{Conversation: &c}}
+	for i := range merged {
+		nrs = append(nrs, record{Message: &merged[i]})
+	}
+
+	if err := s.replaceFile(path, nrs); err != nil {
+		return 0, err
+	}
+
+	s.updateIndexFor(c, merged)
+	return added, nil
+}
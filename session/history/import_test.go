@@ -0,0 +1,79 @@
+package history
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type ImportSuite struct{}
+
+var _ = Suite(&ImportSuite{})
+
+func (s *ImportSuite) Test_Store_Import_mergesMessagesInTimeOrder(c *C) {
+	st, _ := openTestStore(c)
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 500, time.UTC)
+
+	c.Assert(st.Append(aliceConv, Message{Time: t1.Add(time.Hour), From: "alice@example.org", Body: "recorded"}), IsNil)
+
+	added, err := st.Import(aliceConv, []Message{
+		{Time: t1.Add(2 * time.Hour), From: "me@example.org", Outgoing: true, Body: "later"},
+		{Time: t1, From: "alice@example.org", Body: "earlier"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(added, Equals, 2)
+
+	ms, err := st.Messages(aliceConv)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 3)
+	c.Assert(ms[0].Body, Equals, "earlier")
+	c.Assert(ms[1].Body, Equals, "recorded")
+	c.Assert(ms[2].Body, Equals, "later")
+	c.Assert(ms[2].Outgoing, Equals, true)
+}
+
+func (s *ImportSuite) Test_Store_Import_skipsMessagesThatAreAlreadyThere(c *C) {
+	st, _ := openTestStore(c)
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 123456789, time.UTC)
+
+	c.Assert(st.Append(aliceConv, Message{Time: t1, From: "alice@example.org", Body: "hi"}), IsNil)
+
+	added, err := st.Import(aliceConv, []Message{
+		// Other applications keep less precise times, and name the sender differently
+		{Time: t1.Truncate(time.Second), From: "Alice", Body: "hi\n"},
+		{Time: t1.Add(time.Minute), From: "Alice", Body: "twice"},
+		{Time: t1.Add(time.Minute), From: "alice@example.org/phone", Body: "twice"},
+		{Time: t1.Add(2 * time.Minute), From: "Alice", Body: "  "},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(added, Equals, 1)
+
+	added, err = st.Import(aliceConv, []Message{{Time: t1.Add(time.Minute), From: "Alice", Body: "twice"}})
+	c.Assert(err, IsNil)
+	c.Assert(added, Equals, 0)
+
+	ms, _ := st.Messages(aliceConv)
+	c.Assert(ms, HasLen, 2)
+}
+
+func (s *ImportSuite) Test_Store_Import_keepsTheSearchIndexUpToDate(c *C) {
+	st, _ := openTestStore(c)
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	c.Assert(st.Append(aliceConv, Message{Time: t1.Add(time.Hour), From: "alice@example.org", Body: "recorded"}), IsNil)
+	_, err := st.Search(Query{Text: "recorded"})
+	c.Assert(err, IsNil)
+
+	_, err = st.Import(aliceConv, []Message{{Time: t1, From: "alice@example.org", Body: "imported words"}})
+	c.Assert(err, IsNil)
+
+	found, err := st.Search(Query{Text: "imported"})
+	c.Assert(err, IsNil)
+	c.Assert(found, HasLen, 1)
+	c.Assert(found[0].Position, Equals, 0)
+
+	found, err = st.Search(Query{Text: "recorded"})
+	c.Assert(err, IsNil)
+	c.Assert(found, HasLen, 1)
+	c.Assert(found[0].Position, Equals, 1)
+}
@@ -0,0 +1,404 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The search index is an inverted index from every word to the messages it appears in. It's kept in
+// one file, encrypted in the same way as the history itself, next to the history files. The index
+// records how many messages of each conversation it has seen, so when it's loaded after the
+// application was stopped before saving it, only the messages added since then have to be indexed.
+// It also records the nonce of the first record of every file, which changes when a file is
+// rewritten, so that rewritten files are indexed again from the start.
+const indexFileName = "search.idx"
+
+// posting points to one message: the file of the conversation and the position of the message in it
+type posting struct {
+	File    string `json:"f"`
+	Message int    `json:"m"`
+}
+
+type searchIndex struct {
+	Conversations map[string]Conversation
+	Versions      map[string]string
+	Indexed       map[string]int
+	Terms         map[string][]posting
+
+	sortedTerms []string
+	dirty       bool
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		Conversations: make(map[string]Conversation),
+		Versions:      make(map[string]string),
+		Indexed:       make(map[string]int),
+		Terms:         make(map[string][]posting),
+	}
+}
+
+// Query describes what to search for. Empty fields don't restrict the search.
+type Query struct {
+	// Text is the words to search for. Messages have to contain all of them, or words starting with them.
+	Text    string
+	Account string
+	// Peer restricts the search to the conversation with the given bare JID
+	Peer string
+	// Room restricts the search to the room with the given bare JID
+	Room          string
+	Since         time.Time
+	Until         time.Time
+	EncryptedOnly bool
+	// Limit is the maximum number of results returned
+	Limit int
+}
+
+// Match is a message that matched a search
+type Match struct {
+	Conversation Conversation
+	// Position is the index of the message in the history of the conversation
+	Position int
+	Message  Message
+}
+
+// Tokenize splits the text into the lower case words that are indexed
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(words))
+	result := make([]string, 0, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			result = append(result, w)
+		}
+	}
+	return result
+}
+
+func (idx *searchIndex) add(file string, position int, m Message) {
+	for _, w := range Tokenize(m.Body) {
+		if _, ok := idx.Terms[w]; !ok {
+			idx.sortedTerms = nil
+		}
+		idx.Terms[w] = append(idx.Terms[w], posting{File: file, Message: position})
+	}
+	idx.Indexed[file] = position + 1
+	idx.dirty = true
+}
+
+func (idx *searchIndex) forget(file string) {
+	for w, ps := range idx.Terms {
+		kept := ps[:0]
+		for _, p := range ps {
+			if p.File != file {
+				kept = append(kept, p)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(idx.Terms, w)
+			idx.sortedTerms = nil
+		} else {
+			idx.Terms[w] = kept
+		}
+	}
+
+	delete(idx.Indexed, file)
+	delete(idx.Conversations, file)
+	delete(idx.Versions, file)
+	idx.dirty = true
+}
+
+// reindex replaces everything known about the file with the given messages
+func (idx *searchIndex) reindex(file, version string, c Conversation, ms []Message) {
+	idx.forget(file)
+	idx.Conversations[file] = c
+	idx.Versions[file] = version
+	idx.Indexed[file] = 0
+	for i, m := range ms {
+		idx.add(file, i, m)
+	}
+}
+
+// matching returns the postings of all words starting with the given prefix
+func (idx *searchIndex) matching(prefix string) map[posting]bool {
+	if idx.sortedTerms == nil {
+		idx.sortedTerms = make([]string, 0, len(idx.Terms))
+		for w := range idx.Terms {
+			idx.sortedTerms = append(idx.sortedTerms, w)
+		}
+		sort.Strings(idx.sortedTerms)
+	}
+
+	result := make(map[posting]bool)
+	for i := sort.SearchStrings(idx.sortedTerms, prefix); i < len(idx.sortedTerms) && strings.HasPrefix(idx.sortedTerms[i], prefix); i++ {
+		for _, p := range idx.Terms[idx.sortedTerms[i]] {
+			result[p] = true
+		}
+	}
+	return result
+}
+
+func (q Query) matchesConversation(c Conversation) bool {
+	switch {
+	case q.Account != "" && q.Account != c.Account:
+		return false
+	case q.Peer != "" && (c.Room || q.Peer != c.Peer):
+		return false
+	case q.Room != "" && (!c.Room || q.Room != c.Peer):
+		return false
+	}
+	return true
+}
+
+func (q Query) matchesMessage(m Message) bool {
+	switch {
+	case q.EncryptedOnly && !m.Encrypted:
+		return false
+	case !q.Since.IsZero() && m.Time.Before(q.Since):
+		return false
+	case !q.Until.IsZero() && m.Time.After(q.Until):
+		return false
+	}
+	return true
+}
+
+// fileVersion returns the nonce of the first record of the file, which only changes when the file is rewritten
+func fileVersion(path string) string {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	b := make([]byte, lengthSize+nonceSize)
+	if _, err := io.ReadFull(f, b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b[lengthSize:])
+}
+
+// countFrames returns the number of complete records in the file, without decrypting them
+func countFrames(path string) int {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return 0
+	}
+
+	n := 0
+	for len(content) >= lengthSize {
+		l := int(binary.BigEndian.Uint32(content))
+		if len(content) < lengthSize+l {
+			break
+		}
+		content = content[lengthSize+l:]
+		n++
+	}
+	return n
+}
+
+// updateIndexFor is called after a conversation file has been changed, to keep the index
+// up to date if it's loaded. Expects to be called with the lock held.
+func (s *Store) updateIndexFor(c Conversation, ms []Message) {
+	if s.index == nil {
+		return
+	}
+
+	path := s.pathFor(c)
+	name := filepath.Base(path)
+	if ms == nil {
+		s.index.forget(name)
+		return
+	}
+	s.index.reindex(name, fileVersion(path), c, ms)
+}
+
+func (s *Store) indexPath() string {
+	return filepath.Join(s.dir, indexFileName)
+}
+
+// loadIndex reads the index from disk and brings it up to date with the history files.
+// Expects to be called with the lock held.
+func (s *Store) loadIndex() error {
+	if s.index != nil {
+		return nil
+	}
+
+	idx := newSearchIndex()
+	frames, err := s.readFrames(s.indexPath())
+	if err == nil && len(frames) > 0 {
+		if json.Unmarshal(frames[0], idx) != nil || idx.Terms == nil || idx.Indexed == nil || idx.Conversations == nil || idx.Versions == nil {
+			idx = newSearchIndex()
+		}
+	}
+	// An index that can't be read is rebuilt from the history files
+
+	if err := s.updateIndex(idx); err != nil {
+		return err
+	}
+
+	s.index = idx
+	return nil
+}
+
+// updateIndex indexes the messages the index hasn't seen yet, and forgets about files that don't exist anymore
+func (s *Store) updateIndex(idx *searchIndex) error {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), fileExtension) {
+			continue
+		}
+		existing[f.Name()] = true
+
+		path := filepath.Join(s.dir, f.Name())
+		version := fileVersion(path)
+		if idx.Versions[f.Name()] == version && idx.Indexed[f.Name()] == countFrames(path)-1 {
+			continue
+		}
+
+		rs, err := s.readRecords(path)
+		if err != nil || len(rs) == 0 || rs[0].Conversation == nil {
+			// Files we can't read are left alone, they are not part of this history
+			continue
+		}
+
+		ms := messagesIn(rs)
+		indexed, known := idx.Indexed[f.Name()]
+		switch {
+		case !known || indexed > len(ms) || idx.Versions[f.Name()] != version:
+			idx.reindex(f.Name(), version, *rs[0].Conversation, ms)
+		case indexed < len(ms):
+			for i := indexed; i < len(ms); i++ {
+				idx.add(f.Name(), i, ms[i])
+			}
+		}
+	}
+
+	for f := range idx.Indexed {
+		if !existing[f] {
+			idx.forget(f)
+		}
+	}
+
+	return nil
+}
+
+// saveIndex writes the index to disk if it has changed. Expects to be called with the lock held.
+func (s *Store) saveIndex() error {
+	if s.index == nil || !s.index.dirty {
+		return nil
+	}
+
+	if err := s.ensureDir(); err != nil {
+		return err
+	}
+
+	plain, err := json.Marshal(s.index)
+	if err != nil {
+		return err
+	}
+
+	content, err := s.sealFrame(indexFileName, plain)
+	if err != nil {
+		return err
+	}
+
+	if err := writeAtomically(s.indexPath(), content); err != nil {
+		return err
+	}
+
+	s.index.dirty = false
+	return nil
+}
+
+// SaveIndex writes the search index to disk, if it has changed since it was last saved
+func (s *Store) SaveIndex() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.saveIndex()
+}
+
+// Search returns the messages that match the query, newest first
+func (s *Store) Search(q Query) ([]Match, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	words := Tokenize(q.Text)
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	if err := s.loadIndex(); err != nil {
+		return nil, err
+	}
+	_ = s.saveIndex()
+
+	candidates := s.index.matching(words[0])
+	for _, w := range words[1:] {
+		if len(candidates) == 0 {
+			break
+		}
+
+		other := s.index.matching(w)
+		for p := range candidates {
+			if !other[p] {
+				delete(candidates, p)
+			}
+		}
+	}
+
+	byFile := make(map[string][]int)
+	for p := range candidates {
+		if q.matchesConversation(s.index.Conversations[p.File]) {
+			byFile[p.File] = append(byFile[p.File], p.Message)
+		}
+	}
+
+	var result []Match
+	for file, positions := range byFile {
+		rs, err := s.readRecords(filepath.Join(s.dir, file))
+		if err != nil {
+			return nil, err
+		}
+		ms := messagesIn(rs)
+
+		for _, p := range positions {
+			if p < len(ms) && q.matchesMessage(ms[p]) {
+				result = append(result, Match{
+					Conversation: s.index.Conversations[file],
+					Position:     p,
+					Message:      ms[p],
+				})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Message.Time.After(result[j].Message.Time)
+	})
+
+	if q.Limit > 0 && len(result) > q.Limit {
+		result = result[:q.Limit]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,148 @@
+package history
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type IndexSuite struct{}
+
+var _ = Suite(&IndexSuite{})
+
+var searchStart = time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func fillTestStore(c *C, st *Store) {
+	c.Assert(st.Append(aliceConv, Message{Time: searchStart, From: "alice@example.org", Body: "Shall we meet at the Library tomorrow?", Encrypted: true}), IsNil)
+	c.Assert(st.Append(aliceConv, Message{Time: searchStart.Add(time.Hour), From: "me@example.org", Outgoing: true, Body: "Yes, the library at noon"}), IsNil)
+	c.Assert(st.Append(roomConv, Message{Time: searchStart.Add(2 * time.Hour), From: "bob", Body: "the librarian said no"}), IsNil)
+	c.Assert(st.Append(Conversation{Account: "other@example.org", Peer: "alice@example.org"}, Message{Time: searchStart, Body: "library card"}), IsNil)
+}
+
+func bodiesOf(ms []Match) []string {
+	result := []string{}
+	for _, m := range ms {
+		result = append(result, m.Message.Body)
+	}
+	return result
+}
+
+func (s *IndexSuite) Test_Tokenize_splitsIntoLowerCaseWords(c *C) {
+	c.Assert(Tokenize("Hello, World! hello... café 42"), DeepEquals, []string{"hello", "world", "café", "42"})
+	c.Assert(Tokenize(" .,!"), HasLen, 0)
+}
+
+func (s *IndexSuite) Test_Store_Search_findsMessagesContainingAllWords(c *C) {
+	st, _ := openTestStore(c)
+	fillTestStore(c, st)
+
+	res, err := st.Search(Query{Text: "library NOON"})
+	c.Assert(err, IsNil)
+	c.Assert(bodiesOf(res), DeepEquals, []string{"Yes, the library at noon"})
+	c.Assert(res[0].Conversation, DeepEquals, aliceConv)
+	c.Assert(res[0].Position, Equals, 1)
+}
+
+func (s *IndexSuite) Test_Store_Search_matchesPrefixesAndSortsNewestFirst(c *C) {
+	st, _ := openTestStore(c)
+	fillTestStore(c, st)
+
+	res, err := st.Search(Query{Text: "libr", Account: "me@example.org"})
+	c.Assert(err, IsNil)
+	c.Assert(bodiesOf(res), DeepEquals, []string{
+		"the librarian said no",
+		"Yes, the library at noon",
+		"Shall we meet at the Library tomorrow?",
+	})
+
+	res, _ = st.Search(Query{Text: "libr", Account: "me@example.org", Limit: 1})
+	c.Assert(res, HasLen, 1)
+}
+
+func (s *IndexSuite) Test_Store_Search_canBeFiltered(c *C) {
+	st, _ := openTestStore(c)
+	fillTestStore(c, st)
+
+	res, _ := st.Search(Query{Text: "library", Peer: "alice@example.org"})
+	c.Assert(res, HasLen, 3)
+
+	res, _ = st.Search(Query{Text: "libr", Room: "room@conference.example.org"})
+	c.Assert(bodiesOf(res), DeepEquals, []string{"the librarian said no"})
+
+	res, _ = st.Search(Query{Text: "libr", Account: "me@example.org", EncryptedOnly: true})
+	c.Assert(bodiesOf(res), DeepEquals, []string{"Shall we meet at the Library tomorrow?"})
+
+	res, _ = st.Search(Query{Text: "libr", Account: "me@example.org", Since: searchStart.Add(30 * time.Minute), Until: searchStart.Add(90 * time.Minute)})
+	c.Assert(bodiesOf(res), DeepEquals, []string{"Yes, the library at noon"})
+
+	res, _ = st.Search(Query{Text: "nothing"})
+	c.Assert(res, HasLen, 0)
+
+	res, _ = st.Search(Query{Text: "  "})
+	c.Assert(res, HasLen, 0)
+}
+
+func (s *IndexSuite) Test_Store_Search_keepsUpWithChangesToTheHistory(c *C) {
+	st, _ := openTestStore(c)
+	fillTestStore(c, st)
+
+	res, _ := st.Search(Query{Text: "library", Account: "me@example.org"})
+	c.Assert(res, HasLen, 2)
+
+	c.Assert(st.Append(aliceConv, Message{Time: searchStart.Add(3 * time.Hour), Body: "library closed"}), IsNil)
+	res, _ = st.Search(Query{Text: "library", Account: "me@example.org"})
+	c.Assert(res, HasLen, 3)
+
+	c.Assert(st.ApplyRetention(aliceConv, Retention{MaxMessages: 1}, time.Now()), IsNil)
+	res, _ = st.Search(Query{Text: "library", Account: "me@example.org"})
+	c.Assert(bodiesOf(res), DeepEquals, []string{"library closed"})
+	c.Assert(res[0].Position, Equals, 0)
+
+	c.Assert(st.Remove(aliceConv), IsNil)
+	res, _ = st.Search(Query{Text: "library", Account: "me@example.org"})
+	c.Assert(res, HasLen, 0)
+}
+
+func (s *IndexSuite) Test_Store_Search_catchesUpWithChangesMadeWhileTheIndexWasNotLoaded(c *C) {
+	st, dir := openTestStore(c)
+	fillTestStore(c, st)
+	_, _ = st.Search(Query{Text: "library"})
+
+	other, _ := Open(dir, testHistoryKey)
+	c.Assert(other.Append(aliceConv, Message{Time: searchStart.Add(3 * time.Hour), Body: "library closed"}), IsNil)
+	c.Assert(other.ApplyRetention(roomConv, Retention{MaxMessages: 1}, time.Now()), IsNil)
+	c.Assert(other.Append(roomConv, Message{Time: searchStart.Add(4 * time.Hour), Body: "the librarian left"}), IsNil)
+
+	reopened, _ := Open(dir, testHistoryKey)
+	res, err := reopened.Search(Query{Text: "librarian"})
+	c.Assert(err, IsNil)
+	c.Assert(bodiesOf(res), DeepEquals, []string{"the librarian left", "the librarian said no"})
+
+	res, _ = reopened.Search(Query{Text: "closed"})
+	c.Assert(res, HasLen, 1)
+	c.Assert(res[0].Position, Equals, 2)
+}
+
+func (s *IndexSuite) Test_Store_Search_storesTheIndexEncrypted(c *C) {
+	st, dir := openTestStore(c)
+	fillTestStore(c, st)
+	_, _ = st.Search(Query{Text: "library"})
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, indexFileName))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Not(Matches), "(?s).*librar.*")
+}
+
+func (s *IndexSuite) Test_Store_Search_rebuildsAnIndexThatCantBeRead(c *C) {
+	st, dir := openTestStore(c)
+	fillTestStore(c, st)
+	c.Assert(os.MkdirAll(dir, 0700), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, indexFileName), []byte("garbage"), 0600), IsNil)
+
+	res, err := st.Search(Query{Text: "library", Account: "me@example.org"})
+	c.Assert(err, IsNil)
+	c.Assert(res, HasLen, 2)
+}
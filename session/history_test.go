@@ -0,0 +1,225 @@
+package session
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/session/history"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+	"github.com/sirupsen/logrus/hooks/test"
+
+	. "gopkg.in/check.v1"
+)
+
+type HistorySessionSuite struct{}
+
+var _ = Suite(&HistorySessionSuite{})
+
+func historyTestSession(c *C, encrypted bool) (*session, func()) {
+	dir, err := ioutil.TempDir("", "decoyim-history-")
+	c.Assert(err, IsNil)
+
+	ks := config.FunctionKeySupplier(func(config.EncryptionParameters, bool) ([]byte, []byte, bool) {
+		return bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32), true
+	})
+
+	conf, _, _ := config.LoadOrCreate(filepath.Join(dir, "accounts.json"), ks)
+	conf.SetShouldSaveFileEncrypted(encrypted)
+	c.Assert(conf.Save(ks), IsNil)
+
+	l, _ := test.NewNullLogger()
+
+	return &session{
+		log:           l,
+		config:        conf,
+		accountConfig: &config.Account{Account: "me@example.org", KeepHistory: true},
+	}, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func (s *HistorySessionSuite) Test_session_recordsNothingIfTheAccountDoesntKeepHistory(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+	sess.accountConfig.KeepHistory = false
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("hello"))
+
+	ms, err := sess.HistoryWith(jid.Parse("friend@example.org"), false)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 0)
+	_, err = os.Stat(sess.config.DataDir(historyDirectory))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *HistorySessionSuite) Test_session_HistoryWith_failsIfTheConfigurationIsNotEncrypted(c *C) {
+	sess, done := historyTestSession(c, false)
+	defer done()
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("hello"))
+
+	_, err := sess.HistoryWith(jid.Parse("friend@example.org"), false)
+	c.Assert(err, Equals, config.ErrConfigurationNotEncrypted)
+	_, err = os.Stat(sess.config.DataDir(historyDirectory))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *HistorySessionSuite) Test_session_recordsIncomingAndOutgoingMessages(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+
+	when := time.Now().Add(-time.Minute)
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), when, nil, []byte("hello"))
+	sess.recordOutgoingMessage(jid.Parse("friend@example.org/phone"), nil, "hi there")
+
+	ms, err := sess.HistoryWith(jid.Parse("friend@example.org"), false)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 2)
+	c.Assert(ms[0].From, Equals, "friend@example.org")
+	c.Assert(ms[0].Body, Equals, "hello")
+	c.Assert(ms[0].Outgoing, Equals, false)
+	c.Assert(ms[0].Time.Equal(when), Equals, true)
+	c.Assert(ms[1].From, Equals, "me@example.org")
+	c.Assert(ms[1].Outgoing, Equals, true)
+
+	cs, err := sess.HistoryConversations()
+	c.Assert(err, IsNil)
+	c.Assert(cs, DeepEquals, []history.Conversation{{Account: "me@example.org", Peer: "friend@example.org"}})
+
+	c.Assert(sess.ForgetHistoryWith(jid.Parse("friend@example.org"), false), IsNil)
+	ms, _ = sess.HistoryWith(jid.Parse("friend@example.org"), false)
+	c.Assert(ms, HasLen, 0)
+}
+
+func (s *HistorySessionSuite) Test_session_HistoryWith_appliesTheRetentionOfThePeer(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+	sess.accountConfig.HistoryMaxMessages = 10
+	sess.accountConfig.SetHistoryRetentionFor("friend@example.org", 1, 0)
+
+	peer := jid.Parse("friend@example.org/phone")
+	sess.recordIncomingMessage(peer, time.Now(), nil, []byte("one"))
+	sess.recordIncomingMessage(peer, time.Now(), nil, []byte("two"))
+
+	ms, err := sess.HistoryWith(peer, false)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].Body, Equals, "two")
+}
+
+func (s *HistorySessionSuite) Test_session_applyHistoryRetention_dropsOldMessages(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+	sess.accountConfig.HistoryMaxAgeDays = 1
+
+	peer := jid.Parse("friend@example.org/phone")
+	sess.recordIncomingMessage(peer, time.Now().Add(-72*time.Hour), nil, []byte("old"))
+	sess.recordIncomingMessage(peer, time.Now(), nil, []byte("new"))
+
+	sess.applyHistoryRetention()
+
+	st, _ := sess.conversationHistory()
+	ms, err := st.Messages(sess.historyConversation(peer, false))
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].Body, Equals, "new")
+}
+
+func (s *HistorySessionSuite) Test_session_recordRoomMessage_marksOurOwnMessages(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+	sess.muc = newMUCManager(sess.log, sess.Conn, func(interface{}) {})
+	sess.muc.recordMessage = sess.recordRoomMessage
+
+	roomID := jid.ParseBare("room@conference.example.org")
+	sess.muc.liveMessageReceived(roomID, "alice", "hello everyone", time.Now())
+
+	ms, err := sess.HistoryWith(roomID, true)
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 1)
+	c.Assert(ms[0].From, Equals, "alice")
+	c.Assert(ms[0].Outgoing, Equals, false)
+
+	ms, _ = sess.HistoryWith(roomID, false)
+	c.Assert(ms, HasLen, 0)
+}
+
+func (s *HistorySessionSuite) Test_session_SearchHistory_onlyFindsMessagesOfTheAccount(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("where is the party?"))
+
+	other := &session{
+		log:           sess.log,
+		config:        sess.config,
+		accountConfig: &config.Account{Account: "other@example.org", KeepHistory: true},
+	}
+	other.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("the party is here"))
+
+	res, err := sess.SearchHistory(history.Query{Text: "party", Account: "other@example.org"})
+	c.Assert(err, IsNil)
+	c.Assert(res, HasLen, 1)
+	c.Assert(res[0].Message.Body, Equals, "where is the party?")
+	c.Assert(res[0].Conversation.Peer, Equals, "friend@example.org")
+}
+
+func (s *HistorySessionSuite) Test_session_ExportHistory_returnsTheMessagesInThePeriod(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+
+	now := time.Now()
+	peer := jid.Parse("friend@example.org/phone")
+	sess.recordIncomingMessage(peer, now.Add(-2*time.Hour), nil, []byte("old"))
+	sess.recordIncomingMessage(peer, now, nil, []byte("new"))
+
+	e, err := sess.ExportHistory(peer, false, now.Add(-time.Hour), time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(e.Conversation.Peer, Equals, "friend@example.org")
+	c.Assert(e.Since.Equal(now.Add(-time.Hour)), Equals, true)
+	c.Assert(e.Messages, HasLen, 1)
+	c.Assert(e.Messages[0].Body, Equals, "new")
+}
+
+func (s *HistorySessionSuite) Test_moveHistoryStore_movesTheStoreTheSessionUses(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("hello"))
+
+	from := sess.config.DataDir(historyDirectory)
+	to := filepath.Join(filepath.Dir(from), "elsewhere")
+	c.Assert(moveHistoryStore(from, to), IsNil)
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("again"))
+	_, err := os.Stat(from)
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	st, err := openHistoryStore(to, nil)
+	c.Assert(err, IsNil)
+	c.Assert(st, Equals, sess.history)
+	ms, err := st.Messages(sess.historyConversation(jid.Parse("friend@example.org"), false))
+	c.Assert(err, IsNil)
+	c.Assert(ms, HasLen, 2)
+}
+
+func (s *HistorySessionSuite) Test_DeleteHistory_removesTheHistoryBeforeEncryptionIsTurnedOff(c *C) {
+	sess, done := historyTestSession(c, true)
+	defer done()
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("hello"))
+	c.Assert(HasHistory(sess.config), Equals, true)
+
+	c.Assert(DeleteHistory(sess.config), IsNil)
+	c.Assert(HasHistory(sess.config), Equals, false)
+	sess.config.SetShouldSaveFileEncrypted(false)
+
+	sess.recordIncomingMessage(jid.Parse("friend@example.org/phone"), time.Now(), nil, []byte("hello again"))
+	c.Assert(HasHistory(sess.config), Equals, false)
+	_, err := sess.HistoryWith(jid.Parse("friend@example.org"), false)
+	c.Assert(err, Equals, config.ErrConfigurationNotEncrypted)
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/chadsec1/decoyim/decoylog"
 	"github.com/chadsec1/decoyim/otrclient"
 	"github.com/chadsec1/decoyim/roster"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/session/access"
 	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/history"
 	"github.com/chadsec1/decoyim/session/muc"
 	mdata "github.com/chadsec1/decoyim/session/muc/data"
 	"github.com/chadsec1/decoyim/tls"
@@ -61,8 +63,8 @@ func (m *MockedSession) Conn() xi.Conn {
 }
 
 // Connect is the implementation for Session interface
-func (m *MockedSession) Connect(v1 string, v2 tls.Verifier) error {
-	return m.Called(v1, v2).Error(0)
+func (m *MockedSession) Connect(v1 string, v2 tls.Verifier, v3 sasl.Verifier) error {
+	return m.Called(v1, v2, v3).Error(0)
 }
 
 // ConversationManager is the implementation for Session interface
@@ -188,6 +190,83 @@ func (m *MockedSession) SetConnector(v1 access.Connector) {
 	m.Called(v1)
 }
 
+// ReconnectNow is the implementation for Session interface
+func (m *MockedSession) ReconnectNow() {
+	m.Called()
+}
+
+// StopReconnecting is the implementation for Session interface
+func (m *MockedSession) StopReconnecting() {
+	m.Called()
+}
+
+// NetworkUp is the implementation for Session interface
+func (m *MockedSession) NetworkUp() {
+	m.Called()
+}
+
+// IsReconnecting is the implementation for Session interface
+func (m *MockedSession) IsReconnecting() bool {
+	return m.Called().Bool(0)
+}
+
+// QueueMessage is the implementation for Session interface
+func (m *MockedSession) QueueMessage(v1 jid.Any, v2 string) (sdata.QueuedMessage, error) {
+	args := m.Called(v1, v2)
+	return args.Get(0).(sdata.QueuedMessage), args.Error(1)
+}
+
+// QueuedMessagesFor is the implementation for Session interface
+func (m *MockedSession) QueuedMessagesFor(v1 jid.Any) []sdata.QueuedMessage {
+	return m.Called(v1).Get(0).([]sdata.QueuedMessage)
+}
+
+// CancelQueuedMessage is the implementation for Session interface
+func (m *MockedSession) CancelQueuedMessage(v1 string) bool {
+	return m.Called(v1).Bool(0)
+}
+
+// HistoryWith is the implementation for Session interface
+func (m *MockedSession) HistoryWith(v1 jid.Any, v2 bool) ([]history.Message, error) {
+	args := m.Called(v1, v2)
+	return args.Get(0).([]history.Message), args.Error(1)
+}
+
+// HistoryConversations is the implementation for Session interface
+func (m *MockedSession) HistoryConversations() ([]history.Conversation, error) {
+	args := m.Called()
+	return args.Get(0).([]history.Conversation), args.Error(1)
+}
+
+// ForgetHistoryWith is the implementation for Session interface
+func (m *MockedSession) ForgetHistoryWith(v1 jid.Any, v2 bool) error {
+	return m.Called(v1, v2).Error(0)
+}
+
+// SearchHistory is the implementation for Session interface
+func (m *MockedSession) SearchHistory(v1 history.Query) ([]history.Match, error) {
+	args := m.Called(v1)
+	return args.Get(0).([]history.Match), args.Error(1)
+}
+
+// ExportHistory is the implementation for Session interface
+func (m *MockedSession) ExportHistory(v1 jid.Any, v2 bool, v3, v4 time.Time) (*history.Export, error) {
+	args := m.Called(v1, v2, v3, v4)
+	return args.Get(0).(*history.Export), args.Error(1)
+}
+
+// ExportServerData is the implementation for Session interface
+func (m *MockedSession) ExportServerData() (*data.ServerData, error) {
+	args := m.Called()
+	return args.Get(0).(*data.ServerData), args.Error(1)
+}
+
+// ImportServerData is the implementation for Session interface
+func (m *MockedSession) ImportServerData(v1 *data.ServerDataUser, v2 string) (*sdata.ServerDataImport, error) {
+	args := m.Called(v1, v2)
+	return args.Get(0).(*sdata.ServerDataImport), args.Error(1)
+}
+
 // SetLastActionTime is the implementation for Session interface
 func (m *MockedSession) SetLastActionTime(v1 time.Time) {
 	m.Called(v1)
@@ -8,8 +8,10 @@ import (
 	"github.com/chadsec1/decoyim/decoylog"
 	"github.com/chadsec1/decoyim/otrclient"
 	"github.com/chadsec1/decoyim/roster"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/session/access"
 	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/history"
 	"github.com/chadsec1/decoyim/session/muc"
 	mdata "github.com/chadsec1/decoyim/session/muc/data"
 	"github.com/chadsec1/decoyim/tls"
@@ -53,7 +55,7 @@ func (*SessionMock) Conn() xi.Conn {
 }
 
 // Connect is the implementation for Session interface
-func (*SessionMock) Connect(string, tls.Verifier) error {
+func (*SessionMock) Connect(string, tls.Verifier, sasl.Verifier) error {
 	return nil
 }
 
@@ -165,6 +167,70 @@ func (*SessionMock) SetCommandManager(otrclient.CommandManager) {}
 // SetConnector is the implementation for Session interface
 func (*SessionMock) SetConnector(access.Connector) {}
 
+// ReconnectNow is the implementation for Session interface
+func (*SessionMock) ReconnectNow() {}
+
+// StopReconnecting is the implementation for Session interface
+func (*SessionMock) StopReconnecting() {}
+
+// NetworkUp is the implementation for Session interface
+func (*SessionMock) NetworkUp() {}
+
+// IsReconnecting is the implementation for Session interface
+func (*SessionMock) IsReconnecting() bool {
+	return false
+}
+
+// QueueMessage is the implementation for Session interface
+func (*SessionMock) QueueMessage(jid.Any, string) (sdata.QueuedMessage, error) {
+	return sdata.QueuedMessage{}, nil
+}
+
+// QueuedMessagesFor is the implementation for Session interface
+func (*SessionMock) QueuedMessagesFor(jid.Any) []sdata.QueuedMessage {
+	return nil
+}
+
+// CancelQueuedMessage is the implementation for Session interface
+func (*SessionMock) CancelQueuedMessage(string) bool {
+	return false
+}
+
+// HistoryWith is the implementation for Session interface
+func (*SessionMock) HistoryWith(jid.Any, bool) ([]history.Message, error) {
+	return nil, nil
+}
+
+// HistoryConversations is the implementation for Session interface
+func (*SessionMock) HistoryConversations() ([]history.Conversation, error) {
+	return nil, nil
+}
+
+// ForgetHistoryWith is the implementation for Session interface
+func (*SessionMock) ForgetHistoryWith(jid.Any, bool) error {
+	return nil
+}
+
+// SearchHistory is the implementation for Session interface
+func (*SessionMock) SearchHistory(history.Query) ([]history.Match, error) {
+	return nil, nil
+}
+
+// ExportHistory is the implementation for Session interface
+func (*SessionMock) ExportHistory(jid.Any, bool, time.Time, time.Time) (*history.Export, error) {
+	return nil, nil
+}
+
+// ExportServerData is the implementation for Session interface
+func (*SessionMock) ExportServerData() (*data.ServerData, error) {
+	return nil, nil
+}
+
+// ImportServerData is the implementation for Session interface
+func (*SessionMock) ImportServerData(*data.ServerDataUser, string) (*sdata.ServerDataImport, error) {
+	return nil, nil
+}
+
 // SetLastActionTime is the implementation for Session interface
 func (*SessionMock) SetLastActionTime(time.Time) {}
 
@@ -24,7 +24,7 @@ func (s *MockSuite) Test_SessionMock(c *C) {
 	c.Assert(sm.CommandManager(), IsNil)
 	c.Assert(sm.Config(), IsNil)
 	c.Assert(sm.Conn(), IsNil)
-	c.Assert(sm.Connect("", nil), IsNil)
+	c.Assert(sm.Connect("", nil, nil), IsNil)
 	c.Assert(sm.ConversationManager(), IsNil)
 	c.Assert(sm.DenyPresenceSubscription(nil, ""), IsNil)
 	c.Assert(sm.DisplayName(), Equals, "")
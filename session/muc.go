@@ -3,6 +3,7 @@ package session
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/chadsec1/decoyim/decoylog"
 	"github.com/chadsec1/decoyim/session/events"
@@ -18,6 +19,8 @@ type mucManager struct {
 	log          decoylog.Logger
 	conn         func() xi.Conn
 	publishEvent func(ev interface{})
+	// recordMessage is called with every message received in a room, if set
+	recordMessage func(roomID jid.Bare, nickname, message string, timestamp time.Time)
 
 	roomInfos     map[jid.Bare]*muc.RoomListing
 	roomInfosLock sync.Mutex
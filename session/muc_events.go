@@ -81,6 +81,9 @@ func (m *mucManager) selfOccupantJoined(roomID jid.Bare, op *muc.OccupantPresenc
 
 func (m *mucManager) liveMessageReceived(roomID jid.Bare, nickname, message string, timestamp time.Time) {
 	m.appendHistoryMessage(roomID, nickname, message, timestamp)
+	if m.recordMessage != nil {
+		m.recordMessage(roomID, nickname, message, timestamp)
+	}
 
 	ev := events.MUCLiveMessageReceived{}
 	ev.Nickname = nickname
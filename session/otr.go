@@ -32,10 +32,12 @@ func (s *session) listenToOtrDelayedMessageDelivery(c <-chan int, peer jid.Any)
 
 func (s *session) newOTRKeys(peer jid.WithResource, conversation otrclient.Conversation) {
 	s.publishPeerEvent(events.OTRNewKeys, peer)
+	go s.flushEncryptedOutboxFor(peer, conversation)
 }
 
 func (s *session) renewedOTRKeys(peer jid.WithResource, conversation otrclient.Conversation) {
 	s.publishPeerEvent(events.OTRRenewedKeys, peer)
+	go s.flushEncryptedOutboxFor(peer, conversation)
 }
 
 func (s *session) otrEnded(peer jid.WithResource) {
@@ -0,0 +1,297 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/otrclient"
+	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/events"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+)
+
+// outbox keeps the messages the user sent while we were offline, until we can send them.
+// It is stored in a data file next to the configuration, so the messages survive restarts.
+type outbox struct {
+	file     *config.DataFile
+	messages []*sdata.QueuedMessage
+	loaded   bool
+
+	sync.Mutex
+}
+
+type outboxContents struct {
+	Messages []*sdata.QueuedMessage
+}
+
+// outboxFileName doesn't include the account itself, so that the name of the file doesn't reveal it
+func outboxFileName(account string) string {
+	sum := sha256.Sum256([]byte(account))
+	return "outbox-" + hex.EncodeToString(sum[:8]) + ".json"
+}
+
+func (s *session) messageOutbox() *outbox {
+	s.outboxLock.Lock()
+	defer s.outboxLock.Unlock()
+
+	if s.outbox == nil {
+		s.outbox = &outbox{}
+		if s.config != nil && s.GetConfig() != nil {
+			// Messages that were sent or cancelled must not stay behind in backups
+			s.outbox.file = s.config.DataFile(outboxFileName(s.GetConfig().Account)).WithoutBackups()
+		}
+	}
+
+	return s.outbox
+}
+
+// load reads the messages from disk the first time it's called. Expects to be called with the lock held.
+func (o *outbox) load() error {
+	if o.loaded || o.file == nil {
+		return nil
+	}
+
+	content, err := o.file.Load()
+	if err != nil {
+		return err
+	}
+	o.loaded = true
+
+	if content == nil {
+		return nil
+	}
+
+	var res outboxContents
+	if err := json.Unmarshal(content, &res); err != nil {
+		return err
+	}
+	o.messages = append(res.Messages, o.messages...)
+
+	return nil
+}
+
+// save writes the messages to disk, removing the file when there are none. Expects to be called with the lock held.
+func (o *outbox) save() error {
+	if o.file == nil {
+		return nil
+	}
+
+	if len(o.messages) == 0 {
+		o.file.Remove()
+		return nil
+	}
+
+	content, err := json.MarshalIndent(outboxContents{Messages: o.messages}, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return o.file.Store(content)
+}
+
+func (o *outbox) add(m *sdata.QueuedMessage) error {
+	o.Lock()
+	defer o.Unlock()
+
+	loadErr := o.load()
+	o.messages = append(o.messages, m)
+	if loadErr != nil {
+		// We don't overwrite a file we couldn't read
+		return loadErr
+	}
+
+	return o.save()
+}
+
+func (o *outbox) all() []*sdata.QueuedMessage {
+	o.Lock()
+	defer o.Unlock()
+
+	_ = o.load()
+	return append([]*sdata.QueuedMessage(nil), o.messages...)
+}
+
+// take removes and returns the messages that match the given function
+func (o *outbox) take(f func(*sdata.QueuedMessage) bool) []*sdata.QueuedMessage {
+	o.Lock()
+	defer o.Unlock()
+
+	if err := o.load(); err != nil {
+		return nil
+	}
+
+	var taken, kept []*sdata.QueuedMessage
+	for _, m := range o.messages {
+		if f(m) {
+			taken = append(taken, m)
+		} else {
+			kept = append(kept, m)
+		}
+	}
+
+	if len(taken) > 0 {
+		o.messages = kept
+		_ = o.save()
+	}
+
+	return taken
+}
+
+// putBack returns messages that couldn't be sent to the front of the queue
+func (o *outbox) putBack(ms []*sdata.QueuedMessage) {
+	if len(ms) == 0 {
+		return
+	}
+
+	o.Lock()
+	defer o.Unlock()
+
+	o.messages = append(append([]*sdata.QueuedMessage(nil), ms...), o.messages...)
+	_ = o.save()
+}
+
+func newQueuedMessageID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// wasEncryptedWith returns true if the last conversation with the peer was encrypted
+func (s *session) wasEncryptedWith(peer jid.Any) bool {
+	if s.convManager == nil {
+		return false
+	}
+
+	c, ok := s.convManager.GetConversationWith(peer)
+	return ok && c.IsEncrypted()
+}
+
+// QueueMessage puts a message in the outbox, to be sent when we are connected again. If the conversation with the peer
+// should be encrypted, the message will only be sent once an encrypted conversation has been established again.
+func (s *session) QueueMessage(peer jid.Any, message string) (sdata.QueuedMessage, error) {
+	m := &sdata.QueuedMessage{
+		ID:        newQueuedMessageID(),
+		Peer:      peer.String(),
+		Body:      message,
+		Queued:    time.Now(),
+		Encrypted: s.GetConfig().ShouldEncryptTo(peer.NoResource().String()) || s.wasEncryptedWith(peer),
+	}
+
+	err := s.messageOutbox().add(m)
+	if err != nil {
+		s.log.WithError(err).Warn("couldn't save the outbox")
+	}
+
+	s.publishEvent(events.Outbox{Type: events.MessageQueued, Message: *m})
+
+	return *m, err
+}
+
+// QueuedMessagesFor returns the messages waiting to be sent to the given peer
+func (s *session) QueuedMessagesFor(peer jid.Any) []sdata.QueuedMessage {
+	bare := peer.NoResource().String()
+
+	var result []sdata.QueuedMessage
+	for _, m := range s.messageOutbox().all() {
+		if jid.Parse(m.Peer).NoResource().String() == bare {
+			result = append(result, *m)
+		}
+	}
+
+	return result
+}
+
+// CancelQueuedMessage removes the message with the given id from the outbox, returning false if it's not there anymore
+func (s *session) CancelQueuedMessage(id string) bool {
+	taken := s.messageOutbox().take(func(m *sdata.QueuedMessage) bool {
+		return m.ID == id
+	})
+
+	for _, m := range taken {
+		s.publishEvent(events.Outbox{Type: events.QueuedMessageCancelled, Message: *m})
+	}
+
+	return len(taken) > 0
+}
+
+// flushOutbox sends the queued messages that don't need encryption, and starts encrypted conversations
+// with the peers that have messages waiting for one
+func (s *session) flushOutbox() {
+	o := s.messageOutbox()
+	plain := o.take(func(m *sdata.QueuedMessage) bool {
+		return !m.Encrypted
+	})
+
+	for i, m := range plain {
+		if !s.sendQueued(m, nil) {
+			o.putBack(plain[i:])
+			return
+		}
+	}
+
+	started := map[string]bool{}
+	for _, m := range o.all() {
+		bare := jid.Parse(m.Peer).NoResource().String()
+		if started[bare] {
+			continue
+		}
+		started[bare] = true
+
+		peer := jid.Parse(m.Peer)
+		c, _ := s.convManager.EnsureConversationWith(peer, nil)
+		if c.IsEncrypted() {
+			s.flushEncryptedOutboxFor(peer, c)
+			continue
+		}
+
+		if err := c.StartEncryptedChat(); err != nil {
+			s.log.WithError(err).WithField("peer", peer).Warn("couldn't start an encrypted conversation for the queued messages")
+		}
+	}
+}
+
+// flushEncryptedOutboxFor sends the queued messages to the peer once we have an encrypted conversation with them
+func (s *session) flushEncryptedOutboxFor(peer jid.Any, c otrclient.Conversation) {
+	if c == nil || !c.IsEncrypted() {
+		return
+	}
+
+	bare := peer.NoResource().String()
+	o := s.messageOutbox()
+	ms := o.take(func(m *sdata.QueuedMessage) bool {
+		return jid.Parse(m.Peer).NoResource().String() == bare
+	})
+
+	for i, m := range ms {
+		if !s.sendQueued(m, c) {
+			o.putBack(ms[i:])
+			return
+		}
+	}
+}
+
+func (s *session) sendQueued(m *sdata.QueuedMessage, c otrclient.Conversation) bool {
+	var trace int
+	var err error
+	if c != nil {
+		trace, err = c.Send([]byte(m.Body))
+		if err == nil {
+			s.recordOutgoingMessage(jid.Parse(m.Peer), c, m.Body)
+		}
+	} else {
+		trace, _, err = s.EncryptAndSendTo(jid.Parse(m.Peer), m.Body)
+	}
+
+	if err != nil {
+		s.log.WithError(err).WithField("peer", m.Peer).Warn("couldn't send queued message")
+		return false
+	}
+
+	s.publishEvent(events.Outbox{Type: events.QueuedMessageSent, Message: *m, Tracer: trace})
+	return true
+}
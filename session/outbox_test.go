@@ -0,0 +1,221 @@
+package session
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/chadsec1/decoyim/config"
+	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/session/events"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	mck "github.com/stretchr/testify/mock"
+
+	. "gopkg.in/check.v1"
+)
+
+type OutboxSuite struct{}
+
+var _ = Suite(&OutboxSuite{})
+
+func outboxTestSession(c *C) (*session, func()) {
+	dir, err := ioutil.TempDir("", "decoyim-outbox-")
+	c.Assert(err, IsNil)
+
+	conf, _, _ := config.LoadOrCreate(filepath.Join(dir, "accounts.json"), nil)
+	l, _ := test.NewNullLogger()
+
+	return &session{
+		log:           l,
+		config:        conf,
+		accountConfig: &config.Account{Account: "me@example.org"},
+	}, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func (s *OutboxSuite) Test_session_QueueMessage_keepsTheMessageOnDisk(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+
+	observer := make(chan interface{}, 1)
+	sess.Subscribe(observer)
+
+	m, err := sess.QueueMessage(jid.Parse("friend@example.org/phone"), "are you there?")
+	c.Assert(err, IsNil)
+	c.Assert(m.ID, Not(Equals), "")
+	c.Assert(m.Encrypted, Equals, false)
+
+	ev := (<-observer).(events.Outbox)
+	c.Assert(ev.Type, Equals, events.MessageQueued)
+	c.Assert(ev.Message, DeepEquals, m)
+
+	c.Assert(sess.config.DataFile(outboxFileName("me@example.org")).Exists(), Equals, true)
+
+	restarted := &session{
+		config:        sess.config,
+		accountConfig: sess.accountConfig,
+	}
+	queued := restarted.QueuedMessagesFor(jid.Parse("friend@example.org"))
+	c.Assert(queued, HasLen, 1)
+	c.Assert(queued[0].ID, Equals, m.ID)
+	c.Assert(queued[0].Body, Equals, "are you there?")
+	c.Assert(queued[0].Peer, Equals, "friend@example.org/phone")
+	c.Assert(restarted.QueuedMessagesFor(jid.Parse("someone.else@example.org")), HasLen, 0)
+}
+
+func (s *OutboxSuite) Test_session_QueueMessage_requiresEncryptionIfThePeerShouldBeEncrypted(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+	sess.accountConfig.AlwaysEncryptWith = []string{"friend@example.org"}
+
+	m, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "secret")
+	c.Assert(m.Encrypted, Equals, true)
+
+	m, _ = sess.QueueMessage(jid.Parse("acquaintance@example.org"), "not so secret")
+	c.Assert(m.Encrypted, Equals, false)
+}
+
+func (s *OutboxSuite) Test_session_CancelQueuedMessage_removesTheMessage(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+
+	m, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "oops")
+	observer := make(chan interface{}, 1)
+	sess.Subscribe(observer)
+
+	c.Assert(sess.CancelQueuedMessage(m.ID), Equals, true)
+	c.Assert(sess.CancelQueuedMessage(m.ID), Equals, false)
+
+	ev := (<-observer).(events.Outbox)
+	c.Assert(ev.Type, Equals, events.QueuedMessageCancelled)
+	c.Assert(ev.Message.ID, Equals, m.ID)
+
+	c.Assert(sess.QueuedMessagesFor(jid.Parse("friend@example.org")), HasLen, 0)
+	c.Assert(sess.config.DataFile(outboxFileName("me@example.org")).Exists(), Equals, false)
+}
+
+func (s *OutboxSuite) Test_session_CancelQueuedMessage_keepsTheOtherMessages(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+
+	first, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "first")
+	second, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "second")
+	third, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "third")
+
+	c.Assert(sess.CancelQueuedMessage(second.ID), Equals, true)
+
+	restarted := &session{
+		config:        sess.config,
+		accountConfig: sess.accountConfig,
+	}
+	queued := restarted.QueuedMessagesFor(jid.Parse("friend@example.org"))
+	c.Assert(queued, HasLen, 2)
+	c.Assert(queued[0].ID, Equals, first.ID)
+	c.Assert(queued[1].ID, Equals, third.ID)
+}
+
+func (s *OutboxSuite) Test_session_CancelQueuedMessage_leavesNoCopyOfTheMessageBehind(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+
+	_, _ = sess.QueueMessage(jid.Parse("friend@example.org"), "first")
+	second, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "never meant to be sent")
+	_, _ = sess.QueueMessage(jid.Parse("friend@example.org"), "third")
+
+	c.Assert(sess.CancelQueuedMessage(second.ID), Equals, true)
+
+	files, _ := filepath.Glob(filepath.Join(sess.config.DataDir(""), "outbox-*"))
+	c.Assert(files, HasLen, 1)
+	content, _ := ioutil.ReadFile(files[0])
+	c.Assert(string(content), Not(Matches), "(?s).*never meant to be sent.*")
+}
+
+func (s *OutboxSuite) Test_session_flushOutbox_sendsPlainMessagesAndWaitsForEncryption(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+	sess.accountConfig.AlwaysEncryptWith = []string{"friend@example.org"}
+
+	_, _ = sess.QueueMessage(jid.Parse("acquaintance@example.org"), "hello")
+	_, _ = sess.QueueMessage(jid.Parse("friend@example.org"), "secret")
+
+	var sent []string
+	plainConv := &mockConv{}
+	plainConv.send = func(v []byte) (int, error) {
+		sent = append(sent, string(v))
+		return 1, nil
+	}
+
+	notYetEncrypted := &mockConv{isEncrypted: func() bool { return false }}
+
+	mcm := &mockConvManager{}
+	mcm.On("EnsureConversationWith", jid.Parse("acquaintance@example.org"), mck.Anything).Return(plainConv, false).Once()
+	mcm.On("EnsureConversationWith", jid.Parse("friend@example.org"), mck.Anything).Return(notYetEncrypted, false).Once()
+	sess.convManager = mcm
+	sess.connStatus = CONNECTED
+
+	sess.flushOutbox()
+
+	c.Assert(sent, DeepEquals, []string{"hello"})
+	c.Assert(sess.QueuedMessagesFor(jid.Parse("acquaintance@example.org")), HasLen, 0)
+	c.Assert(sess.QueuedMessagesFor(jid.Parse("friend@example.org")), HasLen, 1)
+	mcm.AssertExpectations(c)
+
+	encrypted := &mockConv{isEncrypted: func() bool { return true }}
+	encrypted.send = func(v []byte) (int, error) {
+		sent = append(sent, "encrypted: "+string(v))
+		return 2, nil
+	}
+
+	sess.flushEncryptedOutboxFor(jid.Parse("friend@example.org/laptop"), encrypted)
+
+	c.Assert(sent, DeepEquals, []string{"hello", "encrypted: secret"})
+	c.Assert(sess.QueuedMessagesFor(jid.Parse("friend@example.org")), HasLen, 0)
+}
+
+func (s *OutboxSuite) Test_session_flushEncryptedOutboxFor_keepsTheMessagesThatFailed(c *C) {
+	sess, done := outboxTestSession(c)
+	l, hook := test.NewNullLogger()
+	sess.log = l
+	defer done()
+
+	first, _ := sess.QueueMessage(jid.Parse("friend@example.org"), "first")
+	_, _ = sess.QueueMessage(jid.Parse("friend@example.org"), "second")
+
+	calls := 0
+	conv := &mockConv{isEncrypted: func() bool { return true }}
+	conv.send = func(v []byte) (int, error) {
+		calls++
+		if calls == 2 {
+			return 0, errors.New("marker error")
+		}
+		return calls, nil
+	}
+
+	sess.flushEncryptedOutboxFor(jid.Parse("friend@example.org/laptop"), conv)
+
+	queued := sess.QueuedMessagesFor(jid.Parse("friend@example.org"))
+	c.Assert(queued, HasLen, 1)
+	c.Assert(queued[0].Body, Equals, "second")
+	c.Assert(queued[0].ID, Not(Equals), first.ID)
+	c.Assert(hook.LastEntry().Level, Equals, log.WarnLevel)
+}
+
+func (s *OutboxSuite) Test_outbox_doesntOverwriteAFileItCouldntRead(c *C) {
+	sess, done := outboxTestSession(c)
+	defer done()
+
+	f := sess.config.DataFile(outboxFileName("me@example.org"))
+	c.Assert(f.Store([]byte("this is not what you think")), IsNil)
+
+	_, err := sess.QueueMessage(jid.Parse("friend@example.org"), "hello")
+	c.Assert(err, NotNil)
+
+	content, _ := f.Load()
+	c.Assert(string(content), Equals, "this is not what you think")
+	c.Assert(sess.messageOutbox().all(), HasLen, 1)
+	c.Assert(sess.messageOutbox().all()[0], FitsTypeOf, &sdata.QueuedMessage{})
+}
@@ -0,0 +1,261 @@
+package session
+
+import (
+	"bytes"
+	"encoding/xml"
+
+	"github.com/chadsec1/decoyim/i18n"
+	"github.com/chadsec1/decoyim/session/access"
+	sdata "github.com/chadsec1/decoyim/session/data"
+	"github.com/chadsec1/decoyim/xmpp/data"
+	xi "github.com/chadsec1/decoyim/xmpp/interfaces"
+	"github.com/chadsec1/decoyim/xmpp/jid"
+)
+
+// The private XML storage can't be listed, so only the kinds of data we know about are exported
+var portablePrivateElements = []xml.Name{
+	{Space: "storage:bookmarks", Local: "storage"},
+	{Space: "storage:rosternotes", Local: "storage"},
+	{Space: "roster:delimiter", Local: "roster"},
+}
+
+// portablePubSubNodes are the personal eventing nodes that are exported. Bookmarks are kept in one of them,
+// depending on the client that wrote them.
+var portablePubSubNodes = []string{
+	"urn:xmpp:bookmarks:1",
+	"storage:bookmarks",
+}
+
+// awaitIQResult waits for the reply to an IQ, and returns it if it's a result
+func awaitIQResult(reply <-chan data.Stanza) (*data.ClientIQ, error) {
+	stanza, ok := <-reply
+	if !ok {
+		return nil, ErrInvalidInformationQueryRequest
+	}
+
+	iq, ok := stanza.Value.(*data.ClientIQ)
+	if !ok {
+		return nil, ErrUnexpectedResponse
+	}
+
+	if iq.Type == "error" {
+		return nil, ErrInformationQueryResponse
+	}
+
+	return iq, nil
+}
+
+func sendIQAndAwaitResult(conn xi.Conn, typ string, query interface{}) (*data.ClientIQ, error) {
+	reply, _, err := conn.SendIQ("" /* to our own account */, typ, query)
+	if err != nil {
+		return nil, err
+	}
+	return awaitIQResult(reply)
+}
+
+func hasContent(e data.RawElement) bool {
+	return len(bytes.TrimSpace(e.Inner)) > 0
+}
+
+// ExportServerData returns what the server keeps for this account - the roster, the vCard, private XML storage
+// and bookmarks - in the format of XEP-0227. Only the roster is required, everything else is left out if the
+// server can't give it to us.
+func (s *session) ExportServerData() (*data.ServerData, error) {
+	conn, ok := s.connection()
+	if !ok {
+		return nil, &access.OfflineError{Msg: i18n.Local("The account has to be connected to reach the data on the server")}
+	}
+
+	account := jid.ParseBare(s.GetConfig().Account)
+	user := data.ServerDataUser{Name: account.Local().String()}
+
+	rosterReply, _, err := conn.RequestRoster()
+	if err != nil {
+		return nil, err
+	}
+	iq, err := awaitIQResult(rosterReply)
+	if err != nil {
+		return nil, err
+	}
+	user.Roster = &data.PortableRoster{}
+	if err := xml.Unmarshal(iq.Query, user.Roster); err != nil {
+		return nil, err
+	}
+
+	user.VCard = s.exportVCard(conn)
+	user.Private = s.exportPrivateData(conn)
+	user.PubSub = s.exportPubSubNodes(conn)
+
+	return &data.ServerData{
+		Hosts: []data.ServerDataHost{{
+			JID:   account.Host().String(),
+			Users: []data.ServerDataUser{user},
+		}},
+	}, nil
+}
+
+func (s *session) exportVCard(conn xi.Conn) *data.RawElement {
+	reply, _, err := conn.RequestVCard()
+	if err != nil {
+		return nil
+	}
+
+	iq, err := awaitIQResult(reply)
+	if err != nil {
+		s.log.WithError(err).Info("The server didn't give us the vCard to export")
+		return nil
+	}
+
+	vc := &data.RawElement{}
+	if err := xml.Unmarshal(iq.Query, vc); err != nil || !hasContent(*vc) {
+		return nil
+	}
+	return vc
+}
+
+func (s *session) exportPrivateData(conn xi.Conn) *data.PortablePrivateData {
+	result := &data.PortablePrivateData{}
+
+	for _, name := range portablePrivateElements {
+		iq, err := sendIQAndAwaitResult(conn, "get", data.PortablePrivateData{
+			Elements: []data.RawElement{{XMLName: name}},
+		})
+		if err != nil {
+			s.log.WithError(err).WithField("namespace", name.Space).Info("The server didn't give us the private data to export")
+			continue
+		}
+
+		var pd data.PortablePrivateData
+		if err := xml.Unmarshal(iq.Query, &pd); err != nil {
+			continue
+		}
+		for _, e := range pd.Elements {
+			if hasContent(e) {
+				result.Elements = append(result.Elements, e)
+			}
+		}
+	}
+
+	if len(result.Elements) == 0 {
+		return nil
+	}
+	return result
+}
+
+func (s *session) exportPubSubNodes(conn xi.Conn) *data.PortablePubSub {
+	result := &data.PortablePubSub{}
+
+	for _, node := range portablePubSubNodes {
+		iq, err := sendIQAndAwaitResult(conn, "get", data.PubSubItemsQuery{
+			Items: data.PubSubItemsToSend{Node: node},
+		})
+		if err != nil {
+			// Servers answer with an error for nodes that don't exist
+			continue
+		}
+
+		var ps data.PortablePubSub
+		if err := xml.Unmarshal(iq.Query, &ps); err != nil {
+			continue
+		}
+		for _, n := range ps.Nodes {
+			if len(n.Items) > 0 {
+				result.Nodes = append(result.Nodes, n)
+			}
+		}
+	}
+
+	if len(result.Nodes) == 0 {
+		return nil
+	}
+	return result
+}
+
+// privateNodeOptions makes sure the nodes we publish to keep their items, and only show them to ourselves
+func privateNodeOptions() *data.PubSubPublishOptions {
+	return &data.PubSubPublishOptions{
+		Form: data.Form{
+			Type: "submit",
+			Fields: []data.FormFieldX{
+				{Var: "FORM_TYPE", Type: "hidden", Values: []string{"http://jabber.org/protocol/pubsub#publish-options"}},
+				{Var: "pubsub#persist_items", Values: []string{"true"}},
+				{Var: "pubsub#access_model", Values: []string{"whitelist"}},
+			},
+		},
+	}
+}
+
+func asksForPresenceOf(item data.PortableRosterItem) bool {
+	return item.Subscription == "to" || item.Subscription == "both" || item.Ask == "subscribe"
+}
+
+func givesPresenceTo(item data.PortableRosterItem) bool {
+	return item.Subscription == "from" || item.Subscription == "both"
+}
+
+// ImportServerData replays data exported with ExportServerData into this account, which is usually on another
+// server. The contacts are added to the roster, and are asked again to let us see their presence, with the
+// given message. Contacts that could see our presence before are approved in advance.
+// Requests the server refuses are counted, but don't stop the import.
+func (s *session) ImportServerData(u *data.ServerDataUser, subscriptionMessage string) (*sdata.ServerDataImport, error) {
+	conn, ok := s.connection()
+	if !ok {
+		return nil, &access.OfflineError{Msg: i18n.Local("The account has to be connected to reach the data on the server")}
+	}
+
+	res := &sdata.ServerDataImport{}
+
+	set := func(query interface{}) bool {
+		_, err := sendIQAndAwaitResult(conn, "set", query)
+		if err != nil {
+			s.log.WithError(err).Warn("The server refused data we were importing")
+			res.Failed++
+		}
+		return err == nil
+	}
+
+	if u.Roster != nil {
+		for _, item := range u.Roster.Items {
+			if !set(data.RosterRequest{Item: data.RosterRequestItem{Jid: item.Jid, Name: item.Name, Group: item.Group}}) {
+				continue
+			}
+			res.Contacts++
+
+			peer := jid.NR(item.Jid)
+			if givesPresenceTo(item) {
+				_ = conn.SendPresence(peer.String(), "subscribed", "", "")
+			}
+			if asksForPresenceOf(item) && s.RequestPresenceSubscription(peer, subscriptionMessage) == nil {
+				res.SubscriptionsRequested++
+			}
+		}
+	}
+
+	if u.VCard != nil && set(u.VCard) {
+		res.VCard = true
+	}
+
+	if u.Private != nil {
+		for _, e := range u.Private.Elements {
+			if set(data.PortablePrivateData{Elements: []data.RawElement{e}}) {
+				res.PrivateElements++
+			}
+		}
+	}
+
+	if u.PubSub != nil {
+		for _, n := range u.PubSub.Nodes {
+			for _, item := range n.Items {
+				publish := data.PubSubPublish{
+					Publish:        data.PubSubPublishNode{Node: n.Node, Item: item},
+					PublishOptions: privateNodeOptions(),
+				}
+				if set(publish) {
+					res.PubSubItems++
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
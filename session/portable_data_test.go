@@ -0,0 +1,164 @@
+package session
+
+import (
+	"encoding/xml"
+
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/xmpp/data"
+	"github.com/chadsec1/decoyim/xmpp/mock"
+	"github.com/sirupsen/logrus/hooks/test"
+	. "gopkg.in/check.v1"
+)
+
+type PortableDataSuite struct{}
+
+var _ = Suite(&PortableDataSuite{})
+
+type portableDataMockConn struct {
+	*mock.Conn
+
+	sendIQ    func(string, interface{}) *data.ClientIQ
+	presences [][]string
+}
+
+func replyWith(iq *data.ClientIQ) <-chan data.Stanza {
+	ch := make(chan data.Stanza, 1)
+	ch <- data.Stanza{Value: iq}
+	return ch
+}
+
+func resultIQ(query string) *data.ClientIQ {
+	return &data.ClientIQ{Type: "result", Query: []byte(query)}
+}
+
+func (m *portableDataMockConn) SendIQ(to, typ string, value interface{}) (<-chan data.Stanza, data.Cookie, error) {
+	return replyWith(m.sendIQ(typ, value)), 0, nil
+}
+
+func (m *portableDataMockConn) RequestRoster() (<-chan data.Stanza, data.Cookie, error) {
+	return replyWith(resultIQ(`<query xmlns='jabber:iq:roster'>
+<item jid='alice@example.org' name='Alice' subscription='both'><group>Friends</group></item>
+<item jid='bob@example.org' subscription='none' ask='subscribe'/>
+<item jid='carol@example.org' subscription='from'/>
+</query>`)), 0, nil
+}
+
+func (m *portableDataMockConn) RequestVCard() (<-chan data.Stanza, data.Cookie, error) {
+	return replyWith(resultIQ(`<vCard xmlns='vcard-temp'><FN>Someone</FN></vCard>`)), 0, nil
+}
+
+func (m *portableDataMockConn) SendPresence(to, typ, id, status string) error {
+	m.presences = append(m.presences, []string{to, typ, status})
+	return nil
+}
+
+func portableDataSession(conn *portableDataMockConn) *session {
+	l, _ := test.NewNullLogger()
+	return &session{
+		conn:          conn,
+		log:           l,
+		connStatus:    CONNECTED,
+		accountConfig: &config.Account{Account: "someone@example.org"},
+	}
+}
+
+func (s *PortableDataSuite) Test_session_ExportServerData_collectsWhatTheServerKeeps(c *C) {
+	conn := &portableDataMockConn{}
+	conn.sendIQ = func(typ string, value interface{}) *data.ClientIQ {
+		c.Assert(typ, Equals, "get")
+		switch q := value.(type) {
+		case data.PortablePrivateData:
+			if q.Elements[0].XMLName.Space == "storage:bookmarks" {
+				return resultIQ(`<query xmlns='jabber:iq:private'><storage xmlns='storage:bookmarks'><conference jid='room@conference.example.org'/></storage></query>`)
+			}
+			if q.Elements[0].XMLName.Space == "roster:delimiter" {
+				return resultIQ(`<query xmlns='jabber:iq:private'><roster xmlns='roster:delimiter'/></query>`)
+			}
+		case data.PubSubItemsQuery:
+			if q.Items.Node == "urn:xmpp:bookmarks:1" {
+				return resultIQ(`<pubsub xmlns='http://jabber.org/protocol/pubsub'><items node='urn:xmpp:bookmarks:1'><item id='room@conference.example.org'><conference xmlns='urn:xmpp:bookmarks:1'/></item></items></pubsub>`)
+			}
+		}
+		return &data.ClientIQ{Type: "error"}
+	}
+
+	sd, err := portableDataSession(conn).ExportServerData()
+	c.Assert(err, IsNil)
+
+	c.Assert(sd.Hosts, HasLen, 1)
+	c.Assert(sd.Hosts[0].JID, Equals, "example.org")
+	u, ok := sd.OnlyUser()
+	c.Assert(ok, Equals, true)
+	c.Assert(u.Name, Equals, "someone")
+	c.Assert(u.Roster.Items, HasLen, 3)
+	c.Assert(u.Roster.Items[0].Group, DeepEquals, []string{"Friends"})
+	c.Assert(string(u.VCard.Inner), Equals, "<FN>Someone</FN>")
+	c.Assert(u.Private.Elements, HasLen, 1)
+	c.Assert(u.Private.Elements[0].XMLName, Equals, xml.Name{Space: "storage:bookmarks", Local: "storage"})
+	c.Assert(u.PubSub.Nodes, HasLen, 1)
+	c.Assert(u.PubSub.Nodes[0].Items[0].ID, Equals, "room@conference.example.org")
+}
+
+func (s *PortableDataSuite) Test_session_ExportServerData_needsAConnection(c *C) {
+	sess := portableDataSession(&portableDataMockConn{})
+	sess.connStatus = DISCONNECTED
+
+	_, err := sess.ExportServerData()
+	c.Assert(err, ErrorMatches, ".*connected.*")
+}
+
+func (s *PortableDataSuite) Test_session_ImportServerData_replaysTheDataAndAsksForSubscriptionsAgain(c *C) {
+	var sd data.ServerData
+	c.Assert(xml.Unmarshal([]byte(portableDataExample), &sd), IsNil)
+	u, _ := sd.OnlyUser()
+
+	conn := &portableDataMockConn{}
+	var sent []interface{}
+	conn.sendIQ = func(typ string, value interface{}) *data.ClientIQ {
+		c.Assert(typ, Equals, "set")
+		sent = append(sent, value)
+		if _, ok := value.(data.PubSubPublish); ok {
+			return &data.ClientIQ{Type: "error"}
+		}
+		return resultIQ("")
+	}
+
+	res, err := portableDataSession(conn).ImportServerData(u, "It's me, on my new server")
+	c.Assert(err, IsNil)
+
+	c.Assert(res.Contacts, Equals, 2)
+	c.Assert(res.SubscriptionsRequested, Equals, 2)
+	c.Assert(res.VCard, Equals, true)
+	c.Assert(res.PrivateElements, Equals, 1)
+	c.Assert(res.PubSubItems, Equals, 0)
+	c.Assert(res.Failed, Equals, 1)
+
+	c.Assert(sent[0], DeepEquals, data.RosterRequest{Item: data.RosterRequestItem{Jid: "alice@example.org", Name: "Alice", Group: []string{"Friends"}}})
+	c.Assert(sent, HasLen, 5)
+
+	c.Assert(conn.presences, DeepEquals, [][]string{
+		{"alice@example.org", "subscribed", ""},
+		{"alice@example.org", "subscribe", "It's me, on my new server"},
+		{"bob@example.org", "subscribe", "It's me, on my new server"},
+	})
+}
+
+const portableDataExample = `<server-data xmlns='urn:xmpp:pie:0'>
+  <host jid='example.org'>
+    <user name='someone'>
+      <query xmlns='jabber:iq:roster'>
+        <item jid='alice@example.org' name='Alice' subscription='both'><group>Friends</group></item>
+        <item jid='bob@example.org' subscription='none' ask='subscribe'/>
+      </query>
+      <vCard xmlns='vcard-temp'><FN>Someone</FN></vCard>
+      <query xmlns='jabber:iq:private'>
+        <storage xmlns='storage:bookmarks'><conference jid='room@conference.example.org'/></storage>
+      </query>
+      <pubsub xmlns='http://jabber.org/protocol/pubsub'>
+        <items node='urn:xmpp:bookmarks:1'>
+          <item id='room@conference.example.org'><conference xmlns='urn:xmpp:bookmarks:1'/></item>
+        </items>
+      </pubsub>
+    </user>
+  </host>
+</server-data>`
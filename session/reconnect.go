@@ -1,25 +1,248 @@
 package session
 
 import (
+	"math"
 	"math/rand"
+	"sync"
 	"time"
+
+	"github.com/chadsec1/decoyim/config"
+	ournet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/sasl"
+	"github.com/chadsec1/decoyim/session/events"
+	"github.com/chadsec1/decoyim/tls"
+	"github.com/chadsec1/decoyim/xmpp/errors"
 )
 
-var calculateRandomDelay = func() time.Duration {
-	return 10*time.Second + time.Duration(rand.Int31n(7643))*time.Millisecond
+// ReconnectPolicy decides how long to wait between the automatic attempts to connect again
+type ReconnectPolicy struct {
+	// InitialDelay is the delay before the first attempt
+	InitialDelay time.Duration
+	// MaxDelay is the longest we will wait between two attempts
+	MaxDelay time.Duration
+	// Multiplier is how much the delay grows with every failed attempt
+	Multiplier float64
+	// Jitter is the fraction of the delay that will be added randomly, so that accounts don't all try at the same time
+	Jitter float64
+}
+
+// DefaultReconnectPolicy is the reconnect policy used by all sessions
+var DefaultReconnectPolicy = ReconnectPolicy{
+	InitialDelay: 10 * time.Second,
+	MaxDelay:     10 * time.Minute,
+	Multiplier:   2,
+	Jitter:       0.75,
+}
+
+var randomFraction = rand.Float64
+
+// Delay returns how long to wait before the given attempt, counting from zero
+func (p ReconnectPolicy) Delay(attempt int) time.Duration {
+	d := float64(p.InitialDelay) * math.Pow(p.Multiplier, float64(attempt))
+	if d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+
+	return time.Duration(d + d*p.Jitter*randomFraction())
+}
+
+// ConnectionErrorClass says whether it makes sense to try connecting again after an error
+type ConnectionErrorClass int
+
+const (
+	// RetryableConnectionError is an error that might go away by itself, like a network failure
+	RetryableConnectionError ConnectionErrorClass = iota
+	// FatalConnectionError is an error that will not go away until the user changes something
+	FatalConnectionError
+)
+
+var fatalConnectionErrors = []error{
+	errors.ErrAuthenticationFailed,
+	errors.ErrGoogleAuthenticationFailed,
+	sasl.ErrMechanismDowngrade,
+	config.ErrDNSWouldLeak,
+	config.ErrTorOnlyNeedsLocalProxy,
+	config.ErrDoHResolverNeedsURL,
+	config.ErrTorResolverNeedsTorProxy,
+	ournet.ErrWouldBypassTor,
+}
+
+// ClassifyConnectionError returns whether trying to connect again after the given error makes sense
+func ClassifyConnectionError(err error) ConnectionErrorClass {
+	for _, e := range fatalConnectionErrors {
+		if err == e {
+			return FatalConnectionError
+		}
+	}
+
+	if tls.IsCertificateRejected(err) {
+		return FatalConnectionError
+	}
+
+	return RetryableConnectionError
+}
+
+// reconnector keeps track of the automatic attempts to connect again
+type reconnector struct {
+	policy ReconnectPolicy
+
+	attempts  int
+	stopped   bool
+	lastError error
+
+	now chan bool
+
+	sync.Mutex
 }
 
-var randomDelayChannel = func() <-chan time.Time {
-	return time.After(calculateRandomDelay())
+func newReconnector(p ReconnectPolicy) *reconnector {
+	return &reconnector{
+		policy: p,
+		now:    make(chan bool, 1),
+	}
+}
+
+func (r *reconnector) delay() time.Duration {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.policy.Delay(r.attempts)
+}
+
+func (r *reconnector) shouldTry() bool {
+	r.Lock()
+	defer r.Unlock()
+
+	return !r.stopped
+}
+
+func (r *reconnector) attempted() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.attempts++
+}
+
+// succeeded resets the backoff after a successful connection
+func (r *reconnector) succeeded() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.attempts = 0
+	r.stopped = false
+	r.lastError = nil
+}
+
+// failed records the error from a connection attempt, and stops trying if it's fatal
+func (r *reconnector) failed(err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.lastError = err
+	if ClassifyConnectionError(err) == FatalConnectionError {
+		r.stopped = true
+	}
+}
+
+func (r *reconnector) stop() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.stopped = true
+}
+
+// resume starts trying from scratch, and tries right away if immediately is true
+func (r *reconnector) resume(immediately bool) {
+	r.Lock()
+	r.attempts = 0
+	r.stopped = false
+	r.Unlock()
+
+	if immediately {
+		select {
+		case r.now <- true:
+		default:
+		}
+	}
+}
+
+func (r *reconnector) status(next time.Time) events.Reconnect {
+	r.Lock()
+	defer r.Unlock()
+
+	return events.Reconnect{
+		Attempt:     r.attempts + 1,
+		NextAttempt: next,
+		Stopped:     r.stopped,
+		LastError:   r.lastError,
+	}
+}
+
+var reconnectDelayChannel = func(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (s *session) reconnection() *reconnector {
+	s.reconnectLock.Lock()
+	defer s.reconnectLock.Unlock()
+
+	if s.reconnect == nil {
+		s.reconnect = newReconnector(DefaultReconnectPolicy)
+	}
+
+	return s.reconnect
+}
+
+func (s *session) wantsToReconnect() bool {
+	return s.IsDisconnected() && s.wantToBeOnline
 }
 
 func checkReconnect(s *session) {
-	_, cont := <-randomDelayChannel()
-	for cont {
-		if s.IsDisconnected() && s.wantToBeOnline {
+	r := s.reconnection()
+	for {
+		d := r.delay()
+		if s.wantsToReconnect() && r.shouldTry() {
+			s.publishEvent(r.status(time.Now().Add(d)))
+		}
+
+		select {
+		case _, cont := <-reconnectDelayChannel(d):
+			if !cont {
+				return
+			}
+		case <-r.now:
+		}
+
+		if s.wantsToReconnect() && r.shouldTry() {
+			r.attempted()
 			s.connector.Connect()
 		}
+	}
+}
+
+// ReconnectNow tries to connect again right away, even if we had stopped trying
+func (s *session) ReconnectNow() {
+	s.wantToBeOnline = true
+	s.reconnection().resume(true)
+}
 
-		_, cont = <-randomDelayChannel()
+// StopReconnecting stops the automatic attempts to connect again, until the user connects manually
+func (s *session) StopReconnecting() {
+	r := s.reconnection()
+	r.stop()
+	s.publishEvent(r.status(time.Time{}))
+}
+
+// NetworkUp should be called when the network becomes available, so that we try to connect again right away
+// instead of waiting for the backoff - unless the last failure needs the user to change something
+func (s *session) NetworkUp() {
+	r := s.reconnection()
+	if s.wantsToReconnect() && r.shouldTry() {
+		r.resume(true)
 	}
 }
+
+// IsReconnecting returns true if the session is disconnected and trying to connect again automatically
+func (s *session) IsReconnecting() bool {
+	return s.wantsToReconnect() && s.reconnection().shouldTry()
+}
@@ -1,8 +1,14 @@
 package session
 
 import (
+	goerrors "errors"
 	"time"
 
+	"github.com/chadsec1/decoyim/config"
+	"github.com/chadsec1/decoyim/sasl"
+	"github.com/chadsec1/decoyim/session/events"
+	"github.com/chadsec1/decoyim/tls"
+	"github.com/chadsec1/decoyim/xmpp/errors"
 	. "gopkg.in/check.v1"
 )
 
@@ -10,27 +16,55 @@ type ReconnectSuite struct{}
 
 var _ = Suite(&ReconnectSuite{})
 
-func (s *ReconnectSuite) Test_calculateRandomDelay_works(c *C) {
-	del := calculateRandomDelay()
-	c.Assert(del > time.Duration(0), Equals, true)
+func (s *ReconnectSuite) Test_DefaultReconnectPolicy_firstDelayIsBetweenTenAndEighteenSeconds(c *C) {
+	del := DefaultReconnectPolicy.Delay(0)
+	c.Assert(del >= time.Duration(10)*time.Second, Equals, true)
 	c.Assert(del < time.Duration(18)*time.Second, Equals, true)
 }
 
-func (s *ReconnectSuite) Test_randomDelayChannel_works(c *C) {
-	cc := randomDelayChannel()
-	c.Assert(cc, Not(IsNil))
+func (s *ReconnectSuite) Test_ReconnectPolicy_Delay_growsExponentiallyUpToTheMaximum(c *C) {
+	orgRandomFraction := randomFraction
+	defer func() {
+		randomFraction = orgRandomFraction
+	}()
+	randomFraction = func() float64 { return 0 }
+
+	p := ReconnectPolicy{InitialDelay: time.Second, MaxDelay: 30 * time.Second, Multiplier: 2, Jitter: 0.5}
+
+	c.Assert(p.Delay(0), Equals, time.Second)
+	c.Assert(p.Delay(1), Equals, 2*time.Second)
+	c.Assert(p.Delay(4), Equals, 16*time.Second)
+	c.Assert(p.Delay(5), Equals, 30*time.Second)
+	c.Assert(p.Delay(5000), Equals, 30*time.Second)
+
+	randomFraction = func() float64 { return 1 }
+	c.Assert(p.Delay(1), Equals, 3*time.Second)
+	c.Assert(p.Delay(5000), Equals, 45*time.Second)
+}
+
+func (s *ReconnectSuite) Test_ClassifyConnectionError(c *C) {
+	c.Assert(ClassifyConnectionError(errors.ErrConnectionFailed), Equals, RetryableConnectionError)
+	c.Assert(ClassifyConnectionError(errors.ErrTCPBindingFailed), Equals, RetryableConnectionError)
+	c.Assert(ClassifyConnectionError(config.ErrTorNotRunning), Equals, RetryableConnectionError)
+	c.Assert(ClassifyConnectionError(errors.CreateErrFailedToConnect("example.org:5222", goerrors.New("connection refused"))), Equals, RetryableConnectionError)
+
+	c.Assert(ClassifyConnectionError(errors.ErrAuthenticationFailed), Equals, FatalConnectionError)
+	c.Assert(ClassifyConnectionError(sasl.ErrMechanismDowngrade), Equals, FatalConnectionError)
+	c.Assert(ClassifyConnectionError(config.ErrTorOnlyNeedsLocalProxy), Equals, FatalConnectionError)
+	c.Assert(ClassifyConnectionError(tls.ErrDANERequired), Equals, FatalConnectionError)
+	c.Assert(ClassifyConnectionError(tls.RejectCertificate("you manually denied it")), Equals, FatalConnectionError)
 }
 
 func (s *ReconnectSuite) Test_checkReconnect_whileConnected(c *C) {
-	orgRandomDelayChannel := randomDelayChannel
+	orgReconnectDelayChannel := reconnectDelayChannel
 	defer func() {
-		randomDelayChannel = orgRandomDelayChannel
+		reconnectDelayChannel = orgReconnectDelayChannel
 	}()
 
 	cc := make(chan time.Time, 1)
 	calledNum := 0
 	done := make(chan bool)
-	randomDelayChannel = func() <-chan time.Time {
+	reconnectDelayChannel = func(time.Duration) <-chan time.Time {
 		calledNum++
 		if calledNum == 2 {
 			done <- true
@@ -59,15 +93,15 @@ func (s *ReconnectSuite) Test_checkReconnect_whileConnected(c *C) {
 }
 
 func (s *ReconnectSuite) Test_checkReconnect_whileDisconnected(c *C) {
-	orgRandomDelayChannel := randomDelayChannel
+	orgReconnectDelayChannel := reconnectDelayChannel
 	defer func() {
-		randomDelayChannel = orgRandomDelayChannel
+		reconnectDelayChannel = orgReconnectDelayChannel
 	}()
 
 	cc := make(chan time.Time, 1)
 	calledNum := 0
 	done := make(chan bool)
-	randomDelayChannel = func() <-chan time.Time {
+	reconnectDelayChannel = func(time.Duration) <-chan time.Time {
 		calledNum++
 		if calledNum == 2 {
 			done <- true
@@ -97,15 +131,15 @@ func (s *ReconnectSuite) Test_checkReconnect_whileDisconnected(c *C) {
 }
 
 func (s *ReconnectSuite) Test_checkReconnect_whileDisconnectedAndWantToBeOnline(c *C) {
-	orgRandomDelayChannel := randomDelayChannel
+	orgReconnectDelayChannel := reconnectDelayChannel
 	defer func() {
-		randomDelayChannel = orgRandomDelayChannel
+		reconnectDelayChannel = orgReconnectDelayChannel
 	}()
 
 	cc := make(chan time.Time, 1)
 	calledNum := 0
 	done := make(chan bool)
-	randomDelayChannel = func() <-chan time.Time {
+	reconnectDelayChannel = func(time.Duration) <-chan time.Time {
 		calledNum++
 		if calledNum == 2 {
 			done <- true
@@ -134,3 +168,155 @@ func (s *ReconnectSuite) Test_checkReconnect_whileDisconnectedAndWantToBeOnline(
 	c.Assert(calledNum, Equals, 2)
 	c.Assert(called, Equals, true)
 }
+
+func neverFiringDelayChannel(time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
+
+func (s *ReconnectSuite) Test_checkReconnect_stopsTryingAfterAFatalError(c *C) {
+	orgReconnectDelayChannel := reconnectDelayChannel
+	defer func() {
+		reconnectDelayChannel = orgReconnectDelayChannel
+	}()
+
+	cc := make(chan time.Time, 1)
+	reconnectDelayChannel = func(time.Duration) <-chan time.Time {
+		return cc
+	}
+
+	called := false
+	sess := &session{
+		connStatus:     DISCONNECTED,
+		wantToBeOnline: true,
+		connector: &mockConnector{
+			connect: func() {
+				called = true
+			},
+		},
+	}
+	sess.reconnection().failed(errors.ErrAuthenticationFailed)
+
+	cc <- time.Time{}
+	close(cc)
+	checkReconnect(sess)
+
+	c.Assert(called, Equals, false)
+	c.Assert(sess.IsReconnecting(), Equals, false)
+}
+
+func (s *ReconnectSuite) Test_checkReconnect_publishesTheNextAttemptAndBacksOff(c *C) {
+	orgReconnectDelayChannel := reconnectDelayChannel
+	defer func() {
+		reconnectDelayChannel = orgReconnectDelayChannel
+	}()
+
+	var delays []time.Duration
+	cc := make(chan time.Time, 2)
+	reconnectDelayChannel = func(d time.Duration) <-chan time.Time {
+		delays = append(delays, d)
+		return cc
+	}
+
+	connects := 0
+	sess := &session{
+		connStatus:     DISCONNECTED,
+		wantToBeOnline: true,
+		connector: &mockConnector{
+			connect: func() {
+				connects++
+			},
+		},
+	}
+	sess.reconnect = newReconnector(ReconnectPolicy{InitialDelay: time.Second, MaxDelay: time.Minute, Multiplier: 2})
+
+	observer := make(chan interface{}, 10)
+	sess.Subscribe(observer)
+
+	cc <- time.Time{}
+	cc <- time.Time{}
+	close(cc)
+	checkReconnect(sess)
+
+	c.Assert(connects, Equals, 2)
+	c.Assert(delays, DeepEquals, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second})
+
+	ev := (<-observer).(events.Reconnect)
+	c.Assert(ev.Attempt, Equals, 1)
+	c.Assert(ev.Stopped, Equals, false)
+	ev = (<-observer).(events.Reconnect)
+	c.Assert(ev.Attempt, Equals, 2)
+}
+
+func (s *ReconnectSuite) Test_session_ReconnectNow_triesRightAwayEvenAfterStopping(c *C) {
+	orgReconnectDelayChannel := reconnectDelayChannel
+	defer func() {
+		reconnectDelayChannel = orgReconnectDelayChannel
+	}()
+	reconnectDelayChannel = neverFiringDelayChannel
+
+	connected := make(chan bool, 1)
+	sess := &session{
+		connStatus: DISCONNECTED,
+		connector: &mockConnector{
+			connect: func() {
+				connected <- true
+			},
+		},
+	}
+	sess.StopReconnecting()
+	c.Assert(sess.IsReconnecting(), Equals, false)
+
+	go checkReconnect(sess)
+	sess.ReconnectNow()
+
+	c.Assert(<-connected, Equals, true)
+	c.Assert(sess.wantToBeOnline, Equals, true)
+}
+
+func (s *ReconnectSuite) Test_session_NetworkUp_resetsTheBackoff(c *C) {
+	sess := &session{
+		connStatus:     DISCONNECTED,
+		wantToBeOnline: true,
+	}
+	r := sess.reconnection()
+	r.attempted()
+	r.attempted()
+
+	sess.NetworkUp()
+
+	c.Assert(r.attempts, Equals, 0)
+	c.Assert(<-r.now, Equals, true)
+}
+
+func (s *ReconnectSuite) Test_session_NetworkUp_doesNothingAfterAFatalError(c *C) {
+	sess := &session{
+		connStatus:     DISCONNECTED,
+		wantToBeOnline: true,
+	}
+	r := sess.reconnection()
+	r.attempted()
+	r.failed(sasl.ErrMechanismDowngrade)
+
+	sess.NetworkUp()
+
+	c.Assert(r.attempts, Equals, 1)
+	c.Assert(len(r.now), Equals, 0)
+}
+
+func (s *ReconnectSuite) Test_session_StopReconnecting_publishesThatItStopped(c *C) {
+	sess := &session{
+		connStatus:     DISCONNECTED,
+		wantToBeOnline: true,
+	}
+	observer := make(chan interface{}, 1)
+	sess.Subscribe(observer)
+
+	sess.StopReconnecting()
+
+	ev := (<-observer).(events.Reconnect)
+	c.Assert(ev.Stopped, Equals, true)
+	c.Assert(sess.IsReconnecting(), Equals, false)
+
+	sess.SetWantToBeOnline(true)
+	c.Assert(sess.IsReconnecting(), Equals, true)
+}
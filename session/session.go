@@ -17,8 +17,10 @@ import (
 	"github.com/chadsec1/decoyim/i18n"
 	"github.com/chadsec1/decoyim/otrclient"
 	"github.com/chadsec1/decoyim/roster"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/session/access"
 	"github.com/chadsec1/decoyim/session/events"
+	"github.com/chadsec1/decoyim/session/history"
 	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/ui"
 	"github.com/chadsec1/decoyim/xmpp/data"
@@ -77,6 +79,16 @@ type session struct {
 
 	connector access.Connector
 
+	reconnect     *reconnector
+	reconnectLock sync.Mutex
+
+	outbox     *outbox
+	outboxLock sync.Mutex
+
+	history     *history.Store
+	historyErr  error
+	historyLock sync.Mutex
+
 	cmdManager  otrclient.CommandManager
 	convManager otrclient.ConversationManager
 
@@ -177,6 +189,7 @@ func Factory(c *config.ApplicationConfig, cu *config.Account, df func(tls.Verifi
 	s.ReloadKeys()
 	s.convManager = otrclient.NewConversationManager(s.newConversation, s, cu.Account, s.onOtrEventHandlerCreate, sessionLog.WithField("component", "otr"))
 	s.muc = newMUCManager(s.log, s.Conn, s.publishEvent)
+	s.muc.recordMessage = s.recordRoomMessage
 
 	go observe(s)
 	go checkReconnect(s)
@@ -593,6 +606,7 @@ func (s *session) receiveClientMessage(peer jid.Any, when time.Time, body string
 		out = ui.UnescapeNewlineTags(out)
 	}
 
+	s.recordIncomingMessage(peer, when, conversation, out)
 	s.messageReceived(peer, when, encrypted, out)
 }
 
@@ -851,7 +865,7 @@ func (s *session) onStatusConnecting() {
 }
 
 // Connect connects to the server and starts the main threads
-func (s *session) Connect(password string, verifier tls.Verifier) error {
+func (s *session) Connect(password string, verifier tls.Verifier, mechanismVerifier sasl.Verifier) error {
 	if !s.IsDisconnected() {
 		return nil
 	}
@@ -875,15 +889,18 @@ func (s *session) Connect(password string, verifier tls.Verifier) error {
 		"wantToBeOnline": s.wantToBeOnline,
 	}).Debug("Connect()")
 
-	conn, err := policy.Connect(password, resource, conf, verifier)
+	conn, err := policy.Connect(password, resource, conf, verifier, mechanismVerifier)
 	if err != nil {
 		s.log.WithError(err).Error("failed to connect")
 
 		s.setStatus(DISCONNECTED)
+		s.reconnection().failed(err)
 
 		return err
 	}
 
+	s.reconnection().succeeded()
+
 	if s.getConnStatus() == CONNECTING {
 		s.conn = conn
 		s.setStatus(CONNECTED)
@@ -898,6 +915,8 @@ func (s *session) Connect(password string, verifier tls.Verifier) error {
 		}()
 		go s.watchTimeout()
 		go s.watchStanzas()
+		go s.flushOutbox()
+		go s.applyHistoryRetention()
 	} else {
 		if s.conn != nil {
 			_ = s.conn.Close()
@@ -912,6 +931,9 @@ func (s *session) EncryptAndSendTo(peer jid.Any, message string) (trace int, del
 	if s.IsConnected() {
 		c, _ := s.convManager.EnsureConversationWith(peer, nil)
 		trace, err = c.Send([]byte(message))
+		if err == nil {
+			s.recordOutgoingMessage(peer, c, message)
+		}
 		delayed = c.EventHandler().ConsumeDelayedState(trace)
 		return
 	}
@@ -960,6 +982,9 @@ func (s *session) ConversationManager() otrclient.ConversationManager {
 
 func (s *session) SetWantToBeOnline(val bool) {
 	s.wantToBeOnline = val
+	if val {
+		s.reconnection().resume(false)
+	}
 }
 
 func (s *session) PrivateKeys() []otr3.PrivateKey {
@@ -3668,7 +3668,7 @@ func (s *SessionSuite) Test_session_Connect_works(c *C) {
 		},
 	}
 
-	res := sess.Connect("one", nil)
+	res := sess.Connect("one", nil, nil)
 
 	c.Assert(res, IsNil)
 	c.Assert(sess.resource, Equals, "hoho")
@@ -3707,7 +3707,7 @@ func (s *SessionSuite) Test_session_Connect_worksWithoutVCard(c *C) {
 		},
 	}
 
-	res := sess.Connect("one", nil)
+	res := sess.Connect("one", nil, nil)
 
 	c.Assert(res, IsNil)
 	c.Assert(sess.resource, Equals, "hoho")
@@ -3753,7 +3753,7 @@ func (s *SessionSuite) Test_session_Connect_closesIfWeChangeConnStatus(c *C) {
 		},
 	}
 
-	res := sess.Connect("one", nil)
+	res := sess.Connect("one", nil, nil)
 
 	c.Assert(res, IsNil)
 	c.Assert(sess.resource, Equals, "somewhere")
@@ -3788,7 +3788,7 @@ func (s *SessionSuite) Test_session_Connect_failsOnConnectionFailure(c *C) {
 		},
 	}
 
-	res := sess.Connect("one", nil)
+	res := sess.Connect("one", nil, nil)
 
 	c.Assert(res, ErrorMatches, "dialer marker failure")
 	c.Assert(sess.connStatus, Equals, DISCONNECTED)
@@ -3820,7 +3820,7 @@ func (s *SessionSuite) Test_session_Connect_doesntDoAnythingWhenConnected(c *C)
 		r:              roster.New(),
 	}
 
-	res := sess.Connect("one", nil)
+	res := sess.Connect("one", nil, nil)
 
 	c.Assert(res, IsNil)
 	c.Assert(hook.Entries, HasLen, 0)
@@ -18,8 +18,15 @@ type BasicVerifier struct {
 	HasCertificates       func() bool
 	NeedToCheckPins       bool
 	PinningPolicy         string
+	DANEPolicy            string
 }
 
+// ErrDANERequired is returned when the DANE policy requires a DANE match but the certificates couldn't be verified that way
+var ErrDANERequired = RejectCertificate("your DANE policy requires the server certificate to match a DNSSEC authenticated TLSA record")
+
+// ErrDANEMismatch is returned when there are DNSSEC authenticated TLSA records, but none of them match the certificates
+var ErrDANEMismatch = RejectCertificate("the server certificate doesn't match the DNSSEC authenticated TLSA records for the server")
+
 func verifyHostName(leafCert *x509.Certificate, originDomain string) error {
 	return leafCert.VerifyHostname(originDomain)
 }
@@ -43,7 +50,7 @@ func (v *BasicVerifier) canConnectInPresenceOfPins(certs []*x509.Certificate) er
 		return nil
 	case "deny": // We will never approve a new certificate and will fail immediately, not even asking the user
 		v.OnPinDeny()
-		return errors.New("tls: you have a pinning policy that stops us from connecting using this certificate")
+		return RejectCertificate("you have a pinning policy that stops us from connecting using this certificate")
 	case "add": // We will always add a new certificate to our list of certs
 		v.AddCert(certs[0])
 		return nil
@@ -59,17 +66,75 @@ func (v *BasicVerifier) canConnectInPresenceOfPins(certs []*x509.Certificate) er
 			return nil
 		}
 		v.OnPinDeny()
-		return errors.New("tls: you have a pinning policy that stops us from connecting using this certificate")
+		return RejectCertificate("you have a pinning policy that stops us from connecting using this certificate")
 	case "ask": // We will always ask
 		return v.AskPinning(certs)
 	}
 
 	v.OnPinDeny()
-	return errors.New("tls: you have a pinning policy that stops us from connecting using other certificates")
+	return RejectCertificate("you have a pinning policy that stops us from connecting using other certificates")
+}
+
+func (v *BasicVerifier) verifyCertWithAnchor(certs []*x509.Certificate, anchor *x509.Certificate) ([][]*x509.Certificate, error) {
+	roots := x509.NewCertPool()
+	roots.AddCert(anchor)
+
+	return v.verifyCert(certs, &tls.Config{RootCAs: roots})
+}
+
+func (v *BasicVerifier) acceptsDANE() bool {
+	return v.DANEPolicy == "opportunistic" || v.DANEPolicy == "require"
+}
+
+// verifyChain validates the certificates either through DANE or through the regular CA validation
+func (v *BasicVerifier) verifyChain(certs []*x509.Certificate, conf *tls.Config, originDomain string, dane *DANEResult) error {
+	if v.acceptsDANE() && dane.Verified() {
+		// RFC 7671, section 5.1: for DANE-EE the certificate names are not checked
+		if dane.Matched.Usage == DANEUsageDANEEE {
+			return nil
+		}
+
+		chains, err := v.verifyCertWithAnchor(certs, dane.Anchor)
+		if err != nil {
+			return v.VerifyFailure(certs, err)
+		}
+
+		if err = verifyHostName(chains[0][0], originDomain); err != nil {
+			return v.VerifyHostnameFailure(certs, originDomain, err)
+		}
+
+		return nil
+	}
+
+	if v.acceptsDANE() && dane.Mismatched() {
+		v.OnPinDeny()
+		return ErrDANEMismatch
+	}
+
+	if v.DANEPolicy == "require" {
+		v.OnPinDeny()
+		return ErrDANERequired
+	}
+
+	chains, err := v.verifyCert(certs, conf)
+	if err != nil {
+		return v.VerifyFailure(certs, err)
+	}
+
+	if err = verifyHostName(chains[0][0], originDomain); err != nil {
+		return v.VerifyHostnameFailure(certs, originDomain, err)
+	}
+
+	return nil
 }
 
 // Verify implements tls.Verifier
 func (v *BasicVerifier) Verify(state tls.ConnectionState, conf *tls.Config, originDomain string) error {
+	return v.VerifyWithDANE(state, conf, originDomain, nil)
+}
+
+// VerifyWithDANE implements tls.DANEVerifier
+func (v *BasicVerifier) VerifyWithDANE(state tls.ConnectionState, conf *tls.Config, originDomain string, dane *DANEResult) error {
 	if len(state.PeerCertificates) == 0 {
 		v.OnNoPeerCertificates()
 		return errors.New("tls: server has no certificates")
@@ -79,13 +144,8 @@ func (v *BasicVerifier) Verify(state tls.ConnectionState, conf *tls.Config, orig
 		return nil
 	}
 
-	chains, err := v.verifyCert(state.PeerCertificates, conf)
-	if err != nil {
-		return v.VerifyFailure(state.PeerCertificates, err)
-	}
-
-	if err = verifyHostName(chains[0][0], originDomain); err != nil {
-		return v.VerifyHostnameFailure(state.PeerCertificates, originDomain, err)
+	if err := v.verifyChain(state.PeerCertificates, conf, originDomain, dane); err != nil {
+		return err
 	}
 
 	if v.NeedToCheckPins {
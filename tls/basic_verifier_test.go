@@ -69,7 +69,9 @@ func (s *VerifierSuite) Test_BasicVerifier_canConnectInPresenceOfPins_withPinnin
 		},
 	}
 
-	c.Assert(v.canConnectInPresenceOfPins(nil), ErrorMatches, "tls: you have a pinning policy that stops us from connecting using this certificate")
+	err := v.canConnectInPresenceOfPins(nil)
+	c.Assert(err, ErrorMatches, "tls: you have a pinning policy that stops us from connecting using this certificate")
+	c.Assert(IsCertificateRejected(err), Equals, true)
 	c.Assert(called, Equals, true)
 }
 
@@ -323,3 +325,134 @@ func (s *VerifierSuite) Test_BasicVerifier_Verify_checksPinsForVerifiedDomain(c
 	c.Assert(e, ErrorMatches, "tls: you have a pinning policy that stops us from connecting using this certificate")
 	c.Assert(called, Equals, true)
 }
+
+func (s *VerifierSuite) Test_BasicVerifier_VerifyWithDANE_acceptsDANEEEWithoutCAValidation(c *C) {
+	cert := parseTestCert(testCert)
+	dane := &DANEResult{
+		Secure:  true,
+		Records: []TLSARecord{{Usage: DANEUsageDANEEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw}},
+	}
+	dane.Check([]*x509.Certificate{cert})
+
+	v := &BasicVerifier{
+		HasPinned: func([]*x509.Certificate) bool {
+			return false
+		},
+		DANEPolicy: "opportunistic",
+	}
+
+	e := v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, &tls.Config{}, "something.else.com", dane)
+	c.Assert(e, IsNil)
+}
+
+func (s *VerifierSuite) Test_BasicVerifier_VerifyWithDANE_validatesTheChainToTheDANETAAnchor(c *C) {
+	cert := parseTestCert(testCert)
+	ca := parseTestCert(testCAcert)
+	dane := &DANEResult{
+		Secure:  true,
+		Records: []TLSARecord{{Usage: DANEUsageDANETA, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: ca.Raw}},
+	}
+	certs := []*x509.Certificate{cert, ca}
+	dane.Check(certs)
+
+	v := &BasicVerifier{
+		HasPinned: func([]*x509.Certificate) bool {
+			return false
+		},
+		VerifyHostnameFailure: func([]*x509.Certificate, string, error) error {
+			return errors.New("hostname marker error")
+		},
+		DANEPolicy: "opportunistic",
+	}
+
+	c.Assert(v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: certs}, &tls.Config{}, "foo.bar.com", dane), IsNil)
+	c.Assert(v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: certs}, &tls.Config{}, "something.else.com", dane), ErrorMatches, "hostname marker error")
+}
+
+func (s *VerifierSuite) Test_BasicVerifier_VerifyWithDANE_ignoresDANEWithoutPolicy(c *C) {
+	cert := parseTestCert(testCert)
+	dane := &DANEResult{
+		Secure:  true,
+		Records: []TLSARecord{{Usage: DANEUsageDANEEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw}},
+	}
+	dane.Check([]*x509.Certificate{cert})
+
+	v := &BasicVerifier{
+		HasPinned: func([]*x509.Certificate) bool {
+			return false
+		},
+		VerifyFailure: func([]*x509.Certificate, error) error {
+			return errors.New("marker error")
+		},
+	}
+
+	e := v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, &tls.Config{}, "foo.bar.com", dane)
+	c.Assert(e, ErrorMatches, "marker error")
+}
+
+func (s *VerifierSuite) Test_BasicVerifier_VerifyWithDANE_failsWhenDANEIsRequiredButNotVerified(c *C) {
+	cert := parseTestCert(testCert)
+	roots := x509.NewCertPool()
+	_ = roots.AppendCertsFromPEM([]byte(testCAcert))
+
+	called := false
+	v := &BasicVerifier{
+		HasPinned: func([]*x509.Certificate) bool {
+			return false
+		},
+		OnPinDeny: func() {
+			called = true
+		},
+		DANEPolicy: "require",
+	}
+
+	e := v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, &tls.Config{RootCAs: roots}, "foo.bar.com", &DANEResult{})
+	c.Assert(e, Equals, ErrDANERequired)
+	c.Assert(called, Equals, true)
+}
+
+func (s *VerifierSuite) Test_BasicVerifier_VerifyWithDANE_failsWhenTheAuthenticatedRecordsDontMatch(c *C) {
+	cert := parseTestCert(testCert)
+	roots := x509.NewCertPool()
+	_ = roots.AppendCertsFromPEM([]byte(testCAcert))
+	dane := &DANEResult{
+		Secure:  true,
+		Records: []TLSARecord{{Usage: DANEUsageDANEEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: []byte("something else")}},
+	}
+	dane.Check([]*x509.Certificate{cert})
+
+	called := false
+	v := &BasicVerifier{
+		HasPinned: func([]*x509.Certificate) bool {
+			return false
+		},
+		OnPinDeny: func() {
+			called = true
+		},
+		DANEPolicy: "opportunistic",
+	}
+
+	e := v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, &tls.Config{RootCAs: roots}, "foo.bar.com", dane)
+	c.Assert(e, Equals, ErrDANEMismatch)
+	c.Assert(called, Equals, true)
+}
+
+func (s *VerifierSuite) Test_BasicVerifier_VerifyWithDANE_fallsBackToCAValidationWithoutAuthenticatedRecords(c *C) {
+	cert := parseTestCert(testCert)
+	roots := x509.NewCertPool()
+	_ = roots.AppendCertsFromPEM([]byte(testCAcert))
+	dane := &DANEResult{
+		Records: []TLSARecord{{Usage: DANEUsageDANEEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: []byte("something else")}},
+	}
+	dane.Check([]*x509.Certificate{cert})
+
+	v := &BasicVerifier{
+		HasPinned: func([]*x509.Certificate) bool {
+			return false
+		},
+		DANEPolicy: "opportunistic",
+	}
+
+	e := v.VerifyWithDANE(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, &tls.Config{RootCAs: roots}, "foo.bar.com", dane)
+	c.Assert(e, IsNil)
+}
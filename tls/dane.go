@@ -0,0 +1,130 @@
+package tls
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+)
+
+// Certificate usages for TLSA records, RFC 6698 section 2.1.1 and RFC 7218
+const (
+	DANEUsagePKIXTA uint8 = 0
+	DANEUsagePKIXEE uint8 = 1
+	DANEUsageDANETA uint8 = 2
+	DANEUsageDANEEE uint8 = 3
+)
+
+// Selectors for TLSA records, RFC 6698 section 2.1.2
+const (
+	DANESelectorCert uint8 = 0
+	DANESelectorSPKI uint8 = 1
+)
+
+// Matching types for TLSA records, RFC 6698 section 2.1.3
+const (
+	DANEMatchingFull   uint8 = 0
+	DANEMatchingSHA256 uint8 = 1
+	DANEMatchingSHA512 uint8 = 2
+)
+
+// TLSARecord contains the data of a DNS TLSA resource record
+type TLSARecord struct {
+	Usage        uint8
+	Selector     uint8
+	MatchingType uint8
+	Data         []byte
+}
+
+// Matches returns true if the given certificate is the one this record points to
+func (r TLSARecord) Matches(cert *x509.Certificate) bool {
+	var selected []byte
+	switch r.Selector {
+	case DANESelectorCert:
+		selected = cert.Raw
+	case DANESelectorSPKI:
+		selected = cert.RawSubjectPublicKeyInfo
+	default:
+		return false
+	}
+
+	switch r.MatchingType {
+	case DANEMatchingFull:
+		return bytes.Equal(selected, r.Data)
+	case DANEMatchingSHA256:
+		sum := sha256.Sum256(selected)
+		return bytes.Equal(sum[:], r.Data)
+	case DANEMatchingSHA512:
+		sum := sha512.Sum512(selected)
+		return bytes.Equal(sum[:], r.Data)
+	}
+
+	return false
+}
+
+// DANEResult contains the outcome of looking up and checking the TLSA records for a connection
+type DANEResult struct {
+	// Name is the DNS name the TLSA records were looked up for
+	Name string
+	// Records are the TLSA records found
+	Records []TLSARecord
+	// Secure is true if the records were authenticated using DNSSEC
+	Secure bool
+	// LookupError is the error we got when looking up the records, if any
+	LookupError error
+	// Matched is the DANE-TA or DANE-EE record that matched the certificates presented, if any
+	Matched *TLSARecord
+	// Anchor is the certificate matched by a DANE-TA record
+	Anchor *x509.Certificate
+}
+
+// Check looks for a DANE-EE or DANE-TA record matching the given certificate chain.
+// PKIX usages are not considered, since they don't add anything to the regular CA validation.
+func (r *DANEResult) Check(certs []*x509.Certificate) {
+	r.Matched = nil
+	r.Anchor = nil
+
+	if !r.Secure || len(certs) == 0 {
+		return
+	}
+
+	for ix := range r.Records {
+		rec := &r.Records[ix]
+		switch rec.Usage {
+		case DANEUsageDANEEE:
+			if rec.Matches(certs[0]) {
+				r.Matched = rec
+				return
+			}
+		case DANEUsageDANETA:
+			for _, cert := range certs[1:] {
+				if rec.Matches(cert) {
+					r.Matched = rec
+					r.Anchor = cert
+					return
+				}
+			}
+		}
+	}
+}
+
+// Verified returns true if the certificates were verified by a DNSSEC authenticated TLSA record
+func (r *DANEResult) Verified() bool {
+	return r != nil && r.Secure && r.Matched != nil
+}
+
+// Mismatched returns true if there are DNSSEC authenticated DANE-TA or DANE-EE records, but none of them matched the
+// certificates. RFC 6698, section 4.1 and RFC 7672, section 2.2 require the connection to fail in that case, instead
+// of falling back to the regular CA validation.
+func (r *DANEResult) Mismatched() bool {
+	if r == nil || !r.Secure || r.Matched != nil {
+		return false
+	}
+
+	for _, rec := range r.Records {
+		if rec.Usage == DANEUsageDANEEE || rec.Usage == DANEUsageDANETA {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package tls
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "gopkg.in/check.v1"
+)
+
+type DANESuite struct{}
+
+var _ = Suite(&DANESuite{})
+
+func parseTestCert(s string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(s))
+	cert, _ := x509.ParseCertificate(block.Bytes)
+	return cert
+}
+
+func (s *DANESuite) Test_TLSARecord_Matches_withFullCertificate(c *C) {
+	cert := parseTestCert(testCert)
+
+	r := TLSARecord{Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw}
+	c.Assert(r.Matches(cert), Equals, true)
+	c.Assert(r.Matches(parseTestCert(testCAcert)), Equals, false)
+}
+
+func (s *DANESuite) Test_TLSARecord_Matches_withHashedPublicKey(c *C) {
+	cert := parseTestCert(testCert)
+	sum256 := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	sum512 := sha512.Sum512(cert.RawSubjectPublicKeyInfo)
+
+	c.Assert(TLSARecord{Selector: DANESelectorSPKI, MatchingType: DANEMatchingSHA256, Data: sum256[:]}.Matches(cert), Equals, true)
+	c.Assert(TLSARecord{Selector: DANESelectorSPKI, MatchingType: DANEMatchingSHA512, Data: sum512[:]}.Matches(cert), Equals, true)
+	c.Assert(TLSARecord{Selector: DANESelectorCert, MatchingType: DANEMatchingSHA256, Data: sum256[:]}.Matches(cert), Equals, false)
+}
+
+func (s *DANESuite) Test_TLSARecord_Matches_failsOnUnknownSelectorOrMatchingType(c *C) {
+	cert := parseTestCert(testCert)
+
+	c.Assert(TLSARecord{Selector: 42, MatchingType: DANEMatchingFull, Data: cert.Raw}.Matches(cert), Equals, false)
+	c.Assert(TLSARecord{Selector: DANESelectorCert, MatchingType: 42, Data: cert.Raw}.Matches(cert), Equals, false)
+}
+
+func (s *DANESuite) Test_DANEResult_Check_matchesDANEEE(c *C) {
+	cert := parseTestCert(testCert)
+	r := &DANEResult{
+		Secure: true,
+		Records: []TLSARecord{
+			{Usage: DANEUsagePKIXEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw},
+			{Usage: DANEUsageDANEEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw},
+		},
+	}
+
+	r.Check([]*x509.Certificate{cert})
+	c.Assert(r.Verified(), Equals, true)
+	c.Assert(r.Matched, Equals, &r.Records[1])
+	c.Assert(r.Anchor, IsNil)
+}
+
+func (s *DANESuite) Test_DANEResult_Check_matchesDANETAOnlyForIssuers(c *C) {
+	cert := parseTestCert(testCert)
+	ca := parseTestCert(testCAcert)
+	r := &DANEResult{
+		Secure: true,
+		Records: []TLSARecord{
+			{Usage: DANEUsageDANETA, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw},
+			{Usage: DANEUsageDANETA, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: ca.Raw},
+		},
+	}
+
+	r.Check([]*x509.Certificate{cert, ca})
+	c.Assert(r.Matched, Equals, &r.Records[1])
+	c.Assert(r.Anchor, Equals, ca)
+}
+
+func (s *DANESuite) Test_DANEResult_Check_ignoresInsecureRecords(c *C) {
+	cert := parseTestCert(testCert)
+	r := &DANEResult{
+		Records: []TLSARecord{
+			{Usage: DANEUsageDANEEE, Selector: DANESelectorCert, MatchingType: DANEMatchingFull, Data: cert.Raw},
+		},
+	}
+
+	r.Check([]*x509.Certificate{cert})
+	c.Assert(r.Matched, IsNil)
+	c.Assert(r.Verified(), Equals, false)
+}
+
+func (s *DANESuite) Test_DANEResult_Verified_isFalseForNil(c *C) {
+	var r *DANEResult
+	c.Assert(r.Verified(), Equals, false)
+}
@@ -0,0 +1,22 @@
+package tls
+
+// CertificateRejectedError is returned when the certificate of the server is rejected, by the pinning or
+// DANE policies or by the user. Connecting again will not help until something has been changed.
+type CertificateRejectedError struct {
+	reason string
+}
+
+func (e *CertificateRejectedError) Error() string {
+	return "tls: " + e.reason
+}
+
+// RejectCertificate returns an error saying that the certificate was rejected for the given reason
+func RejectCertificate(reason string) error {
+	return &CertificateRejectedError{reason}
+}
+
+// IsCertificateRejected returns true if the error means that the certificate of the server was rejected
+func IsCertificateRejected(err error) bool {
+	_, ok := err.(*CertificateRejectedError)
+	return ok
+}
@@ -6,3 +6,9 @@ import "crypto/tls"
 type Verifier interface {
 	Verify(tls.ConnectionState, *tls.Config, string) error
 }
+
+// DANEVerifier is a Verifier that can also take the result of a DANE lookup into account
+type DANEVerifier interface {
+	Verifier
+	VerifyWithDANE(tls.ConnectionState, *tls.Config, string, *DANEResult) error
+}
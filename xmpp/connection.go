@@ -23,7 +23,9 @@ import (
 
 	"github.com/chadsec1/decoyim/cache"
 	"github.com/chadsec1/decoyim/decoylog"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
+	ourtls "github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
 	"github.com/chadsec1/decoyim/xmpp/interfaces"
 	"github.com/chadsec1/decoyim/xmpp/jid"
@@ -66,6 +68,11 @@ type conn struct {
 
 	channelBinding []byte
 
+	daneResult *ourtls.DANEResult
+
+	mechanismVerifier sasl.Verifier
+	saltedKeyStore    sasl.SaltedKeyStore
+
 	log decoylog.Logger
 
 	outerTLS bool
@@ -443,6 +450,16 @@ func (c *conn) GetChannelBinding() []byte {
 	return c.channelBinding
 }
 
+// SetDANEResult sets the result of looking up and checking the DANE TLSA records for this connection
+func (c *conn) SetDANEResult(v *ourtls.DANEResult) {
+	c.daneResult = v
+}
+
+// DANEResult returns the result of looking up and checking the DANE TLSA records for this connection, if we did
+func (c *conn) DANEResult() *ourtls.DANEResult {
+	return c.daneResult
+}
+
 func (c *conn) safeWrite(b []byte) (int, error) {
 	c.ioLock.Lock()
 	defer c.ioLock.Unlock()
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"encoding/xml"
+)
+
+// XEP-0227 describes a file format for moving the data servers keep about their users to another server.
+// We use it to move the data of one account, so the file only has one host with one user.
+
+// ServerData is the root of an XEP-0227 file
+type ServerData struct {
+	XMLName xml.Name         `xml:"urn:xmpp:pie:0 server-data"`
+	Hosts   []ServerDataHost `xml:"host"`
+}
+
+// ServerDataHost contains the users of one host
+type ServerDataHost struct {
+	JID   string           `xml:"jid,attr"`
+	Users []ServerDataUser `xml:"user"`
+}
+
+// ServerDataUser contains everything the server keeps for one user
+type ServerDataUser struct {
+	Name    string               `xml:"name,attr"`
+	Roster  *PortableRoster      `xml:"jabber:iq:roster query"`
+	VCard   *RawElement          `xml:"vcard-temp vCard"`
+	Private *PortablePrivateData `xml:"jabber:iq:private query"`
+	PubSub  *PortablePubSub      `xml:"http://jabber.org/protocol/pubsub pubsub"`
+}
+
+// PortableRoster is the roster of a user, with the state of the subscriptions
+type PortableRoster struct {
+	XMLName xml.Name             `xml:"jabber:iq:roster query"`
+	Items   []PortableRosterItem `xml:"item"`
+}
+
+// PortableRosterItem is one contact in the roster
+type PortableRosterItem struct {
+	Jid          string   `xml:"jid,attr"`
+	Name         string   `xml:"name,attr,omitempty"`
+	Subscription string   `xml:"subscription,attr,omitempty"`
+	Ask          string   `xml:"ask,attr,omitempty"`
+	Group        []string `xml:"group"`
+}
+
+// PortablePrivateData contains the elements a user has kept in private XML storage, as described in XEP-0049
+type PortablePrivateData struct {
+	XMLName  xml.Name     `xml:"jabber:iq:private query"`
+	Elements []RawElement `xml:",any"`
+}
+
+// PortablePubSub contains the items of the personal eventing nodes of a user
+type PortablePubSub struct {
+	XMLName xml.Name             `xml:"http://jabber.org/protocol/pubsub pubsub"`
+	Nodes   []PortablePubSubNode `xml:"items"`
+}
+
+// PortablePubSubNode contains the items published to one node
+type PortablePubSubNode struct {
+	Node  string               `xml:"node,attr"`
+	Items []PortablePubSubItem `xml:"item"`
+}
+
+// PortablePubSubItem is one published item
+type PortablePubSubItem struct {
+	ID      string     `xml:"id,attr,omitempty"`
+	Payload RawElement `xml:",any"`
+}
+
+// RawElement is an element that is kept as it is, without being understood
+type RawElement struct {
+	XMLName xml.Name
+	Inner   []byte `xml:",innerxml"`
+}
+
+// PubSubItemsQuery requests the items of a node
+type PubSubItemsQuery struct {
+	XMLName xml.Name          `xml:"http://jabber.org/protocol/pubsub pubsub"`
+	Items   PubSubItemsToSend `xml:"items"`
+}
+
+// PubSubItemsToSend names the node to request items from
+type PubSubItemsToSend struct {
+	Node string `xml:"node,attr"`
+}
+
+// PubSubPublish publishes an item to a node
+type PubSubPublish struct {
+	XMLName        xml.Name              `xml:"http://jabber.org/protocol/pubsub pubsub"`
+	Publish        PubSubPublishNode     `xml:"publish"`
+	PublishOptions *PubSubPublishOptions `xml:"publish-options,omitempty"`
+}
+
+// PubSubPublishNode contains the item to publish
+type PubSubPublishNode struct {
+	Node string             `xml:"node,attr"`
+	Item PortablePubSubItem `xml:"item"`
+}
+
+// PubSubPublishOptions contains the configuration the node should have, as a form
+type PubSubPublishOptions struct {
+	Form Form `xml:"jabber:x:data x"`
+}
+
+// OnlyUser returns the data of the user, if the file has the data of exactly one user
+func (sd *ServerData) OnlyUser() (*ServerDataUser, bool) {
+	if len(sd.Hosts) != 1 || len(sd.Hosts[0].Users) != 1 {
+		return nil, false
+	}
+	return &sd.Hosts[0].Users[0], true
+}
@@ -0,0 +1,72 @@
+package data
+
+import (
+	"encoding/xml"
+
+	. "gopkg.in/check.v1"
+)
+
+type PortableDataSuite struct{}
+
+var _ = Suite(&PortableDataSuite{})
+
+const portableDataExample = `<server-data xmlns='urn:xmpp:pie:0'>
+  <host jid='example.org'>
+    <user name='someone'>
+      <query xmlns='jabber:iq:roster'>
+        <item jid='alice@example.org' name='Alice' subscription='both'><group>Friends</group></item>
+        <item jid='bob@example.org' subscription='none' ask='subscribe'/>
+      </query>
+      <vCard xmlns='vcard-temp'><FN>Someone</FN></vCard>
+      <query xmlns='jabber:iq:private'>
+        <storage xmlns='storage:bookmarks'><conference jid='room@conference.example.org' autojoin='true'/></storage>
+      </query>
+      <pubsub xmlns='http://jabber.org/protocol/pubsub'>
+        <items node='urn:xmpp:bookmarks:1'>
+          <item id='room@conference.example.org'><conference xmlns='urn:xmpp:bookmarks:1' name='The room'/></item>
+        </items>
+      </pubsub>
+    </user>
+  </host>
+</server-data>`
+
+func (s *PortableDataSuite) Test_ServerData_canBeReadAndWrittenAgain(c *C) {
+	var sd ServerData
+	c.Assert(xml.Unmarshal([]byte(portableDataExample), &sd), IsNil)
+
+	u, ok := sd.OnlyUser()
+	c.Assert(ok, Equals, true)
+	c.Assert(u.Name, Equals, "someone")
+	c.Assert(u.Roster.Items, DeepEquals, []PortableRosterItem{
+		{Jid: "alice@example.org", Name: "Alice", Subscription: "both", Group: []string{"Friends"}},
+		{Jid: "bob@example.org", Subscription: "none", Ask: "subscribe"},
+	})
+	c.Assert(string(u.VCard.Inner), Equals, "<FN>Someone</FN>")
+	c.Assert(u.Private.Elements, HasLen, 1)
+	c.Assert(u.Private.Elements[0].XMLName, Equals, xml.Name{Space: "storage:bookmarks", Local: "storage"})
+	c.Assert(u.PubSub.Nodes, HasLen, 1)
+	c.Assert(u.PubSub.Nodes[0].Node, Equals, "urn:xmpp:bookmarks:1")
+	c.Assert(u.PubSub.Nodes[0].Items[0].ID, Equals, "room@conference.example.org")
+	c.Assert(u.PubSub.Nodes[0].Items[0].Payload.XMLName, Equals, xml.Name{Space: "urn:xmpp:bookmarks:1", Local: "conference"})
+
+	written, err := xml.Marshal(sd)
+	c.Assert(err, IsNil)
+
+	var again ServerData
+	c.Assert(xml.Unmarshal(written, &again), IsNil)
+	u2, _ := again.OnlyUser()
+	c.Assert(u2.Roster, DeepEquals, u.Roster)
+	c.Assert(u2.VCard, DeepEquals, u.VCard)
+	c.Assert(u2.Private.Elements[0].XMLName, Equals, u.Private.Elements[0].XMLName)
+	c.Assert(u2.PubSub.Nodes[0].Items[0].Payload.XMLName, Equals, u.PubSub.Nodes[0].Items[0].Payload.XMLName)
+}
+
+func (s *PortableDataSuite) Test_ServerData_OnlyUser_needsExactlyOneUser(c *C) {
+	sd := &ServerData{}
+	_, ok := sd.OnlyUser()
+	c.Assert(ok, Equals, false)
+
+	sd.Hosts = []ServerDataHost{{JID: "example.org", Users: []ServerDataUser{{Name: "a"}, {Name: "b"}}}}
+	_, ok = sd.OnlyUser()
+	c.Assert(ok, Equals, false)
+}
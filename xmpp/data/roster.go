@@ -32,8 +32,8 @@ type RosterRequest struct {
 // RosterRequestItem contains one specific entry
 type RosterRequestItem struct {
 	Jid          string   `xml:"jid,attr"`
-	Subscription string   `xml:"subscription,attr"`
-	Name         string   `xml:"name,attr"`
+	Subscription string   `xml:"subscription,attr,omitempty"`
+	Name         string   `xml:"name,attr,omitempty"`
 	Group        []string `xml:"group"`
 }
 
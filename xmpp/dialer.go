@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/chadsec1/decoyim/decoylog"
+	ourNet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
 	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
@@ -32,12 +34,30 @@ type dialer struct {
 	// proxy configures a proxy used to connect to the server
 	proxy proxy.Dialer
 
+	// resolver is used for the SRV lookups. If it's not set, we look up over TCP through the proxy.
+	resolver ourNet.Resolver
+
 	// config configures the XMPP protocol
 	config data.Config
 
 	verifier       tls.Verifier
 	tlsConnFactory tls.Factory
 
+	// mechanismVerifier decides whether the SASL mechanism chosen is acceptable
+	mechanismVerifier sasl.Verifier
+
+	// saltedKeyStore, if set, keeps keys derived from the password so we can authenticate without it
+	saltedKeyStore sasl.SaltedKeyStore
+
+	// lookupTLSA decides whether we look up DANE TLSA records for the server we connect to
+	lookupTLSA bool
+
+	// dnssecResolver is the validating DNS resolver used for the TLSA lookups, if not the default one
+	dnssecResolver string
+
+	// connectedTo is the address we actually connected to, after the SRV lookup
+	connectedTo *connectEntry
+
 	log decoylog.Logger
 
 	// Have we dialed with Direct TLS or not
@@ -94,6 +114,26 @@ func (d *dialer) SetProxy(v proxy.Dialer) {
 	d.proxy = v
 }
 
+func (d *dialer) SetResolver(v ourNet.Resolver) {
+	d.resolver = v
+}
+
+func (d *dialer) SetMechanismVerifier(v sasl.Verifier) {
+	d.mechanismVerifier = v
+}
+
+func (d *dialer) SetSaltedKeyStore(v sasl.SaltedKeyStore) {
+	d.saltedKeyStore = v
+}
+
+func (d *dialer) SetShouldLookupTLSA(v bool) {
+	d.lookupTLSA = v
+}
+
+func (d *dialer) SetDNSSECResolver(v string) {
+	d.dnssecResolver = v
+}
+
 func (d *dialer) SetConfig(v data.Config) {
 	d.config = v
 }
@@ -186,6 +226,8 @@ func (d *dialer) setupStream(conn net.Conn) (interfaces.Conn, error) {
 	c.originDomain = d.getJIDDomainpart()
 	c.outerTLS = d.outerTLS
 	c.known = d.known
+	c.mechanismVerifier = d.mechanismVerifier
+	c.saltedKeyStore = d.saltedKeyStore
 
 	if c.outerTLS {
 		if err := d.startRawTLS(c, conn); err != nil {
@@ -6,6 +6,8 @@ import (
 	"net"
 	"time"
 
+	ourNet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
 	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
@@ -37,6 +39,44 @@ func (s *DialerSuite) Test_dialer_SetKnown(c *C) {
 	c.Assert(dd.known, Equals, kn)
 }
 
+func (s *DialerSuite) Test_dialer_SetMechanismVerifier(c *C) {
+	dd := &dialer{}
+	mv := &sasl.BasicVerifier{}
+	dd.SetMechanismVerifier(mv)
+	c.Assert(dd.mechanismVerifier, Equals, mv)
+}
+
+type mockSaltedKeyStore struct {
+	keys  map[string]sasl.SaltedKeys
+	saved map[string]sasl.SaltedKeys
+}
+
+func (ks *mockSaltedKeyStore) SaltedKeysFor(mechanism string) (sasl.SaltedKeys, bool) {
+	k, ok := ks.keys[mechanism]
+	return k, ok
+}
+
+func (ks *mockSaltedKeyStore) SaveSaltedKeys(mechanism string, keys sasl.SaltedKeys) {
+	if ks.saved == nil {
+		ks.saved = make(map[string]sasl.SaltedKeys)
+	}
+	ks.saved[mechanism] = keys
+}
+
+func (s *DialerSuite) Test_dialer_SetSaltedKeyStore(c *C) {
+	dd := &dialer{}
+	ks := &mockSaltedKeyStore{}
+	dd.SetSaltedKeyStore(ks)
+	c.Assert(dd.saltedKeyStore, Equals, ks)
+}
+
+func (s *DialerSuite) Test_dialer_SetResolver(c *C) {
+	dd := &dialer{}
+	r := &ourNet.TCPResolver{}
+	dd.SetResolver(r)
+	c.Assert(dd.resolver, Equals, r)
+}
+
 func (s *DialerSuite) Test_dialer_ServerAddress(c *C) {
 	dd := &dialer{JID: "hmm@haha.com"}
 	c.Assert(dd.hasCustomServer(), Equals, false)
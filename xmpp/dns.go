@@ -7,6 +7,7 @@
 package xmpp
 
 import (
+	"encoding/hex"
 	"errors"
 	"net"
 	"sort"
@@ -14,6 +15,8 @@ import (
 	"strings"
 
 	ourNet "github.com/chadsec1/decoyim/net"
+	ourtls "github.com/chadsec1/decoyim/tls"
+	"github.com/miekg/dns"
 	"golang.org/x/net/proxy"
 )
 
@@ -28,6 +31,9 @@ type connectEntry struct {
 	priority int
 	weight   int
 	tls      bool
+
+	// ip is the address of host we will connect to, if we resolved it ourselves
+	ip string
 }
 
 func intoConnectEntry(s string) *connectEntry {
@@ -60,6 +66,15 @@ func resolveSRVWithProxy(proxy proxy.Dialer, domain string) (hosts []*connectEnt
 	return resolveWithCustom(domain, resolverWithProxy(proxy))
 }
 
+// resolveSRV performs a DNS SRV lookup for the xmpp server that serves the given domain, using the resolver configured
+func (d *dialer) resolveSRV(domain string) (hosts []*connectEntry, err error) {
+	if d.resolver != nil {
+		return resolveWithCustom(domain, d.resolver.LookupSRV)
+	}
+
+	return resolveSRVWithProxy(d.proxy, domain)
+}
+
 type resolver func(string, string, string) (string, []*net.SRV, error)
 
 func resolverWithProxy(p proxy.Dialer) resolver {
@@ -103,6 +118,14 @@ func (c *connectEntry) String() string {
 	return net.JoinHostPort(c.host, strconv.Itoa(c.port))
 }
 
+// dialAddress returns the address to connect to - the resolved IP address if there is one, or the host otherwise
+func (c *connectEntry) dialAddress() string {
+	if c.ip != "" {
+		return net.JoinHostPort(c.ip, strconv.Itoa(c.port))
+	}
+	return c.String()
+}
+
 func massage(addrs []*net.SRV, tls bool) ([]*connectEntry, error) {
 	// https://xmpp.org/rfcs/rfc6120.html#tcp-resolution-prefer
 	if len(addrs) == 1 && addrs[0].Target == "." {
@@ -124,3 +147,63 @@ func massage(addrs []*net.SRV, tls bool) ([]*connectEntry, error) {
 
 	return ret, nil
 }
+
+// lookupTLSA asks the validating DNS resolver given, if any, and otherwise the resolver of the account - so the
+// lookup goes the same way as the other lookups for the account do. Only the answers of a validating resolver on this
+// computer, or of a DNS-over-HTTPS endpoint, can be secure - anyone on the way could have changed any other answer.
+var lookupTLSA = func(r ourNet.Resolver, p proxy.Dialer, dnsServer string, port int, host string) ([]*dns.TLSA, bool, error) {
+	if dnsServer != "" && ourNet.IsLocalAddress("tcp", dnsServer) {
+		return ourNet.LookupTLSAFromLocalValidator(dnsServer, port, "tcp", host)
+	}
+	if dnsServer != "" {
+		return ourNet.LookupTLSAWith(p, dnsServer, port, "tcp", host)
+	}
+	if r != nil {
+		return r.LookupTLSA(port, "tcp", host)
+	}
+	return ourNet.LookupTLSA(p, port, "tcp", host)
+}
+
+// lookupDANE looks up the TLSA records for the address we are connected to, over the same proxy and resolver we used to connect.
+// It returns nil when we shouldn't or can't do a lookup.
+func (d *dialer) lookupDANE() *ourtls.DANEResult {
+	if !d.lookupTLSA || d.connectedTo == nil || net.ParseIP(d.connectedTo.host) != nil {
+		return nil
+	}
+
+	host, port := d.connectedTo.host, d.connectedTo.port
+	result := &ourtls.DANEResult{
+		Name: "_" + strconv.Itoa(port) + "._tcp." + host,
+	}
+
+	records, secure, err := lookupTLSA(d.resolver, d.proxy, d.dnssecResolver, port, host)
+	if err != nil {
+		d.log.WithError(err).WithField("name", result.Name).Warn("dns: failed to look up TLSA records")
+		result.LookupError = err
+		return result
+	}
+
+	result.Records = convertTLSARecords(records)
+	result.Secure = secure
+
+	return result
+}
+
+func convertTLSARecords(in []*dns.TLSA) []ourtls.TLSARecord {
+	result := make([]ourtls.TLSARecord, 0, len(in))
+	for _, r := range in {
+		data, err := hex.DecodeString(r.Certificate)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, ourtls.TLSARecord{
+			Usage:        r.Usage,
+			Selector:     r.Selector,
+			MatchingType: r.MatchingType,
+			Data:         data,
+		})
+	}
+
+	return result
+}
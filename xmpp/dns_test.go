@@ -2,12 +2,17 @@ package xmpp
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"sort"
 	"time"
 
+	ourNet "github.com/chadsec1/decoyim/net"
+	ourtls "github.com/chadsec1/decoyim/tls"
+	"github.com/miekg/dns"
+	"golang.org/x/net/proxy"
 	. "gopkg.in/check.v1"
 )
 
@@ -103,3 +108,121 @@ func (s *DNSXMPPSuite) Test_resolveWithCustom(c *C) {
 	c.Assert(res, HasLen, 0)
 	c.Assert(e, ErrorMatches, "service not available")
 }
+
+func (s *DNSXMPPSuite) Test_dialer_lookupDANE_doesNothingUnlessAskedTo(c *C) {
+	d := &dialer{connectedTo: &connectEntry{host: "xmpp.example.org", port: 5222}}
+	c.Assert(d.lookupDANE(), IsNil)
+
+	d = &dialer{lookupTLSA: true, connectedTo: &connectEntry{host: "127.0.0.1", port: 5222}}
+	c.Assert(d.lookupDANE(), IsNil)
+}
+
+func (s *DNSXMPPSuite) Test_dialer_lookupDANE_looksUpTheAddressConnectedTo(c *C) {
+	orgLookupTLSA := lookupTLSA
+	defer func() {
+		lookupTLSA = orgLookupTLSA
+	}()
+
+	var calledServer, calledHost string
+	var calledPort int
+	lookupTLSA = func(r ourNet.Resolver, p proxy.Dialer, dnsServer string, port int, host string) ([]*dns.TLSA, bool, error) {
+		calledServer, calledPort, calledHost = dnsServer, port, host
+		return []*dns.TLSA{
+			{Usage: 3, Selector: 1, MatchingType: 1, Certificate: "abcd"},
+			{Usage: 2, Selector: 0, MatchingType: 0, Certificate: "not hex"},
+		}, true, nil
+	}
+
+	d := &dialer{
+		lookupTLSA:     true,
+		dnssecResolver: "9.9.9.9:53",
+		connectedTo:    &connectEntry{host: "xmpp.example.org", port: 5223},
+		log:            testLogger(),
+	}
+
+	res := d.lookupDANE()
+	c.Assert(calledServer, Equals, "9.9.9.9:53")
+	c.Assert(calledPort, Equals, 5223)
+	c.Assert(calledHost, Equals, "xmpp.example.org")
+	c.Assert(res.Name, Equals, "_5223._tcp.xmpp.example.org")
+	c.Assert(res.Secure, Equals, true)
+	c.Assert(res.Records, DeepEquals, []ourtls.TLSARecord{
+		{Usage: 3, Selector: 1, MatchingType: 1, Data: []byte{0xab, 0xcd}},
+	})
+}
+
+func (s *DNSXMPPSuite) Test_dialer_lookupDANE_usesTheResolverOfTheAccount(c *C) {
+	r := &mockResolver{tlsa: []*dns.TLSA{{Usage: 3, Selector: 1, MatchingType: 1, Certificate: "abcd"}}}
+	d := &dialer{
+		lookupTLSA:  true,
+		resolver:    r,
+		connectedTo: &connectEntry{host: "xmpp.example.org", port: 5222},
+		log:         testLogger(),
+	}
+
+	res := d.lookupDANE()
+	c.Assert(r.called, DeepEquals, []string{"tlsa xmpp.example.org"})
+	c.Assert(res.Secure, Equals, true)
+	c.Assert(res.Records, HasLen, 1)
+}
+
+func (s *DNSXMPPSuite) Test_dialer_lookupDANE_keepsTheLookupError(c *C) {
+	orgLookupTLSA := lookupTLSA
+	defer func() {
+		lookupTLSA = orgLookupTLSA
+	}()
+
+	lookupTLSA = func(ourNet.Resolver, proxy.Dialer, string, int, string) ([]*dns.TLSA, bool, error) {
+		return nil, false, errors.New("marker error")
+	}
+
+	d := &dialer{
+		lookupTLSA:  true,
+		connectedTo: &connectEntry{host: "xmpp.example.org", port: 5222},
+		log:         testLogger(),
+	}
+
+	res := d.lookupDANE()
+	c.Assert(res.LookupError, ErrorMatches, "marker error")
+	c.Assert(res.Secure, Equals, false)
+}
+
+type mockResolver struct {
+	srv    []*net.SRV
+	hosts  map[string][]string
+	tlsa   []*dns.TLSA
+	err    error
+	called []string
+}
+
+func (r *mockResolver) LookupSRV(service, proto, name string) (string, []*net.SRV, error) {
+	r.called = append(r.called, service)
+	return "", r.srv, r.err
+}
+
+func (r *mockResolver) LookupHost(host string) ([]string, error) {
+	return r.hosts[host], r.err
+}
+
+func (r *mockResolver) LookupTLSA(port int, proto, name string) ([]*dns.TLSA, bool, error) {
+	r.called = append(r.called, "tlsa "+name)
+	return r.tlsa, true, r.err
+}
+
+func (s *DNSXMPPSuite) Test_dialer_resolveSRV_usesTheConfiguredResolver(c *C) {
+	r := &mockResolver{srv: []*net.SRV{{Target: "xmpp.example.org.", Port: 5222}}}
+	d := &dialer{resolver: r}
+
+	hosts, e := d.resolveSRV("example.org")
+	c.Assert(e, IsNil)
+	c.Assert(r.called, DeepEquals, []string{"xmpps-client", "xmpp-client"})
+	c.Assert(hosts, HasLen, 2)
+	c.Assert(hosts[0].host, Equals, "xmpp.example.org")
+}
+
+func (s *DNSXMPPSuite) Test_dialer_resolveSRV_returnsTheResolverError(c *C) {
+	d := &dialer{resolver: &mockResolver{err: ourNet.ErrSRVNotSupported}}
+
+	_, e := d.resolveSRV("example.org")
+	c.Assert(e, Equals, ourNet.ErrSRVNotSupported)
+}
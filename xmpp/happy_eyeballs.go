@@ -0,0 +1,193 @@
+package xmpp
+
+import (
+	"context"
+	"net"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	ourNet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/xmpp/errors"
+	"golang.org/x/net/proxy"
+)
+
+// connectionAttemptDelay is how long we wait for a connection attempt before starting the next one in parallel.
+// See RFC 8305, Section 5
+var connectionAttemptDelay = 250 * time.Millisecond
+
+// proxiedConnectionAttemptDelay is used instead of connectionAttemptDelay when connecting through a proxy,
+// since building a Tor circuit usually takes a lot longer than a TCP handshake
+var proxiedConnectionAttemptDelay = 2 * time.Second
+
+type connectionAttempt struct {
+	number int
+	entry  *connectEntry
+	conn   net.Conn
+	err    error
+	took   time.Duration
+}
+
+// connectToFirstAvailable connects to the first of the given addresses that answers. The attempts are started in
+// order, one after another, but a new attempt doesn't wait for the previous one for longer than the attempt delay.
+// As soon as one of them succeeds, the rest are cancelled.
+func (d *dialer) connectToFirstAvailable(xmppAddrs []*connectEntry, dialer proxy.Dialer) (net.Conn, *connectEntry, error) {
+	targets := d.expandAddressFamilies(xmppAddrs, dialer)
+	if len(targets) == 0 {
+		return nil, nil, errors.ErrConnectionFailed
+	}
+
+	delay := connectionAttemptDelay
+	if !ourNet.IsDirect(dialer) {
+		delay = proxiedConnectionAttemptDelay
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	results := make(chan connectionAttempt, len(targets))
+	next, pending := 0, 0
+	startNext := func() {
+		go d.attemptConnection(ctx, next+1, targets[next], dialer, results)
+		next++
+		pending++
+	}
+
+	startNext()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for pending > 0 {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				go closeLosingAttempts(results, pending)
+				d.connectedTo = r.entry
+				return r.conn, r.entry, nil
+			}
+
+			if next < len(targets) {
+				startNext()
+				resetTimer(timer, delay)
+			}
+		case <-timer.C:
+			if next < len(targets) {
+				startNext()
+				timer.Reset(delay)
+			}
+		}
+	}
+
+	return nil, nil, errors.ErrConnectionFailed
+}
+
+func (d *dialer) attemptConnection(ctx context.Context, number int, entry *connectEntry, dialer proxy.Dialer, results chan<- connectionAttempt) {
+	l := d.log.WithFields(log.Fields{
+		"attempt": number,
+		"addr":    entry,
+		"dial":    entry.dialAddress(),
+	})
+	l.Info("Connecting")
+
+	attemptCtx, cancel := context.WithTimeout(ctx, defaultDialTimeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := dialContext(attemptCtx, dialer, "tcp", entry.dialAddress())
+	took := time.Since(start)
+
+	switch {
+	case err == nil:
+		l.WithField("took", took).Info("Connection attempt succeeded")
+	case ctx.Err() != nil:
+		l.WithField("took", took).Debug("Connection attempt cancelled")
+	case attemptCtx.Err() == context.DeadlineExceeded:
+		err = ourNet.ErrTimeout
+		l.WithField("took", took).Warn("Connection attempt timed out")
+	default:
+		err = errors.CreateErrFailedToConnect(entry.dialAddress(), err)
+		l.WithField("took", took).WithError(err).Warn("Connection attempt failed")
+	}
+
+	results <- connectionAttempt{number: number, entry: entry, conn: conn, err: err, took: took}
+}
+
+// closeLosingAttempts waits for the attempts still running after one of them won, and closes their connections
+func closeLosingAttempts(results <-chan connectionAttempt, pending int) {
+	for ; pending > 0; pending-- {
+		if r := <-results; r.conn != nil {
+			_ = r.conn.Close()
+		}
+	}
+}
+
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	t.Reset(d)
+}
+
+// expandAddressFamilies resolves the hosts to their IPv6 and IPv4 addresses, so that we can try all of them,
+// interleaving the families as described in RFC 8305, Section 4. This is only done when connecting directly - when
+// going through a proxy, the proxy resolves the names, so that they don't leak.
+func (d *dialer) expandAddressFamilies(entries []*connectEntry, dialer proxy.Dialer) []*connectEntry {
+	if d.resolver == nil || !ourNet.IsDirect(dialer) {
+		return entries
+	}
+
+	result := make([]*connectEntry, 0, len(entries))
+	for _, e := range entries {
+		if net.ParseIP(e.host) != nil {
+			result = append(result, e)
+			continue
+		}
+
+		addrs, err := d.resolver.LookupHost(e.host)
+		if err != nil || len(addrs) == 0 {
+			d.log.WithError(err).WithField("host", e.host).Debug("Couldn't resolve host, letting the dialer do it")
+			result = append(result, e)
+			continue
+		}
+
+		for _, ip := range interleaveAddressFamilies(addrs) {
+			withIP := *e
+			withIP.ip = ip
+			result = append(result, &withIP)
+		}
+	}
+
+	return result
+}
+
+// interleaveAddressFamilies orders the addresses alternating between IPv6 and IPv4, starting with IPv6
+func interleaveAddressFamilies(addrs []string) []string {
+	var v6, v4 []string
+	for _, a := range addrs {
+		ip := net.ParseIP(a)
+		switch {
+		case ip == nil:
+			continue
+		case ip.To4() == nil:
+			v6 = append(v6, a)
+		default:
+			v4 = append(v4, a)
+		}
+	}
+
+	result := make([]string, 0, len(v6)+len(v4))
+	for i := 0; i < len(v6) || i < len(v4); i++ {
+		if i < len(v6) {
+			result = append(result, v6[i])
+		}
+		if i < len(v4) {
+			result = append(result, v4[i])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,211 @@
+package xmpp
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	ourNet "github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/xmpp/errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type HappyEyeballsSuite struct{}
+
+var _ = Suite(&HappyEyeballsSuite{})
+
+// scriptedContextDialer answers every address with the function given for it, and records which attempts got cancelled
+type scriptedContextDialer struct {
+	answers   map[string]func(context.Context) (net.Conn, error)
+	dialed    []string
+	cancelled []string
+	sync.Mutex
+}
+
+func (d *scriptedContextDialer) Dial(network, addr string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, addr)
+}
+
+func (d *scriptedContextDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	d.Lock()
+	d.dialed = append(d.dialed, addr)
+	f := d.answers[addr]
+	d.Unlock()
+
+	c, err := f(ctx)
+	if ctx.Err() == context.Canceled {
+		d.Lock()
+		d.cancelled = append(d.cancelled, addr)
+		d.Unlock()
+	}
+	return c, err
+}
+
+func answerAfter(t time.Duration, conn net.Conn, err error) func(context.Context) (net.Conn, error) {
+	return func(ctx context.Context) (net.Conn, error) {
+		select {
+		case <-time.After(t):
+			return conn, err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func neverAnswer(ctx context.Context) (net.Conn, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func withAttemptDelay(d time.Duration) func() {
+	orgConnectionAttemptDelay := connectionAttemptDelay
+	orgProxiedConnectionAttemptDelay := proxiedConnectionAttemptDelay
+	connectionAttemptDelay = d
+	proxiedConnectionAttemptDelay = d
+	return func() {
+		connectionAttemptDelay = orgConnectionAttemptDelay
+		proxiedConnectionAttemptDelay = orgProxiedConnectionAttemptDelay
+	}
+}
+
+func (s *HappyEyeballsSuite) Test_connectToFirstAvailable_startsTheNextAttemptWhileTheFirstHangs(c *C) {
+	defer withAttemptDelay(10 * time.Millisecond)()
+
+	expectedConn := &net.TCPConn{}
+	p := &scriptedContextDialer{answers: map[string]func(context.Context) (net.Conn, error){
+		"dead.example.org:5222":  neverAnswer,
+		"alive.example.org:5223": answerAfter(0, expectedConn, nil),
+	}}
+	d := &dialer{log: testLogger()}
+
+	entries := []*connectEntry{
+		{host: "dead.example.org", port: 5222, priority: 1},
+		{host: "alive.example.org", port: 5223, priority: 2, tls: true},
+	}
+
+	conn, ce, err := d.connectToFirstAvailable(entries, p)
+	c.Assert(err, IsNil)
+	c.Assert(conn, Equals, expectedConn)
+	c.Assert(ce, Equals, entries[1])
+	c.Assert(d.connectedTo, Equals, entries[1])
+
+	time.Sleep(20 * time.Millisecond)
+	p.Lock()
+	defer p.Unlock()
+	c.Assert(p.dialed, DeepEquals, []string{"dead.example.org:5222", "alive.example.org:5223"})
+	c.Assert(p.cancelled, DeepEquals, []string{"dead.example.org:5222"})
+}
+
+func (s *HappyEyeballsSuite) Test_connectToFirstAvailable_startsTheNextAttemptRightAwayWhenOneFails(c *C) {
+	defer withAttemptDelay(time.Hour)()
+
+	expectedConn := &net.TCPConn{}
+	p := &scriptedContextDialer{answers: map[string]func(context.Context) (net.Conn, error){
+		"one.example.org:5222": answerAfter(0, nil, ourNet.ErrWouldBypassTor),
+		"two.example.org:5222": answerAfter(0, expectedConn, nil),
+	}}
+	d := &dialer{log: testLogger()}
+
+	conn, _, err := d.connectToFirstAvailable([]*connectEntry{
+		{host: "one.example.org", port: 5222},
+		{host: "two.example.org", port: 5222},
+	}, p)
+	c.Assert(err, IsNil)
+	c.Assert(conn, Equals, expectedConn)
+}
+
+func (s *HappyEyeballsSuite) Test_connectToFirstAvailable_failsWhenAllAttemptsFail(c *C) {
+	defer withAttemptDelay(time.Millisecond)()
+
+	p := &scriptedContextDialer{answers: map[string]func(context.Context) (net.Conn, error){
+		"one.example.org:5222": answerAfter(5*time.Millisecond, nil, ourNet.ErrWouldBypassTor),
+		"two.example.org:5222": answerAfter(0, nil, ourNet.ErrWouldBypassTor),
+	}}
+	d := &dialer{log: testLogger()}
+
+	_, _, err := d.connectToFirstAvailable([]*connectEntry{
+		{host: "one.example.org", port: 5222},
+		{host: "two.example.org", port: 5222},
+	}, p)
+	c.Assert(err, Equals, errors.ErrConnectionFailed)
+	c.Assert(p.dialed, HasLen, 2)
+	c.Assert(d.connectedTo, IsNil)
+}
+
+func (s *HappyEyeballsSuite) Test_connectToFirstAvailable_closesConnectionsThatArriveTooLate(c *C) {
+	defer withAttemptDelay(time.Millisecond)()
+
+	late, other := net.Pipe()
+	defer func() {
+		_ = other.Close()
+	}()
+
+	winner := &net.TCPConn{}
+	p := &funcDialer{f: func(network, addr string) (net.Conn, error) {
+		if addr == "slow.example.org:5222" {
+			time.Sleep(20 * time.Millisecond)
+			return late, nil
+		}
+		return winner, nil
+	}}
+	d := &dialer{log: testLogger()}
+
+	conn, _, err := d.connectToFirstAvailable([]*connectEntry{
+		{host: "slow.example.org", port: 5222},
+		{host: "fast.example.org", port: 5222},
+	}, p)
+	c.Assert(err, IsNil)
+	c.Assert(conn, Equals, winner)
+
+	_ = other.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = other.Read(make([]byte, 1))
+	c.Assert(err, Equals, io.EOF)
+}
+
+func (s *HappyEyeballsSuite) Test_expandAddressFamilies_interleavesTheAddressesWhenConnectingDirectly(c *C) {
+	d := &dialer{
+		log: testLogger(),
+		resolver: &mockResolver{hosts: map[string][]string{
+			"xmpp.example.org": {"192.0.2.1", "192.0.2.2", "2001:db8::1"},
+		}},
+	}
+
+	entries := []*connectEntry{
+		{host: "xmpp.example.org", port: 5223, tls: true},
+		{host: "unknown.example.org", port: 5222},
+		{host: "198.51.100.7", port: 5222},
+	}
+
+	result := d.expandAddressFamilies(entries, ourNet.Dialers.Direct(false))
+	c.Assert(result, HasLen, 5)
+	c.Assert(result[0].dialAddress(), Equals, "[2001:db8::1]:5223")
+	c.Assert(result[1].dialAddress(), Equals, "192.0.2.1:5223")
+	c.Assert(result[2].dialAddress(), Equals, "192.0.2.2:5223")
+	c.Assert(result[3].dialAddress(), Equals, "unknown.example.org:5222")
+	c.Assert(result[4].dialAddress(), Equals, "198.51.100.7:5222")
+
+	c.Assert(result[0].host, Equals, "xmpp.example.org")
+	c.Assert(result[0].tls, Equals, true)
+	c.Assert(result[0].String(), Equals, "xmpp.example.org:5223")
+}
+
+func (s *HappyEyeballsSuite) Test_expandAddressFamilies_leavesTheNamesToTheProxy(c *C) {
+	d := &dialer{
+		log: testLogger(),
+		resolver: &mockResolver{hosts: map[string][]string{
+			"xmpp.example.org": {"192.0.2.1"},
+		}},
+	}
+
+	entries := []*connectEntry{{host: "xmpp.example.org", port: 5222}}
+	c.Assert(d.expandAddressFamilies(entries, &mockProxy{}), DeepEquals, entries)
+}
+
+func (s *HappyEyeballsSuite) Test_interleaveAddressFamilies(c *C) {
+	c.Assert(interleaveAddressFamilies([]string{"192.0.2.1", "192.0.2.2", "2001:db8::1", "2001:db8::2", "2001:db8::3", "bad"}),
+		DeepEquals, []string{"2001:db8::1", "192.0.2.1", "2001:db8::2", "192.0.2.2", "2001:db8::3"})
+	c.Assert(interleaveAddressFamilies(nil), DeepEquals, []string{})
+}
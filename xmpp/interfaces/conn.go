@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/chadsec1/decoyim/cache"
+	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
 )
 
@@ -67,6 +68,8 @@ type Features interface {
 type Transport interface {
 	SetChannelBinding([]byte)
 	GetChannelBinding() []byte
+	SetDANEResult(*tls.DANEResult)
+	DANEResult() *tls.DANEResult
 	Close() error
 }
 
@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"github.com/chadsec1/decoyim/decoylog"
+	"github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
 	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
@@ -25,6 +27,11 @@ type Dialer interface {
 	SetShouldSendALPN(bool)
 	SetLogger(decoylog.Logger)
 	SetKnown(*servers.Server)
+	SetMechanismVerifier(sasl.Verifier)
+	SetSaltedKeyStore(sasl.SaltedKeyStore)
+	SetResolver(net.Resolver)
+	SetShouldLookupTLSA(bool)
+	SetDNSSECResolver(string)
 }
 
 // DialerFactory represents a function that can create a Dialer
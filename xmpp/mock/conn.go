@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/chadsec1/decoyim/cache"
+	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
 )
 
@@ -203,6 +204,14 @@ func (*Conn) GetChannelBinding() []byte {
 	return nil
 }
 
+// SetDANEResult implements the XMPP connection interface
+func (*Conn) SetDANEResult(*tls.DANEResult) {}
+
+// DANEResult implements the XMPP connection interface
+func (*Conn) DANEResult() *tls.DANEResult {
+	return nil
+}
+
 // GetJIDResource implements the XMPP connection interface
 func (*Conn) GetJIDResource() string {
 	return ""
@@ -55,6 +55,9 @@ func (s *ConnSuite) Test_ConnMock(c *C) {
 	m.SetChannelBinding(nil)
 	c.Assert(m.GetChannelBinding(), IsNil)
 
+	m.SetDANEResult(nil)
+	c.Assert(m.DANEResult(), IsNil)
+
 	c.Assert(m.GetJIDResource(), Equals, "")
 	m.SetJIDResource("")
 
@@ -2,6 +2,8 @@ package mock
 
 import (
 	"github.com/chadsec1/decoyim/decoylog"
+	"github.com/chadsec1/decoyim/net"
+	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
 	"github.com/chadsec1/decoyim/xmpp/data"
 	"github.com/chadsec1/decoyim/xmpp/interfaces"
@@ -65,3 +67,18 @@ func (*Dialer) SetLogger(decoylog.Logger) {}
 
 // SetKnown is an implementation of the Dialer interface
 func (*Dialer) SetKnown(*servers.Server) {}
+
+// SetMechanismVerifier is an implementation of the Dialer interface
+func (*Dialer) SetMechanismVerifier(sasl.Verifier) {}
+
+// SetSaltedKeyStore is an implementation of the Dialer interface
+func (*Dialer) SetSaltedKeyStore(sasl.SaltedKeyStore) {}
+
+// SetResolver is an implementation of the Dialer interface
+func (*Dialer) SetResolver(net.Resolver) {}
+
+// SetShouldLookupTLSA is an implementation of the Dialer interface
+func (*Dialer) SetShouldLookupTLSA(bool) {}
+
+// SetDNSSECResolver is an implementation of the Dialer interface
+func (*Dialer) SetDNSSECResolver(string) {}
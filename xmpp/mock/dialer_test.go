@@ -28,4 +28,9 @@ func (s *DialerSuite) Test_DialerMock(c *C) {
 	d.SetShouldSendALPN(false)
 	d.SetLogger(nil)
 	d.SetKnown(nil)
+	d.SetMechanismVerifier(nil)
+	d.SetSaltedKeyStore(nil)
+	d.SetResolver(nil)
+	d.SetShouldLookupTLSA(false)
+	d.SetDNSSECResolver("")
 }
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/chadsec1/decoyim/cache"
+	"github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/data"
 
 	mck "github.com/stretchr/testify/mock"
@@ -256,6 +257,17 @@ func (m *MockedConn) GetChannelBinding() []byte {
 	return args.Get(0).([]byte)
 }
 
+// SetDANEResult implements the XMPP connection interface
+func (m *MockedConn) SetDANEResult(v *tls.DANEResult) {
+	m.Called(v)
+}
+
+// DANEResult implements the XMPP connection interface
+func (m *MockedConn) DANEResult() *tls.DANEResult {
+	args := m.Called()
+	return args.Get(0).(*tls.DANEResult)
+}
+
 // GetJIDResource implements the XMPP connection interface
 func (m *MockedConn) GetJIDResource() string {
 	args := m.Called()
@@ -78,3 +78,16 @@ func (v *mockTLSVerifier) Verify(state gotls.ConnectionState, conf *gotls.Config
 
 	return v.toReturn
 }
+
+type mockDANEVerifier struct {
+	mockTLSVerifier
+	verifyWithDANECalled int
+	dane                 *tls.DANEResult
+}
+
+func (v *mockDANEVerifier) VerifyWithDANE(state gotls.ConnectionState, conf *gotls.Config, originDomain string, dane *tls.DANEResult) error {
+	v.verifyWithDANECalled++
+	v.dane = dane
+
+	return v.toReturn
+}
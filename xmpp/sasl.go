@@ -39,6 +39,9 @@ func (d *dialer) negotiateSASL(c interfaces.Conn) error {
 	password := d.password
 
 	if err := c.Authenticate(user, password); err != nil {
+		if err == sasl.ErrMechanismDowngrade {
+			return err
+		}
 		return c.AuthenticationFailure()
 	}
 
@@ -82,6 +85,22 @@ func (c *conn) hasChannelBinding() bool {
 	return len(c.GetChannelBinding()) > 0
 }
 
+// channelBindingType returns the type of channel binding available on this connection, if any
+func (c *conn) channelBindingType() string {
+	if c.hasChannelBinding() {
+		return "tls-unique"
+	}
+	return ""
+}
+
+func (c *conn) verifyMechanism(mechanism string) error {
+	if c.mechanismVerifier == nil {
+		return nil
+	}
+
+	return c.mechanismVerifier.VerifyMechanism(mechanism, c.channelBindingType())
+}
+
 var preferedMechanisms = []string{"SCRAM-SHA-512-PLUS", "SCRAM-SHA-512", "SCRAM-SHA-256-PLUS", "SCRAM-SHA-256", "SCRAM-SHA-1-PLUS", "SCRAM-SHA-1", "DIGEST-MD5", "PLAIN"}
 var preferedMechanismsWithoutChannelBinding = []string{"SCRAM-SHA-512", "SCRAM-SHA-256", "SCRAM-SHA-1", "DIGEST-MD5", "PLAIN"}
 var preferedMechanismsWithoutSCRAM = []string{"DIGEST-MD5", "PLAIN"}
@@ -103,8 +122,20 @@ func (c *conn) authenticateWithPreferedMethod(user, password string) error {
 	for _, prefered := range pm {
 		for _, m := range c.features.Mechanisms.Mechanism {
 			if prefered == m {
+				if err := c.verifyMechanism(prefered); err != nil {
+					c.log.WithField("mechanism", prefered).WithError(err).Warn("sasl: refusing to authenticate via")
+					return err
+				}
+
 				c.log.WithField("mechanism", prefered).Info("sasl: authenticating via")
-				return c.authenticateWith(prefered, user, password)
+				if err := c.authenticateWith(prefered, user, password); err != nil {
+					return err
+				}
+
+				if c.mechanismVerifier != nil {
+					c.mechanismVerifier.MechanismSucceeded(prefered, c.channelBindingType())
+				}
+				return nil
 			}
 		}
 	}
@@ -136,7 +167,11 @@ func (c *conn) authenticateWith(mechanism string, user string, password string)
 	}
 
 	_ = clientAuth.SetProperty(sasl.AuthID, user)
-	_ = clientAuth.SetProperty(sasl.Password, password)
+	// When we keep salted keys, an empty password means we should authenticate with those instead
+	if password != "" || c.saltedKeyStore == nil {
+		_ = clientAuth.SetProperty(sasl.Password, password)
+	}
+	c.useSaltedKeys(mechanism, clientAuth)
 
 	_ = clientAuth.SetProperty(sasl.Service, "xmpp")
 	_ = clientAuth.SetProperty(sasl.QOP, "auth")
@@ -155,7 +190,35 @@ func (c *conn) authenticateWith(mechanism string, user string, password string)
 
 	fmt.Fprintf(c.rawOut, "<auth xmlns='%s' mechanism='%s'>%s</auth>\n", NsSASL, mechanism, t.Encode())
 
-	return c.challengeLoop(clientAuth)
+	if err = c.challengeLoop(clientAuth); err != nil {
+		return err
+	}
+
+	c.saveSaltedKeys(mechanism, clientAuth)
+
+	return nil
+}
+
+func (c *conn) useSaltedKeys(mechanism string, clientAuth sasl.Session) {
+	ks, ok := clientAuth.(sasl.SaltedKeysSession)
+	if !ok || c.saltedKeyStore == nil {
+		return
+	}
+
+	if keys, ok := c.saltedKeyStore.SaltedKeysFor(sasl.BaseMechanism(mechanism)); ok {
+		ks.SetSaltedKeys(keys)
+	}
+}
+
+func (c *conn) saveSaltedKeys(mechanism string, clientAuth sasl.Session) {
+	ks, ok := clientAuth.(sasl.SaltedKeysSession)
+	if !ok || c.saltedKeyStore == nil {
+		return
+	}
+
+	if keys, ok := ks.SaltedKeys(); ok {
+		c.saltedKeyStore.SaveSaltedKeys(sasl.BaseMechanism(mechanism), keys)
+	}
 }
 
 func (c *conn) challengeLoop(clientAuth sasl.Session) error {
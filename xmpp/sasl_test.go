@@ -3,6 +3,7 @@ package xmpp
 import (
 	"encoding/xml"
 	"errors"
+	"io"
 
 	"github.com/chadsec1/decoyim/sasl"
 	"github.com/chadsec1/decoyim/servers"
@@ -229,6 +230,184 @@ func (s *SaslXMPPSuite) Test_conn_authenticateWithPreferedMethod_handlesBrokenSe
 	c.Assert(hook.Entries[1].Data["mechanism"], Equals, "DIGEST-MD5")
 }
 
+type mockMechanismVerifier struct {
+	mechanism      string
+	channelBinding string
+	err            error
+	succeeded      []string
+}
+
+func (v *mockMechanismVerifier) VerifyMechanism(mechanism, channelBinding string) error {
+	v.mechanism = mechanism
+	v.channelBinding = channelBinding
+	return v.err
+}
+
+func (v *mockMechanismVerifier) MechanismSucceeded(mechanism, channelBinding string) {
+	v.succeeded = append(v.succeeded, mechanism)
+}
+
+func (s *SaslXMPPSuite) Test_conn_authenticateWithPreferedMethod_refusesWhenTheVerifierRejectsTheMechanism(c *C) {
+	l, hook := test.NewNullLogger()
+	l.SetLevel(log.DebugLevel)
+
+	out := &mockConnIOReaderWriter{}
+	mv := &mockMechanismVerifier{err: sasl.ErrMechanismDowngrade}
+	cn := &conn{
+		log:               l,
+		rawOut:            out,
+		mechanismVerifier: mv,
+		channelBinding:    []byte{0x01},
+		features: data.StreamFeatures{
+			Mechanisms: data.SaslMechanisms{
+				Mechanism: []string{"PLAIN"},
+			},
+		},
+	}
+
+	e := cn.authenticateWithPreferedMethod("foo", "bar")
+
+	c.Assert(e, Equals, sasl.ErrMechanismDowngrade)
+	c.Assert(mv.mechanism, Equals, "PLAIN")
+	c.Assert(mv.channelBinding, Equals, "tls-unique")
+	c.Assert(out.write, HasLen, 0)
+	c.Assert(hook.LastEntry().Level, Equals, log.WarnLevel)
+	c.Assert(hook.LastEntry().Message, Equals, "sasl: refusing to authenticate via")
+}
+
+func (s *SaslXMPPSuite) Test_conn_authenticateWithPreferedMethod_passesNoChannelBindingToTheVerifierWhenUnavailable(c *C) {
+	out := &mockConnIOReaderWriter{}
+	mockIn := &mockConnIOReaderWriter{read: []byte("<sasl:success xmlns:sasl='urn:ietf:params:xml:ns:xmpp-sasl'></sasl:success>")}
+	mv := &mockMechanismVerifier{}
+	cn := &conn{
+		log:               testLogger(),
+		rawOut:            out,
+		in:                xml.NewDecoder(mockIn),
+		mechanismVerifier: mv,
+		features: data.StreamFeatures{
+			Mechanisms: data.SaslMechanisms{
+				Mechanism: []string{"PLAIN"},
+			},
+		},
+	}
+
+	e := cn.authenticateWithPreferedMethod("foo", "bar")
+
+	c.Assert(e, IsNil)
+	c.Assert(mv.mechanism, Equals, "PLAIN")
+	c.Assert(mv.channelBinding, Equals, "")
+	c.Assert(mv.succeeded, DeepEquals, []string{"PLAIN"})
+}
+
+func (s *SaslXMPPSuite) Test_conn_authenticateWithPreferedMethod_doesNotReportAFailedAuthenticationToTheVerifier(c *C) {
+	out := &mockConnIOReaderWriter{}
+	mockIn := &mockConnIOReaderWriter{read: []byte("<sasl:failure xmlns:sasl='urn:ietf:params:xml:ns:xmpp-sasl'><not-authorized/></sasl:failure>")}
+	mv := &mockMechanismVerifier{}
+	cn := &conn{
+		log:               testLogger(),
+		rawOut:            out,
+		in:                xml.NewDecoder(mockIn),
+		mechanismVerifier: mv,
+		features: data.StreamFeatures{
+			Mechanisms: data.SaslMechanisms{
+				Mechanism: []string{"PLAIN"},
+			},
+		},
+	}
+
+	e := cn.authenticateWithPreferedMethod("foo", "bar")
+
+	c.Assert(e, NotNil)
+	c.Assert(mv.mechanism, Equals, "PLAIN")
+	c.Assert(mv.succeeded, HasLen, 0)
+}
+
+func scramSHA1TestConn(out io.WriteCloser, ks sasl.SaltedKeyStore) *conn {
+	mockIn := &mockConnIOReaderWriter{read: []byte(
+		"<challenge xmlns='urn:ietf:params:xml:ns:xmpp-sasl'>cj1iNWNmZjYxOTAwMTNlNmttdWE1REVtUEFaak9NcHE0VEhXSlE9PSxzPURrRVdNMjBxRTE5c3V2ckhoUHI3SEE9PSxpPTQwOTY=</challenge>\n" +
+			"<success xmlns='urn:ietf:params:xml:ns:xmpp-sasl'>dj1rNW41OTVxVzUwVHlFMnErSjBjVWY5eVQ4djQ9</success>\n",
+	)}
+
+	mockRand := &mockConnIOReaderWriter{read: []byte{
+		0xb5, 0xcf, 0xf6, 0x19, 0x00, 0x13, 0xe6,
+	}}
+
+	return &conn{
+		log:            testLogger(),
+		rawOut:         out,
+		in:             xml.NewDecoder(mockIn),
+		rand:           mockRand,
+		saltedKeyStore: ks,
+		features: data.StreamFeatures{
+			Mechanisms: data.SaslMechanisms{
+				Mechanism: []string{"SCRAM-SHA-1"},
+			},
+		},
+	}
+}
+
+func (s *SaslXMPPSuite) Test_scramSHA1Auth_savesSaltedKeysAndAuthenticatesWithThemLater(c *C) {
+	expectedOut := "<auth xmlns='urn:ietf:params:xml:ns:xmpp-sasl' mechanism='SCRAM-SHA-1'>eSwsbj11c2VyLHI9YjVjZmY2MTkwMDEzZTY=</auth>\n" +
+		"<response xmlns='urn:ietf:params:xml:ns:xmpp-sasl'>Yz1lU3dzLHI9YjVjZmY2MTkwMDEzZTZrbXVhNURFbVBBWmpPTXBxNFRIV0pRPT0scD1SZnFnNDlqYkJmMWJHQ2t3RlRiby9EdkhtVUk9</response>\n"
+
+	ks := &mockSaltedKeyStore{}
+	out := &mockConnIOReaderWriter{}
+	e := scramSHA1TestConn(out, ks).Authenticate("user", "pencil")
+	c.Assert(e, IsNil)
+	c.Assert(string(out.write), Equals, expectedOut)
+
+	keys, ok := ks.saved["SCRAM-SHA-1"]
+	c.Assert(ok, Equals, true)
+	c.Assert(keys.Iterations, Equals, 4096)
+
+	ks.keys = ks.saved
+	out = &mockConnIOReaderWriter{}
+	e = scramSHA1TestConn(out, ks).Authenticate("user", "")
+	c.Assert(e, IsNil)
+	c.Assert(string(out.write), Equals, expectedOut)
+}
+
+func (s *SaslXMPPSuite) Test_scramSHA1Auth_failsWithoutPasswordOrSaltedKeys(c *C) {
+	out := &mockConnIOReaderWriter{}
+	e := scramSHA1TestConn(out, &mockSaltedKeyStore{}).Authenticate("user", "")
+	c.Assert(e, ErrorMatches, "missing property.*")
+}
+
+func (s *SaslXMPPSuite) Test_conn_useSaltedKeys_setsTheKeysForTheBaseMechanism(c *C) {
+	ks := &mockSaltedKeyStore{keys: map[string]sasl.SaltedKeys{
+		"SCRAM-SHA-1": {Iterations: 42},
+	}}
+	cn := &conn{saltedKeyStore: ks}
+	session, _ := sasl.NewClient("SCRAM-SHA-1-PLUS")
+
+	cn.useSaltedKeys("SCRAM-SHA-1-PLUS", session)
+
+	keys, ok := session.(sasl.SaltedKeysSession).SaltedKeys()
+	c.Assert(ok, Equals, true)
+	c.Assert(keys.Iterations, Equals, 42)
+}
+
+func (s *SaslXMPPSuite) Test_conn_saveSaltedKeys_savesTheKeysForTheBaseMechanism(c *C) {
+	ks := &mockSaltedKeyStore{}
+	cn := &conn{saltedKeyStore: ks}
+	session, _ := sasl.NewClient("SCRAM-SHA-256-PLUS")
+	session.(sasl.SaltedKeysSession).SetSaltedKeys(sasl.SaltedKeys{Iterations: 7})
+
+	cn.saveSaltedKeys("SCRAM-SHA-256-PLUS", session)
+
+	c.Assert(ks.saved["SCRAM-SHA-256"].Iterations, Equals, 7)
+}
+
+func (s *SaslXMPPSuite) Test_conn_saveSaltedKeys_ignoresMechanismsWithoutSaltedKeys(c *C) {
+	ks := &mockSaltedKeyStore{}
+	cn := &conn{saltedKeyStore: ks}
+	session, _ := sasl.NewClient("PLAIN")
+
+	cn.saveSaltedKeys("PLAIN", session)
+
+	c.Assert(ks.saved, HasLen, 0)
+}
+
 func (s *SaslXMPPSuite) Test_conn_BindResource(c *C) {
 	mockOut := &mockConnIOReaderWriter{}
 	mockIn := &mockConnIOReaderWriter{
@@ -1,6 +1,7 @@
 package xmpp
 
 import (
+	"context"
 	"net"
 	"time"
 
@@ -15,7 +16,7 @@ var defaultDialTimeout = 60 * time.Second
 
 func (d *dialer) newTCPConn() (net.Conn, bool, error) {
 	if d.proxy == nil {
-		d.proxy = proxy.Direct
+		d.proxy = ourNet.Dialers.Direct(false)
 	}
 
 	//libpurple and xmpp-client are strict to section 3.2.3 and skip SRV lookup
@@ -51,7 +52,7 @@ func (d *dialer) defaultLookupAndFallback() (net.Conn, bool, error) {
 		"host": host,
 	}).Info("Making SRV lookup")
 
-	addrs, err := resolveSRVWithProxy(d.proxy, host)
+	addrs, err := d.resolveSRV(host)
 
 	d.log.WithFields(log.Fields{
 		"xmpp": addrs,
@@ -87,50 +88,76 @@ func (d *dialer) defaultLookupAndFallback() (net.Conn, bool, error) {
 	return conn, ce.tls, nil
 }
 
-func (d *dialer) connectToFirstAvailable(xmppAddrs []*connectEntry, dialer proxy.Dialer) (net.Conn, *connectEntry, error) {
-	for _, addr := range xmppAddrs {
-		conn, _, err := d.connectWithProxy(addr, dialer)
-		if err == nil {
-			return conn, addr, nil
-		}
+func (d *dialer) dialTimeout(network, addr string, dialer proxy.Dialer, t time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), t)
+	defer cancel()
+
+	c, err := dialContext(ctx, dialer, network, addr)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		d.log.Warn("tcp: dial timed out")
+		return nil, ourNet.ErrTimeout
 	}
 
-	return nil, nil, errors.ErrConnectionFailed
+	return c, err
 }
 
-func (d *dialer) dialTimeout(network, addr string, dialer proxy.Dialer, t time.Duration) (c net.Conn, err error) {
-	result := make(chan bool, 1)
+// dialContext dials with the given dialer until the context is done. Dialers that can't be cancelled are left
+// to finish in the background, and the connection is closed if it arrives too late.
+func dialContext(ctx context.Context, dialer proxy.Dialer, network, addr string) (net.Conn, error) {
+	if cd, ok := dialer.(proxy.ContextDialer); ok {
+		return cd.DialContext(ctx, network, addr)
+	}
 
+	type dialResult struct {
+		c   net.Conn
+		err error
+	}
+
+	result := make(chan dialResult, 1)
 	go func() {
-		c, err = dialer.Dial(network, addr)
-		result <- true
+		c, err := dialer.Dial(network, addr)
+		result <- dialResult{c, err}
 	}()
 
 	select {
-	case <-time.After(t):
-		d.log.Warn("tcp: dial timed out")
-		return nil, ourNet.ErrTimeout
-	case <-result:
-		return
+	case <-ctx.Done():
+		go func() {
+			if r := <-result; r.c != nil {
+				_ = r.c.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case r := <-result:
+		return r.c, r.err
 	}
 }
 
-func (d *dialer) connectWithProxy(addr *connectEntry, dialer proxy.Dialer) (conn net.Conn, tls bool, err error) {
-	d.log.WithField("addr", addr).Info("Connecting")
-
+func (d *dialer) dialEntry(addr *connectEntry, dialer proxy.Dialer) (net.Conn, error) {
 	//TODO: It is not clear to me if this follows
 	//RFC 6120, Section 3.2.1, item 6
 	//See: https://xmpp.org/rfcs/rfc6120.html#tcp-resolution
-	conn, err = d.dialTimeout("tcp", addr.String(), dialer, defaultDialTimeout)
+	conn, err := d.dialTimeout("tcp", addr.dialAddress(), dialer, defaultDialTimeout)
 	if err != nil {
 		if err == ourNet.ErrTimeout {
-			return nil, false, err
+			return nil, err
 		}
 
-		return nil, false, errors.CreateErrFailedToConnect(addr.String(), err)
+		return nil, errors.CreateErrFailedToConnect(addr.dialAddress(), err)
+	}
+
+	return conn, nil
+}
+
+func (d *dialer) connectWithProxy(addr *connectEntry, dialer proxy.Dialer) (conn net.Conn, tls bool, err error) {
+	d.log.WithField("addr", addr).Info("Connecting")
+
+	conn, err = d.dialEntry(addr, dialer)
+	if err != nil {
+		return nil, false, err
 	}
 
 	tls = addr.tls
+	d.connectedTo = addr
 
 	return
 }
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/net/proxy"
 
 	"github.com/chadsec1/decoyim/decoylog"
 	"github.com/chadsec1/decoyim/i18n"
@@ -122,7 +121,7 @@ func (s *TCPSuite) Test_newTCPConn_usesDirectProxyIfNoneGiven(c *C) {
 
 	_, _, _ = d.newTCPConn()
 
-	c.Assert(d.proxy, Equals, proxy.Direct)
+	c.Assert(d.proxy, DeepEquals, ourNet.Dialers.Direct(false))
 }
 
 func testLogger() decoylog.Logger {
@@ -14,6 +14,7 @@ import (
 	"net"
 
 	"github.com/chadsec1/decoyim/decoylog"
+	ourtls "github.com/chadsec1/decoyim/tls"
 	"github.com/chadsec1/decoyim/xmpp/interfaces"
 )
 
@@ -57,6 +58,14 @@ func printTLSDetails(ll decoylog.Logger, tlsState tls.ConnectionState) {
 	ll.WithField("cipherSuite", GetCipherSuiteName(tlsState)).Info("  Cipher suite")
 }
 
+func (d *dialer) verify(tlsState tls.ConnectionState, tlsConfig *tls.Config, originDomain string, dane *ourtls.DANEResult) error {
+	if dv, ok := d.verifier.(ourtls.DANEVerifier); ok {
+		return dv.VerifyWithDANE(tlsState, tlsConfig, originDomain, dane)
+	}
+
+	return d.verifier.Verify(tlsState, tlsConfig, originDomain)
+}
+
 // RFC 6120, section 5.4
 func (d *dialer) negotiateSTARTTLS(c interfaces.Conn, conn net.Conn) error {
 	// RFC 6120, section 5.3
@@ -115,7 +124,13 @@ func (d *dialer) startRawTLS(c interfaces.Conn, conn net.Conn) error {
 	tlsState := tlsConn.ConnectionState()
 	printTLSDetails(d.log, tlsState)
 
-	if err := d.verifier.Verify(tlsState, tlsConfig, c.OriginDomain()); err != nil {
+	dane := d.lookupDANE()
+	if dane != nil {
+		dane.Check(tlsState.PeerCertificates)
+		c.SetDANEResult(dane)
+	}
+
+	if err := d.verify(tlsState, tlsConfig, c.OriginDomain(), dane); err != nil {
 		return err
 	}
 
@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 
+	ourtls "github.com/chadsec1/decoyim/tls"
 	log "github.com/sirupsen/logrus"
 	. "gopkg.in/check.v1"
 )
@@ -59,3 +60,19 @@ func (s *TLSXmppSuite) Test_printTLSDetails_printsCorrectVersions(c *C) {
 	c.Assert(buf.String(), Matches, "(?s).*?version=\"TLS 1\\.2\".*?")
 	c.Assert(buf.String(), Matches, "(?s).*?cipherSuite=TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384.*?")
 }
+
+func (s *TLSXmppSuite) Test_dialer_verify_usesTheDANEResultWhenPossible(c *C) {
+	dane := &ourtls.DANEResult{Name: "_5222._tcp.foo.com"}
+
+	dv := &mockDANEVerifier{}
+	d := &dialer{verifier: dv}
+	c.Assert(d.verify(tls.ConnectionState{}, &tls.Config{}, "foo.com", dane), IsNil)
+	c.Assert(dv.verifyWithDANECalled, Equals, 1)
+	c.Assert(dv.verifyCalled, Equals, 0)
+	c.Assert(dv.dane, Equals, dane)
+
+	v := &mockTLSVerifier{}
+	d = &dialer{verifier: v}
+	c.Assert(d.verify(tls.ConnectionState{}, &tls.Config{}, "foo.com", dane), IsNil)
+	c.Assert(v.verifyCalled, Equals, 1)
+}